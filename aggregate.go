@@ -0,0 +1,183 @@
+package kallax
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+// ErrNoAggregates is returned when a query with no grouped columns is
+// performed to find aggregates without giving any aggregate.
+var ErrNoAggregates = errors.New("kallax: no aggregates or grouped columns to select")
+
+// ErrGroupedAggregate is returned when a grouped query is used to compute a
+// single aggregate, which can only be done for all the rows. Aggregates of
+// grouped queries need to be retrieved with FindAggregate.
+var ErrGroupedAggregate = errors.New("kallax: grouped queries can not be aggregated, use FindAggregate instead")
+
+// aggregateField is a schema field that is the result of applying an
+// aggregate function to a column. As a schema field, it can be used to order
+// the results or in the conditions of the having clause.
+type aggregateField struct {
+	fn       string
+	col      SchemaField
+	distinct bool
+}
+
+// Sum returns a schema field that is the sum of the given column.
+func Sum(col SchemaField) SchemaField {
+	return &aggregateField{fn: "SUM", col: col}
+}
+
+// Avg returns a schema field that is the average of the given column.
+func Avg(col SchemaField) SchemaField {
+	return &aggregateField{fn: "AVG", col: col}
+}
+
+// Min returns a schema field that is the minimum value of the given column.
+func Min(col SchemaField) SchemaField {
+	return &aggregateField{fn: "MIN", col: col}
+}
+
+// Max returns a schema field that is the maximum value of the given column.
+func Max(col SchemaField) SchemaField {
+	return &aggregateField{fn: "MAX", col: col}
+}
+
+// CountDistinct returns a schema field that is the number of distinct values
+// of the given column.
+func CountDistinct(col SchemaField) SchemaField {
+	return &aggregateField{fn: "COUNT", col: col, distinct: true}
+}
+
+// CountAll returns a schema field that is the number of rows.
+func CountAll() SchemaField {
+	return &aggregateField{fn: "COUNT"}
+}
+
+func (*aggregateField) isSchemaField() {}
+
+func (f *aggregateField) String() string {
+	if f.col == nil {
+		return f.fn + "(*)"
+	}
+
+	return f.format(f.col.String())
+}
+
+func (f *aggregateField) QualifiedName(schema Schema) string {
+	if f.col == nil {
+		return f.fn + "(*)"
+	}
+
+	return f.format(f.col.QualifiedName(schema))
+}
+
+func (f *aggregateField) format(col string) string {
+	if f.distinct {
+		return fmt.Sprintf("%s(DISTINCT %s)", f.fn, col)
+	}
+	return fmt.Sprintf("%s(%s)", f.fn, col)
+}
+
+// AggregateResultSet is the result set of a query with aggregates. Each row
+// contains the grouped columns of the query and the requested aggregates.
+type AggregateResultSet struct {
+	columns []string
+	*sql.Rows
+}
+
+// NewAggregateResultSet creates a new aggregate result set with the given
+// rows and columns. It is mandatory that all columns are in the same order
+// as in the query that produced the rows.
+func NewAggregateResultSet(rows *sql.Rows, columns ...SchemaField) *AggregateResultSet {
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.String()
+	}
+
+	return &AggregateResultSet{names, rows}
+}
+
+// Get returns the current row of the result set.
+func (rs *AggregateResultSet) Get() (*AggregateRow, error) {
+	var (
+		values   = make([]interface{}, len(rs.columns))
+		pointers = make([]interface{}, len(rs.columns))
+	)
+
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	if err := rs.Rows.Scan(pointers...); err != nil {
+		return nil, err
+	}
+
+	row := &AggregateRow{make(map[string]interface{}, len(values))}
+	for i, col := range rs.columns {
+		row.values[col] = values[i]
+	}
+
+	return row, nil
+}
+
+// All returns all the rows in the result set and closes it.
+func (rs *AggregateResultSet) All() ([]*AggregateRow, error) {
+	defer rs.Close()
+
+	var result []*AggregateRow
+	for rs.Next() {
+		row, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, row)
+	}
+
+	return result, rs.Err()
+}
+
+// AggregateRow is a row of an aggregate result set. Its values can be
+// retrieved using the schema field of the grouped column or the aggregate.
+type AggregateRow struct {
+	values map[string]interface{}
+}
+
+// Value returns the raw value of the given column or aggregate.
+func (r *AggregateRow) Value(col SchemaField) interface{} {
+	return r.values[col.String()]
+}
+
+// Scan copies the value of the given column or aggregate into the value
+// pointed by dest. If the value is null, dest is left untouched.
+func (r *AggregateRow) Scan(col SchemaField, dest interface{}) error {
+	v, ok := r.values[col.String()]
+	if !ok {
+		return fmt.Errorf("kallax: column %s is not in the aggregate row", col)
+	}
+
+	scanner := types.Nullable(dest)
+	if scanner == nil {
+		return fmt.Errorf("kallax: cannot scan aggregate value into %T", dest)
+	}
+
+	return scanner.Scan(v)
+}
+
+// Int64 returns the value of the given column or aggregate as an int64.
+func (r *AggregateRow) Int64(col SchemaField) (int64, error) {
+	var v int64
+	err := r.Scan(col, &v)
+	return v, err
+}
+
+// Float64 returns the value of the given column or aggregate as a float64.
+func (r *AggregateRow) Float64(col SchemaField) (float64, error) {
+	var v float64
+	err := r.Scan(col, &v)
+	return v, err
+}
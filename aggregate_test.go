@@ -0,0 +1,59 @@
+package kallax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateField(t *testing.T) {
+	cases := []struct {
+		field     SchemaField
+		name      string
+		qualified string
+	}{
+		{Sum(f("age")), "SUM(age)", "SUM(__model.age)"},
+		{Avg(f("age")), "AVG(age)", "AVG(__model.age)"},
+		{Min(f("age")), "MIN(age)", "MIN(__model.age)"},
+		{Max(f("age")), "MAX(age)", "MAX(__model.age)"},
+		{CountDistinct(f("age")), "COUNT(DISTINCT age)", "COUNT(DISTINCT __model.age)"},
+		{CountAll(), "COUNT(*)", "COUNT(*)"},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.name, c.field.String())
+		require.Equal(t, c.qualified, c.field.QualifiedName(ModelSchema))
+	}
+}
+
+func TestAggregateRow(t *testing.T) {
+	require := require.New(t)
+	row := &AggregateRow{map[string]interface{}{
+		"age":      int64(2),
+		"AVG(id)":  []byte("2.5000"),
+		"COUNT(*)": int64(3),
+		"MAX(age)": nil,
+	}}
+
+	age, err := row.Int64(f("age"))
+	require.NoError(err)
+	require.Equal(int64(2), age)
+
+	avg, err := row.Float64(Avg(f("id")))
+	require.NoError(err)
+	require.Equal(2.5, avg)
+
+	count, err := row.Int64(CountAll())
+	require.NoError(err)
+	require.Equal(int64(3), count)
+	require.Equal(int64(3), row.Value(CountAll()))
+
+	max := 5
+	require.NoError(row.Scan(Max(f("age")), &max))
+	require.Equal(5, max)
+
+	_, err = row.Int64(f("name"))
+	require.Error(err)
+
+	require.Error(row.Scan(f("age"), new(struct{})))
+}
@@ -1,6 +1,7 @@
 package kallax
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,23 +10,31 @@ import (
 )
 
 type batchQueryRunner struct {
+	ctx           context.Context
 	schema        Schema
 	cols          []string
 	q             Query
 	oneToOneRels  []Relationship
 	oneToManyRels []Relationship
 	db            squirrel.BaseRunner
+	dialect       Dialect
 	builder       squirrel.SelectBuilder
 	total         int
 	eof           bool
 	// records is the cache of the records in the last batch.
 	records []Record
+	// keyset is true when the order of the query is unique, in which case the
+	// batches after the first one are retrieved after the last record of the
+	// previous batch, instead of using an offset.
+	keyset bool
+	// lastRecord is the last record of the last batch.
+	lastRecord Record
 }
 
 var errNoMoreRows = errors.New("kallax: there are no more rows in the result set")
 
-func newBatchQueryRunner(schema Schema, db squirrel.BaseRunner, q Query) *batchQueryRunner {
-	cols, builder := q.compile()
+func newBatchQueryRunner(ctx context.Context, schema Schema, db squirrel.BaseRunner, dialect Dialect, q Query) *batchQueryRunner {
+	cols, builder := q.compile(dialect)
 	var (
 		oneToOneRels  []Relationship
 		oneToManyRels []Relationship
@@ -35,19 +44,22 @@ func newBatchQueryRunner(schema Schema, db squirrel.BaseRunner, q Query) *batchQ
 		switch rel.Type {
 		case OneToOne:
 			oneToOneRels = append(oneToOneRels, rel)
-		case OneToMany:
+		case OneToMany, ManyToMany:
 			oneToManyRels = append(oneToManyRels, rel)
 		}
 	}
 
 	return &batchQueryRunner{
+		ctx:           ctx,
 		schema:        schema,
 		cols:          cols,
 		q:             q,
 		oneToOneRels:  oneToOneRels,
 		oneToManyRels: oneToManyRels,
 		db:            db,
+		dialect:       dialect,
 		builder:       builder,
+		keyset:        isKeysetOrder(schema, q.getOrder()),
 	}
 }
 
@@ -87,6 +99,7 @@ func (r *batchQueryRunner) next() (Record, error) {
 		}
 
 		r.total += len(records)
+		r.lastRecord = records[len(records)-1]
 		r.records = records[1:]
 		return records[0], nil
 	}
@@ -102,11 +115,23 @@ func (r *batchQueryRunner) loadNextBatch() ([]Record, error) {
 		limit = r.q.GetBatchSize()
 	}
 
-	rows, err := r.builder.
-		Offset(r.q.GetOffset() + uint64(r.total)).
+	builder := r.builder
+	if r.keyset && r.lastRecord != nil {
+		cursor, err := newRecordCursor(r.lastRecord, r.q.getOrder())
+		if err != nil {
+			return nil, err
+		}
+
+		cond := keysetCondition(r.q.getOrder(), cursor.values, false)
+		builder = builder.Where(cond(r.schema))
+	} else {
+		builder = builder.Offset(r.q.GetOffset() + uint64(r.total))
+	}
+
+	rows, err := builder.
 		Limit(limit).
 		RunWith(r.db).
-		Query()
+		QueryContext(r.ctx)
 
 	if err != nil {
 		return nil, err
@@ -173,6 +198,10 @@ func (r *batchQueryRunner) getRecordRelationships(ids []interface{}, rel Relatio
 		return nil, fmt.Errorf("kallax: cannot find foreign key on field %s for table %s", rel.Field, r.schema.Table())
 	}
 
+	if rel.Type == ManyToMany {
+		return r.getRecordRelationshipsThrough(ids, rel, fk)
+	}
+
 	filter := In(fk, ids...)
 	if rel.Filter != nil {
 		rel.Filter = And(rel.Filter, filter)
@@ -182,8 +211,8 @@ func (r *batchQueryRunner) getRecordRelationships(ids []interface{}, rel Relatio
 
 	q := NewBaseQuery(rel.Schema)
 	q.Where(rel.Filter)
-	cols, builder := q.compile()
-	rows, err := builder.RunWith(r.db).Query()
+	cols, builder := q.compile(r.dialect)
+	rows, err := builder.RunWith(r.db).QueryContext(r.ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -213,3 +242,73 @@ func (r *batchQueryRunner) getRecordRelationships(ids []interface{}, rel Relatio
 
 	return indexedResults, nil
 }
+
+// getRecordRelationshipsThrough retrieves the records of a many to many
+// relationship joining them with the join table, whose foreign key to the
+// records holding the relationship is selected along with the rest of the
+// columns in order to index the results.
+func (r *batchQueryRunner) getRecordRelationshipsThrough(ids []interface{}, rel Relationship, fk *ForeignKey) (indexedRecords, error) {
+	if fk.Through == "" {
+		return nil, ErrManyToManyNotSupported
+	}
+
+	q := NewBaseQuery(rel.Schema)
+	if rel.Filter != nil {
+		q.Where(rel.Filter)
+	}
+
+	alias := rel.Schema.Alias() + "_through"
+	fkCol := fmt.Sprintf("%s.%s", alias, fk.String())
+	cols, builder := q.compile(r.dialect)
+	builder = builder.
+		Join(fmt.Sprintf(
+			"%s %s ON (%s.%s = %s)",
+			fk.Through,
+			alias,
+			alias,
+			fk.ThroughKey,
+			rel.Schema.ID().QualifiedName(rel.Schema),
+		)).
+		Column(fkCol).
+		Where(squirrel.Eq{fkCol: ids})
+
+	rows, err := builder.RunWith(r.db).QueryContext(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	relRs := NewResultSet(rows, false, nil)
+	var indexedResults = make(indexedRecords)
+	for relRs.Next() {
+		rec := rel.Schema.New()
+		owner := r.schema.New()
+
+		var pointers = make([]interface{}, len(cols)+1)
+		for i, col := range cols {
+			pointers[i], err = rec.ColumnAddress(col)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pointers[len(cols)], err = owner.ColumnAddress(r.schema.ID().String())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := relRs.RawScan(pointers...); err != nil {
+			return nil, err
+		}
+
+		rec.setPersisted()
+		rec.setWritable(true)
+		id := owner.GetID().Raw()
+		indexedResults[id] = append(indexedResults[id], rec)
+	}
+
+	if err := relRs.Close(); err != nil {
+		return nil, err
+	}
+
+	return indexedResults, nil
+}
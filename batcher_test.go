@@ -1,6 +1,7 @@
 package kallax
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestOneToManyWithFilterNotWritable(t *testing.T) {
 
 	q := NewBaseQuery(ModelSchema)
 	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, Eq(f("foo"), "1")))
-	runner := newBatchQueryRunner(ModelSchema, squirrel.NewStmtCacher(db), q)
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, squirrel.NewStmtCacher(db), PostgreSQL, q)
 	record, err := runner.next()
 	r.NoError(err)
 	r.False(record.IsWritable())
@@ -54,7 +55,7 @@ func TestBatcherLimit(t *testing.T) {
 	q.BatchSize(2)
 	q.Limit(5)
 	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, Eq(f("foo"), "1")))
-	runner := newBatchQueryRunner(ModelSchema, store.runner, q)
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, store.runner, PostgreSQL, q)
 	rs := NewBatchingResultSet(runner)
 
 	var count int
@@ -92,7 +93,7 @@ func TestBatcherNoExtraQueryIfLessThanLimit(t *testing.T) {
 	proxy := store.DebugWith(func(_ string, _ ...interface{}) {
 		queries++
 	}).runner
-	runner := newBatchQueryRunner(ModelSchema, proxy, q)
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, proxy, PostgreSQL, q)
 	rs := NewBatchingResultSet(runner)
 
 	var count int
@@ -131,7 +132,7 @@ func TestBatcherNoExtraQueryIfLessThanBatchSize(t *testing.T) {
 	proxy := store.DebugWith(func(_ string, _ ...interface{}) {
 		queries++
 	}).runner
-	runner := newBatchQueryRunner(ModelSchema, proxy, q)
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, proxy, PostgreSQL, q)
 	rs := NewBatchingResultSet(runner)
 
 	var count int
@@ -144,3 +145,106 @@ func TestBatcherNoExtraQueryIfLessThanBatchSize(t *testing.T) {
 	r.Equal(4, count)
 	r.Equal(4, queries)
 }
+
+func TestBatcherKeyset(t *testing.T) {
+	r := require.New(t)
+	db, err := openTestDB()
+	r.NoError(err)
+	setupTables(t, db)
+	defer db.Close()
+	defer teardownTables(t, db)
+
+	store := NewStore(db)
+	for _, name := range []string{"c", "a", "e", "b", "d"} {
+		m := newModel(name, "bar", 1)
+		r.NoError(store.Insert(ModelSchema, m))
+		r.NoError(store.Insert(RelSchema, newRel(m.GetID(), name)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.BatchSize(2)
+	q.Order(Asc(f("name")), Asc(f("id")))
+	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+
+	var queries []string
+	proxy := store.DebugWith(func(query string, _ ...interface{}) {
+		queries = append(queries, query)
+	}).runner
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, proxy, PostgreSQL, q)
+	r.True(runner.keyset)
+	rs := NewBatchingResultSet(runner)
+
+	var names []string
+	for rs.Next() {
+		record, err := rs.Get(nil)
+		r.NoError(err)
+		m := record.(*model)
+		r.Len(m.Rels, 1)
+		names = append(names, m.Name)
+	}
+	r.Equal([]string{"a", "b", "c", "d", "e"}, names)
+
+	// 3 batches, each one with a query for the relationships
+	r.Len(queries, 6)
+	r.Contains(queries[0], "OFFSET")
+	r.NotContains(queries[2], "OFFSET")
+	r.Contains(queries[2], "__model.name > $1")
+
+	cursor, err := rs.Cursor()
+	r.NoError(err)
+	r.Equal("e", cursor.Values()[0])
+}
+
+func TestBatcherNullableOrder(t *testing.T) {
+	r := require.New(t)
+	db, err := openTestDB()
+	r.NoError(err)
+	setupTables(t, db)
+	defer db.Close()
+	defer teardownTables(t, db)
+
+	_, err = db.Exec("ALTER TABLE model ALTER COLUMN age DROP NOT NULL")
+	r.NoError(err)
+
+	store := NewStore(db)
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		m := newModel(name, "bar", i)
+		r.NoError(store.Insert(ModelSchema, m))
+		r.NoError(store.Insert(RelSchema, newRel(m.GetID(), name)))
+	}
+
+	_, err = db.Exec("UPDATE model SET age = NULL WHERE name IN ('b', 'd', 'e')")
+	r.NoError(err)
+
+	// age is not selected, as a null age can not be scanned into the model
+	schema := NewBaseSchema(
+		"model",
+		"__model",
+		f("id"),
+		ModelSchema.foreignKeys,
+		ModelSchema.constructor,
+		true,
+		f("id"),
+		f("name"),
+		f("email"),
+	).WithNotNull(f("name"), f("email"))
+
+	q := NewBaseQuery(schema)
+	q.BatchSize(2)
+	q.Order(Asc(f("age")), Asc(f("id")))
+	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+
+	runner := newBatchQueryRunner(context.Background(), schema, store.runner, PostgreSQL, q)
+	r.False(runner.keyset, "age can be null")
+	rs := NewBatchingResultSet(runner)
+
+	var names []string
+	for rs.Next() {
+		record, err := rs.Get(nil)
+		r.NoError(err)
+		m := record.(*model)
+		r.Len(m.Rels, 1)
+		names = append(names, m.Name)
+	}
+	r.Equal([]string{"a", "c", "b", "d", "e"}, names)
+}
@@ -42,6 +42,12 @@ func setupTables(t *testing.T, db *sql.DB) {
 		foo text
 	)`)
 	require.NoError(t, err)
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS model_rel (
+		model_id integer not null,
+		rel_id integer not null
+	)`)
+	require.NoError(t, err)
 }
 
 func teardownTables(t *testing.T, db *sql.DB) {
@@ -49,6 +55,8 @@ func teardownTables(t *testing.T, db *sql.DB) {
 	require.NoError(t, err)
 	_, err = db.Exec("DROP TABLE rel")
 	require.NoError(t, err)
+	_, err = db.Exec("DROP TABLE model_rel")
+	require.NoError(t, err)
 }
 
 type model struct {
@@ -98,7 +106,7 @@ func (m *model) NewRelationshipRecord(field string) (Record, error) {
 	switch field {
 	case "rel":
 		return new(rel), nil
-	case "rels":
+	case "rels", "rels_through":
 		return new(rel), nil
 	}
 	return nil, fmt.Errorf("kallax: no relationship found for field %s", field)
@@ -113,7 +121,7 @@ func (m *model) SetRelationship(field string, record interface{}) error {
 		}
 		m.Rel = rel
 		return nil
-	case "rels":
+	case "rels", "rels_through":
 		rels, ok := record.([]Record)
 		if !ok {
 			return fmt.Errorf("kallax: can't set relationship %s with value of type %T", field, record)
@@ -226,9 +234,10 @@ var ModelSchema = NewBaseSchema(
 	"__model",
 	f("id"),
 	ForeignKeys{
-		"rel":     NewForeignKey("model_id", false),
-		"rels":    NewForeignKey("model_id", false),
-		"rel_inv": NewForeignKey("model_id", true),
+		"rel":          NewForeignKey("model_id", false),
+		"rels":         NewForeignKey("model_id", false),
+		"rel_inv":      NewForeignKey("model_id", true),
+		"rels_through": NewThroughForeignKey("model_id", "model_rel", "rel_id"),
 	},
 	func() Record {
 		return new(model)
@@ -238,7 +247,7 @@ var ModelSchema = NewBaseSchema(
 	f("name"),
 	f("email"),
 	f("age"),
-)
+).WithNotNull(f("name"), f("email"), f("age"))
 
 var RelSchema = NewBaseSchema(
 	"rel",
@@ -266,6 +275,38 @@ var onlyPkModelSchema = NewBaseSchema(
 	f("id"),
 )
 
+// versionedModelSchema is the schema of model using age as version column.
+var versionedModelSchema = NewBaseSchema(
+	"model",
+	"__model",
+	f("id"),
+	nil,
+	func() Record {
+		return new(model)
+	},
+	true,
+	f("id"),
+	f("name"),
+	f("email"),
+	f("age"),
+).WithVersion(f("age"))
+
+// softDeleteModelSchema is the schema of a model with soft deletes. It is
+// only meant to generate queries, not to retrieve records.
+var softDeleteModelSchema = NewBaseSchema(
+	"model",
+	"__model",
+	f("id"),
+	nil,
+	func() Record {
+		return new(model)
+	},
+	true,
+	f("id"),
+	f("name"),
+	f("deleted_at"),
+).WithSoftDelete(f("deleted_at"))
+
 func f(name string) SchemaField {
 	return NewSchemaField(name)
 }
@@ -0,0 +1,235 @@
+package kallax
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrNoCursor is returned when the cursor of a result set is requested,
+	// but the query has no order or no record has been retrieved yet.
+	ErrNoCursor = errors.New("kallax: there is no cursor, the query has no order or no record was retrieved")
+	// ErrInvalidCursor is returned when an encoded cursor can not be parsed.
+	ErrInvalidCursor = errors.New("kallax: invalid cursor")
+	// ErrCursorMismatch is returned when a query is performed with a cursor
+	// that does not have a value for each one of the columns the query is
+	// ordered by.
+	ErrCursorMismatch = errors.New("kallax: the cursor does not match the order of the query")
+	// ErrRankCursor is returned when a cursor is used with or requested for a
+	// query ordered by the rank of a full-text search.
+	ErrRankCursor = errors.New("kallax: cursors can not be used with queries ordered by rank")
+)
+
+// Cursor is the position of a record in the results of a query, given by the
+// values of the columns the query is ordered by. It can be used to retrieve
+// the records after or before it in a query with the same order, which is
+// way faster than using offsets and does not skip or repeat rows when other
+// rows are inserted or deleted in the meantime.
+// Cursors can be encoded to be sent to clients and parsed back afterwards.
+type Cursor struct {
+	values []interface{}
+}
+
+// NewCursor creates a new cursor with the given values of the columns a query
+// is ordered by, in the same order.
+func NewCursor(values ...interface{}) (*Cursor, error) {
+	var result = make([]interface{}, len(values))
+	for i, v := range values {
+		dv, err := driver.DefaultParameterConverter.ConvertValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("kallax: cannot use value of type %T in a cursor: %s", v, err)
+		}
+		result[i] = dv
+	}
+
+	return &Cursor{result}, nil
+}
+
+// newRecordCursor creates a new cursor with the values of the given record
+// for the given order.
+func newRecordCursor(record Record, order []ColumnOrder) (*Cursor, error) {
+	if record == nil || len(order) == 0 {
+		return nil, ErrNoCursor
+	}
+
+	if !isCursorOrder(order) {
+		return nil, ErrRankCursor
+	}
+
+	var values = make([]interface{}, len(order))
+	for i, o := range order {
+		v, err := record.Value(o.column().String())
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	return NewCursor(values...)
+}
+
+// Values returns the values of the cursor.
+func (c *Cursor) Values() []interface{} {
+	return c.values
+}
+
+type cursorValue struct {
+	Type  string `json:"t"`
+	Value string `json:"v,omitempty"`
+}
+
+const (
+	cursorNull   = "null"
+	cursorInt    = "int"
+	cursorFloat  = "float"
+	cursorBool   = "bool"
+	cursorString = "string"
+	cursorBytes  = "bytes"
+	cursorTime   = "time"
+)
+
+// Encode returns the cursor encoded as an opaque string, safe to be used in
+// URLs.
+func (c *Cursor) Encode() (string, error) {
+	var values = make([]cursorValue, len(c.values))
+	for i, v := range c.values {
+		switch v := v.(type) {
+		case nil:
+			values[i] = cursorValue{Type: cursorNull}
+		case int64:
+			values[i] = cursorValue{cursorInt, strconv.FormatInt(v, 10)}
+		case float64:
+			values[i] = cursorValue{cursorFloat, strconv.FormatFloat(v, 'g', -1, 64)}
+		case bool:
+			values[i] = cursorValue{cursorBool, strconv.FormatBool(v)}
+		case string:
+			values[i] = cursorValue{cursorString, v}
+		case []byte:
+			values[i] = cursorValue{cursorBytes, base64.StdEncoding.EncodeToString(v)}
+		case time.Time:
+			values[i] = cursorValue{cursorTime, v.Format(time.RFC3339Nano)}
+		default:
+			return "", fmt.Errorf("kallax: cannot encode value of type %T in a cursor", v)
+		}
+	}
+
+	bytes, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// ParseCursor parses a cursor encoded with the Encode method of Cursor.
+func ParseCursor(encoded string) (*Cursor, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var values []cursorValue
+	if err := json.Unmarshal(bytes, &values); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var result = make([]interface{}, len(values))
+	for i, v := range values {
+		var err error
+		switch v.Type {
+		case cursorNull:
+			result[i] = nil
+		case cursorInt:
+			result[i], err = strconv.ParseInt(v.Value, 10, 64)
+		case cursorFloat:
+			result[i], err = strconv.ParseFloat(v.Value, 64)
+		case cursorBool:
+			result[i], err = strconv.ParseBool(v.Value)
+		case cursorString:
+			result[i] = v.Value
+		case cursorBytes:
+			result[i], err = base64.StdEncoding.DecodeString(v.Value)
+		case cursorTime:
+			result[i], err = time.Parse(time.RFC3339Nano, v.Value)
+		default:
+			err = ErrInvalidCursor
+		}
+
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return &Cursor{result}, nil
+}
+
+// keysetCondition returns a condition that matches the rows that come after
+// the given values in the given order, or before them if before is true.
+//   keysetCondition([]ColumnOrder{Asc(A), Desc(B)}, []interface{}{1, 2}, false)
+//   // ... (A > 1 OR (A = 1 AND B < 2))
+func keysetCondition(order []ColumnOrder, values []interface{}, before bool) Condition {
+	var conds = make([]Condition, len(order))
+	for i, o := range order {
+		var parts = make([]Condition, 0, i+1)
+		for j := 0; j < i; j++ {
+			parts = append(parts, Eq(order[j].column(), values[j]))
+		}
+
+		if o.isDescending() != before {
+			parts = append(parts, Lt(o.column(), values[i]))
+		} else {
+			parts = append(parts, Gt(o.column(), values[i]))
+		}
+
+		if len(parts) == 1 {
+			conds[i] = parts[0]
+		} else {
+			conds[i] = And(parts...)
+		}
+	}
+
+	return Or(conds...)
+}
+
+// isKeysetOrder reports whether the rows after a given one in the given
+// order can be found using the values of the ordered columns of that row. This
+// happens when the primary key is one of the columns in the order, so that
+// no two rows can be in the same position, and all of them are plain columns
+// that can not be null, which would not match any comparison.
+func isKeysetOrder(schema Schema, order []ColumnOrder) bool {
+	if !isCursorOrder(order) {
+		return false
+	}
+
+	var hasID bool
+	for _, o := range order {
+		if _, ok := o.column().(*BaseSchemaField); !ok {
+			return false
+		}
+
+		if !schema.isNotNull(o.column()) {
+			return false
+		}
+
+		if o.column().String() == schema.ID().String() {
+			hasID = true
+		}
+	}
+	return hasID
+}
+
+// isCursorOrder reports whether the given order can be used with cursors,
+// which is not the case if the records are ordered by rank.
+func isCursorOrder(order []ColumnOrder) bool {
+	for _, o := range order {
+		if _, ok := o.(*rankOrder); ok {
+			return false
+		}
+	}
+	return true
+}
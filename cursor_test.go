@@ -0,0 +1,91 @@
+package kallax
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorEncode(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2017, time.March, 3, 10, 11, 12, 13000, time.UTC)
+	cursor, err := NewCursor(1, "foo", 1.5, true, []byte("bar"), now, nil)
+	require.NoError(err)
+	require.Equal([]interface{}{int64(1), "foo", 1.5, true, []byte("bar"), now, nil}, cursor.Values())
+
+	encoded, err := cursor.Encode()
+	require.NoError(err)
+
+	parsed, err := ParseCursor(encoded)
+	require.NoError(err)
+	require.Len(parsed.Values(), 7)
+	require.Equal(cursor.Values()[:5], parsed.Values()[:5])
+	require.True(now.Equal(parsed.Values()[5].(time.Time)))
+	require.Nil(parsed.Values()[6])
+}
+
+func TestNewCursor_InvalidValue(t *testing.T) {
+	_, err := NewCursor(struct{}{})
+	require.Error(t, err)
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	cases := []string{
+		"not base64!",
+		"bm90IGpzb24",
+		"W3sidCI6ImludCIsInYiOiJmb28ifV0",
+		"W3sidCI6ImZvbyJ9XQ",
+	}
+
+	for _, c := range cases {
+		_, err := ParseCursor(c)
+		require.Equal(t, ErrInvalidCursor, err, c)
+	}
+}
+
+func TestNewRecordCursor(t *testing.T) {
+	require := require.New(t)
+
+	m := newModel("foo", "foo@foo.foo", 5)
+	m.ID = 3
+
+	_, err := newRecordCursor(m, nil)
+	require.Equal(ErrNoCursor, err)
+
+	_, err = newRecordCursor(nil, []ColumnOrder{Asc(f("id"))})
+	require.Equal(ErrNoCursor, err)
+
+	cursor, err := newRecordCursor(m, []ColumnOrder{Desc(f("age")), Asc(f("id"))})
+	require.NoError(err)
+	require.Equal([]interface{}{int64(5), int64(3)}, cursor.Values())
+
+	_, err = newRecordCursor(m, []ColumnOrder{Asc(f("foo"))})
+	require.Error(err)
+}
+
+func TestKeysetCondition(t *testing.T) {
+	require := require.New(t)
+	order := []ColumnOrder{Asc(f("name")), Desc(f("age")), Asc(f("id"))}
+
+	sql, args, err := keysetCondition(order, []interface{}{"a", 2, 3}, false)(ModelSchema).ToSql()
+	require.NoError(err)
+	require.Equal("(__model.name > ? OR (__model.name = ? AND __model.age < ?) OR (__model.name = ? AND __model.age = ? AND __model.id > ?))", sql)
+	require.Equal([]interface{}{"a", "a", 2, "a", 2, 3}, args)
+
+	sql, _, err = keysetCondition(order, []interface{}{"a", 2, 3}, true)(ModelSchema).ToSql()
+	require.NoError(err)
+	require.Equal("(__model.name < ? OR (__model.name = ? AND __model.age > ?) OR (__model.name = ? AND __model.age = ? AND __model.id < ?))", sql)
+}
+
+func TestIsKeysetOrder(t *testing.T) {
+	require := require.New(t)
+
+	require.False(isKeysetOrder(ModelSchema, nil))
+	require.False(isKeysetOrder(ModelSchema, []ColumnOrder{Asc(f("name"))}))
+	require.True(isKeysetOrder(ModelSchema, []ColumnOrder{Asc(f("name")), Desc(f("id"))}))
+	require.False(isKeysetOrder(ModelSchema, []ColumnOrder{Asc(f("id")), Asc(Sum(f("age")))}))
+	require.True(isKeysetOrder(RelSchema, []ColumnOrder{Asc(f("id"))}))
+	require.False(isKeysetOrder(RelSchema, []ColumnOrder{Asc(f("foo")), Asc(f("id"))}))
+}
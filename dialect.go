@@ -0,0 +1,174 @@
+package kallax
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// Dialect is the SQL dialect of a database, which defines the parts of the
+// queries run by a store that are not the same in all databases. The store
+// builds queries with RETURNING clauses and upserts with ON CONFLICT clauses,
+// so the database needs to support them.
+type Dialect interface {
+	// Name returns the name of the dialect.
+	Name() string
+	// Placeholder returns the placeholder of the nth argument of a query,
+	// starting at 1.
+	Placeholder(n int) string
+	// PlaceholderFormat returns the format used to replace the `?`
+	// placeholders of the queries built with squirrel.
+	PlaceholderFormat() squirrel.PlaceholderFormat
+	// Operator returns the format of the SQL of the operator with the given
+	// name, written like the formats of NewOperator, and whether the dialect
+	// supports it. Operators are named after the functions that return their
+	// conditions, such as `ArrayOverlap`, orders, such as `ByRank`, or row
+	// locks, such as `ForUpdate`, which take no column nor argument.
+	Operator(name string) (format string, ok bool)
+	// IsUndefinedTable reports whether the given error was returned because
+	// a table does not exist.
+	IsUndefinedTable(err error) bool
+	// ReserveIDs returns a query that reserves values of the sequence of an
+	// autoincrementable column, so rows can be inserted with known IDs, and
+	// whether the dialect supports it. The query takes the table, the column
+	// and the number of values as arguments, and returns a row with each
+	// value, or none if the column has no sequence.
+	ReserveIDs() (query string, ok bool)
+}
+
+var (
+	// PostgreSQL is the dialect of PostgreSQL, which is used by default.
+	PostgreSQL Dialect = &postgreSQL{}
+	// SQLite is the dialect of SQLite. It requires SQLite 3.35 or later,
+	// which supports RETURNING clauses. Array, full-text search and regular
+	// expression operators are not supported, and neither are most of the
+	// JSON operators nor row locking.
+	SQLite Dialect = &sqlite{}
+)
+
+type postgreSQL struct{}
+
+func (*postgreSQL) Name() string { return "postgres" }
+
+func (*postgreSQL) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (*postgreSQL) PlaceholderFormat() squirrel.PlaceholderFormat {
+	return squirrel.Dollar
+}
+
+func (*postgreSQL) Operator(name string) (string, bool) {
+	format, ok := postgreSQLOperators[name]
+	return format, ok
+}
+
+// undefinedTable is the code of the error returned by PostgreSQL when a
+// table does not exist.
+const undefinedTable = "42P01"
+
+func (*postgreSQL) IsUndefinedTable(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == undefinedTable
+}
+
+func (*postgreSQL) ReserveIDs() (string, bool) {
+	return `SELECT nextval(seq::regclass) FROM (SELECT pg_get_serial_sequence($1, $2) AS seq) s, generate_series(1, $3) WHERE seq IS NOT NULL`, true
+}
+
+var postgreSQLOperators = map[string]string{
+	"Ilike":               ":col: ILIKE :arg:",
+	"SimilarTo":           ":col: SIMILAR TO :arg:",
+	"NotSimilarTo":        ":col: NOT SIMILAR TO :arg:",
+	"ArrayEq":             ":col: = :arg:",
+	"ArrayNotEq":          ":col: <> :arg:",
+	"ArrayLt":             ":col: < :arg:",
+	"ArrayGt":             ":col: > :arg:",
+	"ArrayLtOrEq":         ":col: <= :arg:",
+	"ArrayGtOrEq":         ":col: >= :arg:",
+	"ArrayContains":       ":col: @> :arg:",
+	"ArrayContainedBy":    ":col: <@ :arg:",
+	"ArrayOverlap":        ":col: && :arg:",
+	"JSONIsObject":        ":col: @> '{}'",
+	"JSONIsArray":         ":col: @> '[]'",
+	"JSONContains":        ":col: @> :arg:",
+	"JSONContainsAny":     ":col: @> ANY (ARRAY [:arg:]::jsonb[])",
+	"JSONContainedBy":     ":col: <@ :arg:",
+	"JSONContainsAnyKey":  ":col: ??| :arg:",
+	"JSONContainsAllKeys": ":col: ??& :arg:",
+	"MatchRegexCase":      ":col: ~ :arg:",
+	"MatchRegex":          ":col: ~* :arg:",
+	"NotMatchRegexCase":   ":col: !~ :arg:",
+	"NotMatchRegex":       ":col: !~* :arg:",
+	"Matches":             ":col: @@ :arg:",
+	"ByRank":              "ts_rank(:col:, :arg:)",
+	"ForUpdate":           "FOR UPDATE",
+	"ForShare":            "FOR SHARE",
+}
+
+type sqlite struct{}
+
+func (*sqlite) Name() string { return "sqlite" }
+
+func (*sqlite) Placeholder(n int) string {
+	return fmt.Sprintf("?%d", n)
+}
+
+func (*sqlite) PlaceholderFormat() squirrel.PlaceholderFormat {
+	return squirrel.Question
+}
+
+func (*sqlite) Operator(name string) (string, bool) {
+	format, ok := sqliteOperators[name]
+	return format, ok
+}
+
+func (*sqlite) IsUndefinedTable(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "no such table")
+}
+
+// ReserveIDs is not supported by SQLite, which has no sequences.
+func (*sqlite) ReserveIDs() (string, bool) {
+	return "", false
+}
+
+// sqliteOperators are the operators supported by SQLite. LIKE is already
+// case-insensitive for ASCII characters. JSON values are stored as blobs,
+// which need to be converted to text to be used by the JSON functions.
+var sqliteOperators = map[string]string{
+	"Ilike":        ":col: LIKE :arg:",
+	"JSONIsObject": "json_type(CAST(:col: AS text)) = 'object'",
+	"JSONIsArray":  "json_type(CAST(:col: AS text)) = 'array'",
+}
+
+// dialectSchema is a schema along with the dialect its conditions are
+// compiled for.
+type dialectSchema struct {
+	Schema
+	dialect Dialect
+}
+
+// withDialect returns the given schema along with the given dialect.
+func withDialect(schema Schema, dialect Dialect) Schema {
+	if s, ok := schema.(*dialectSchema); ok {
+		schema = s.Schema
+	}
+	return &dialectSchema{schema, dialect}
+}
+
+// WithAlias returns a new schema with the given string added to the
+// default alias, along with the same dialect.
+func (s *dialectSchema) WithAlias(field string) Schema {
+	return &dialectSchema{s.Schema.WithAlias(field), s.dialect}
+}
+
+// dialectOf returns the dialect the conditions of the given schema are
+// compiled for, which is PostgreSQL if none was given.
+func dialectOf(schema Schema) Dialect {
+	if s, ok := schema.(*dialectSchema); ok {
+		return s.dialect
+	}
+	return PostgreSQL
+}
@@ -0,0 +1,315 @@
+package kallax
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDialectOperatorsSQL(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		name    string
+		dialect Dialect
+		cond    Condition
+		sql     string
+	}{
+		{"postgres Ilike", PostgreSQL, Ilike(f("name"), "j%"), "__model.name ILIKE ?"},
+		{"sqlite Ilike", SQLite, Ilike(f("name"), "j%"), "__model.name LIKE ?"},
+		{"postgres ArrayOverlap", PostgreSQL, ArrayOverlap(f("elems"), 1, 2), "__model.elems && ?"},
+		{"postgres JSONIsObject", PostgreSQL, JSONIsObject(f("elem")), "__model.elem @> '{}'"},
+		{"sqlite JSONIsObject", SQLite, JSONIsObject(f("elem")), "json_type(CAST(__model.elem AS text)) = 'object'"},
+		{"sqlite JSONIsArray", SQLite, JSONIsArray(f("elem")), "json_type(CAST(__model.elem AS text)) = 'array'"},
+		{"postgres JSONContains column", PostgreSQL, JSONContains(f("elem"), Col(f("other"))), "__model.elem @> __model.other"},
+		{"postgres JSONContainsAny", PostgreSQL, JSONContainsAny(f("elem"), 1, 2), "__model.elem @> ANY (ARRAY [?, ?]::jsonb[])"},
+	}
+
+	for _, c := range cases {
+		sql, _, err := c.cond(withDialect(ModelSchema, c.dialect)).ToSql()
+		r.NoError(err, c.name)
+		r.Equal(c.sql, sql, c.name)
+	}
+}
+
+func TestDialectOperatorsSQL_NotSupported(t *testing.T) {
+	r := require.New(t)
+	schema := withDialect(ModelSchema, SQLite)
+
+	_, _, err := ArrayOverlap(f("elems"), 1, 2)(schema).ToSql()
+	r.EqualError(err, "kallax: operator ArrayOverlap is not supported by the sqlite dialect")
+
+	_, _, err = Matches(f("name"), PlainQuery("fat rats"), "english")(schema).ToSql()
+	r.EqualError(err, "kallax: operator Matches is not supported by the sqlite dialect")
+
+	_, _, err = JSONContainsAny(f("elem"), 1, 2)(schema).ToSql()
+	r.EqualError(err, "kallax: operator JSONContainsAny is not supported by the sqlite dialect")
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Or(Eq(f("name"), "a"), Has(RelSchema, "rels", MatchRegex(f("foo"), "a.*"))))
+	_, b := q.compile(SQLite)
+	_, _, err = b.ToSql()
+	r.EqualError(err, "kallax: operator MatchRegex is not supported by the sqlite dialect")
+
+	_, b = q.compile(PostgreSQL)
+	_, _, err = b.ToSql()
+	r.NoError(err)
+
+	q = NewBaseQuery(ModelSchema)
+	q.Order(ByRank(f("name"), PlainQuery("fat rats"), "english"))
+	_, b = q.compile(SQLite)
+	_, _, err = b.ToSql()
+	r.EqualError(err, "kallax: operator ByRank is not supported by the sqlite dialect")
+
+	q = NewBaseQuery(ModelSchema)
+	q.ForShare()
+	q.SkipLocked()
+	_, b = q.compile(SQLite)
+	_, _, err = b.ToSql()
+	r.EqualError(err, "kallax: operator ForShare is not supported by the sqlite dialect")
+}
+
+func TestQueryCompileDialect(t *testing.T) {
+	r := require.New(t)
+
+	sub := NewBaseQuery(RelSchema)
+	sub.Select(f("model_id"))
+	sub.Where(Eq(f("foo"), "b"))
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("name"), "a"))
+	q.Where(InQuery(f("id"), sub))
+
+	_, b := q.compile(SQLite)
+	sql, _, err := b.ToSql()
+	r.NoError(err)
+	r.Contains(sql, "WHERE __model.name = ? AND __model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = ?)")
+
+	sql, _, err = q.ToSql()
+	r.NoError(err)
+	r.Contains(sql, "WHERE __model.name = $1 AND __model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = $2)")
+
+	sql, _, err = updateWhereSQL(SQLite, q, map[SchemaField]interface{}{f("age"): 1}, []string{"id"})
+	r.NoError(err)
+	r.Equal("UPDATE model AS __model SET age = ? WHERE __model.name = ? AND __model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = ?) RETURNING id", sql)
+}
+
+func openSQLiteTestDB(t *testing.T) (*sql.DB, string) {
+	dir, err := ioutil.TempDir("", "kallax-sqlite")
+	require.NoError(t, err)
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	return db, dir
+}
+
+func TestSQLiteStore(t *testing.T) {
+	suite.Run(t, new(SQLiteStoreSuite))
+}
+
+type SQLiteStoreSuite struct {
+	suite.Suite
+	db    *sql.DB
+	dir   string
+	store *Store
+}
+
+func (s *SQLiteStoreSuite) SetupTest() {
+	s.db, s.dir = openSQLiteTestDB(s.T())
+	s.store = NewStore(s.db).WithDialect(SQLite)
+
+	_, err := s.db.Exec(`CREATE TABLE model (
+		id integer PRIMARY KEY,
+		name text NOT NULL,
+		email text NOT NULL,
+		age integer NOT NULL
+	);
+	CREATE TABLE rel (
+		id integer PRIMARY KEY,
+		model_id integer,
+		foo text
+	);
+	CREATE TABLE model_rel (
+		model_id integer NOT NULL,
+		rel_id integer NOT NULL
+	);`)
+	s.Require().NoError(err)
+}
+
+func (s *SQLiteStoreSuite) TearDownTest() {
+	s.NoError(s.db.Close())
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *SQLiteStoreSuite) names(q Query) []string {
+	rs, err := s.store.Find(q)
+	s.Require().NoError(err)
+
+	var names []string
+	for rs.Next() {
+		r, err := rs.Get(ModelSchema)
+		s.Require().NoError(err)
+		names = append(names, r.(*model).Name)
+	}
+	return names
+}
+
+func (s *SQLiteStoreSuite) TestInsertAndFind() {
+	require := s.Require()
+	for _, m := range []*model{
+		newModel("Joe", "joe@example.com", 1),
+		newModel("Jane", "jane@example.com", 2),
+		newModel("Anna", "anna@example.com", 3),
+	} {
+		require.NoError(s.store.Insert(ModelSchema, m))
+		require.NotZero(m.ID)
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Ilike(f("name"), "j%"))
+	q.Where(Gt(f("age"), 1))
+	s.Equal([]string{"Jane"}, s.names(q))
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(In(f("name"), "Joe", "Anna"))
+	q.Order(Desc(f("age")))
+	s.Equal([]string{"Anna", "Joe"}, s.names(q))
+
+	count, err := s.store.Count(NewBaseQuery(ModelSchema))
+	require.NoError(err)
+	s.Equal(int64(3), count)
+}
+
+func (s *SQLiteStoreSuite) TestInsertMany() {
+	require := s.Require()
+	models := []Record{
+		newModel("Joe", "joe@example.com", 1),
+		newModel("Jane", "jane@example.com", 2),
+		newModel("Anna", "anna@example.com", 3),
+	}
+	require.NoError(s.store.InsertMany(ModelSchema, models...))
+
+	for _, r := range models {
+		m := r.(*model)
+		require.NotZero(m.ID)
+
+		q := NewBaseQuery(ModelSchema)
+		q.Where(Eq(f("id"), m.ID))
+		s.Equal([]string{m.Name}, s.names(q))
+	}
+}
+
+func (s *SQLiteStoreSuite) TestUpdateAndDelete() {
+	require := s.Require()
+	m := newModel("Joe", "joe@example.com", 1)
+	require.NoError(s.store.Insert(ModelSchema, m))
+
+	m.Age = 2
+	_, err := s.store.Update(ModelSchema, m, f("age"))
+	require.NoError(err)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("age"), 2))
+	rs, err := s.store.UpdateWhereReturning(q, map[SchemaField]interface{}{f("name"): "Joseph"})
+	require.NoError(err)
+	require.True(rs.Next())
+	r, err := rs.Get(ModelSchema)
+	require.NoError(err)
+	s.Equal("Joseph", r.(*model).Name)
+	require.False(rs.Next())
+
+	require.NoError(s.store.Reload(ModelSchema, m))
+	s.Equal("Joseph", m.Name)
+
+	m.Name = "Joe"
+	require.NoError(s.store.Upsert(ModelSchema, m, []SchemaField{f("id")}, f("name")))
+	require.NoError(s.store.Reload(ModelSchema, m))
+	s.Equal("Joe", m.Name)
+
+	deleted, err := s.store.DeleteWhere(NewBaseQuery(ModelSchema))
+	require.NoError(err)
+	s.Equal(int64(1), deleted)
+}
+
+func (s *SQLiteStoreSuite) TestRelationships() {
+	require := s.Require()
+	m := newModel("Joe", "joe@example.com", 1)
+	require.NoError(s.store.Insert(ModelSchema, m))
+	require.NoError(s.store.InsertMany(RelSchema, newRel(m.GetID(), "a"), newRel(m.GetID(), "b")))
+
+	q := NewBaseQuery(ModelSchema)
+	require.NoError(q.AddRelation(RelSchema, "rels", OneToMany, Eq(f("foo"), "b")))
+	rs, err := s.store.Find(q)
+	require.NoError(err)
+	require.True(rs.Next())
+	r, err := rs.Get(ModelSchema)
+	require.NoError(err)
+	require.Len(r.(*model).Rels, 1)
+	s.Equal("b", r.(*model).Rels[0].Foo)
+	require.False(rs.Next())
+
+	rel := newRel(nil, "c")
+	require.NoError(s.store.Insert(RelSchema, rel))
+	require.NoError(s.store.Link(ModelSchema, "rels_through", m, rel))
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Has(RelSchema, "rels_through", Eq(f("foo"), "c")))
+	s.Equal([]string{"Joe"}, s.names(q))
+}
+
+func (s *SQLiteStoreSuite) TestNotSupportedOperator() {
+	q := NewBaseQuery(ModelSchema)
+	q.Where(ArrayOverlap(f("name"), "a", "b"))
+
+	_, err := s.store.Find(q)
+	s.EqualError(err, "kallax: operator ArrayOverlap is not supported by the sqlite dialect")
+}
+
+func (s *SQLiteStoreSuite) TestNotSupportedLock() {
+	q := NewBaseQuery(ModelSchema)
+	q.ForUpdate()
+
+	_, err := s.store.Find(q)
+	s.EqualError(err, "kallax: operator ForUpdate is not supported by the sqlite dialect")
+}
+
+func TestSQLiteMigrator(t *testing.T) {
+	require := require.New(t)
+	db, dbDir := openSQLiteTestDB(t)
+	defer os.RemoveAll(dbDir)
+	defer db.Close()
+
+	dir := writeMigrationFiles(t, map[string]string{
+		"1_create.up.sql":   "CREATE TABLE migrator (id integer PRIMARY KEY, name text NOT NULL);",
+		"1_create.down.sql": "DROP TABLE migrator;",
+	})
+	defer os.RemoveAll(dir)
+
+	m := NewMigrator(db, dir).WithDialect(SQLite)
+	m.migrations = map[uint]*Migration{
+		2: {Version: 2, Name: "backfill", up: func(store *Store) error {
+			_, err := store.RawExec("INSERT INTO migrator (name) VALUES (?)", "foo")
+			return err
+		}},
+	}
+
+	version, _, err := m.Version()
+	require.NoError(err)
+	require.Equal(uint(0), version)
+
+	require.NoError(m.Up())
+	version, dirty, err := m.Version()
+	require.NoError(err)
+	require.False(dirty)
+	require.Equal(uint(2), version)
+
+	var count int
+	require.NoError(db.QueryRow("SELECT COUNT(*) FROM migrator").Scan(&count))
+	require.Equal(1, count)
+}
@@ -28,6 +28,7 @@ func newApp() *cli.App {
 	app.Commands = cli.Commands{
 		cmd.Generate,
 		cmd.Migrate,
+		cmd.Import,
 	}
 
 	return app
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/src-d/go-kallax.v1/generator"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+var Import = cli.Command{
+	Name:   "import",
+	Usage:  "Generate kallax models for the tables of an existing database and seed the lock of the migrations with their schema",
+	Action: importAction,
+	Flags: []cli.Flag{
+		dirFlag,
+		dsnFlag,
+		cli.StringFlag{
+			Name:  "output, o",
+			Value: "models.go",
+			Usage: "Output file of the models. The lock is seeded with the schema of the models of its whole package.",
+		},
+		cli.StringFlag{
+			Name:  "package, p",
+			Usage: "Package name of the models. By default, it is the name of the directory of the output file.",
+		},
+		cli.StringSliceFlag{
+			Name:  "ignore",
+			Usage: "Tables of the database that must not be imported. You can use this flag as many times as you want.",
+		},
+	},
+}
+
+func importAction(c *cli.Context) error {
+	dir := c.String("dir")
+	ok, err := isDirectory(dir)
+	if err != nil {
+		return fmt.Errorf("kallax: cannot check if `dir` is a directory: %s", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("kallax: argument `dir` must be a valid directory")
+	}
+
+	output, err := filepath.Abs(c.String("output"))
+	if err != nil {
+		return fmt.Errorf("kallax: cannot get absolute path of `output`: %s", err)
+	}
+
+	if _, err := os.Stat(output); err == nil {
+		return fmt.Errorf("kallax: output file %s already exists", output)
+	}
+
+	outputDir := filepath.Dir(output)
+	ok, err = isDirectory(outputDir)
+	if err != nil || !ok {
+		return fmt.Errorf("kallax: directory of `output` %s must be a valid directory", outputDir)
+	}
+
+	pkgName := c.String("package")
+	if pkgName == "" {
+		pkgName = filepath.Base(outputDir)
+	}
+
+	// nothing is written if the lock can not be seeded afterwards
+	g := generator.NewMigrationGenerator("", dir)
+	if g.HasLock() {
+		return generator.ErrLockExists
+	}
+
+	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s", c.String("dsn")))
+	if err != nil {
+		return fmt.Errorf("kallax: unable to open a connection with the database: %s", err)
+	}
+	defer db.Close()
+
+	live, err := generator.IntrospectSchema(db, append(c.StringSlice("ignore"), migrationsTable)...)
+	if err != nil {
+		return err
+	}
+
+	src, skipped, err := generator.ImportModels(live, pkgName)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(output, src, 0644); err != nil {
+		return fmt.Errorf("kallax: unable to write the models: %s", err)
+	}
+
+	for _, t := range skipped {
+		fmt.Fprintf(os.Stderr, "WARN: table %s was not imported because it does not have a primary key of a single column of a supported type\n", t)
+	}
+
+	pkgs, err := processPackages([]string{outputDir})
+	if err == nil {
+		err = g.SeedLock(live, pkgs...)
+	}
+
+	if err != nil {
+		// the models are not left behind when the lock is not seeded
+		os.Remove(output)
+		return err
+	}
+
+	return nil
+}
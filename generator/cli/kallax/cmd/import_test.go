@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-kallax.v1/generator"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+func TestImportAction_LockExists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kallax-import")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "lock.json"), []byte("{}"), 0644)
+	require.NoError(t, err)
+
+	output := filepath.Join(dir, "models.go")
+	set := flag.NewFlagSet("import", flag.ContinueOnError)
+	set.String("dir", dir, "")
+	set.String("dsn", "", "")
+	set.String("output", output, "")
+	set.String("package", "", "")
+
+	err = importAction(cli.NewContext(nil, set, nil))
+	require.Equal(t, generator.ErrLockExists, err)
+
+	_, err = os.Stat(output)
+	require.True(t, os.IsNotExist(err), "models are not written")
+}
@@ -1,13 +1,25 @@
 package cmd
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
 
 	"github.com/golang-migrate/migrate"
 	_ "github.com/golang-migrate/migrate/database/postgres"
 	_ "github.com/golang-migrate/migrate/source/file"
+	_ "github.com/lib/pq"
 
+	"gopkg.in/src-d/go-kallax.v1"
 	"gopkg.in/src-d/go-kallax.v1/generator"
 	cli "gopkg.in/urfave/cli.v1"
 )
@@ -30,23 +42,65 @@ var Migrate = cli.Command{
 			Name:  "input, i",
 			Usage: "List of directories to scan models from. You can use this flag as many times as you want.",
 		},
+		cli.StringFlag{
+			Name:  "dialect",
+			Usage: "SQL dialect of the migration: postgres or sqlite.",
+			Value: "postgres",
+		},
+		cli.BoolFlag{
+			Name:  "concurrent-indexes",
+			Usage: "Create and drop the indexes of existing tables concurrently, without locking them against writes. These statements are placed after the transaction of the migration.",
+		},
+		cli.BoolFlag{
+			Name:  "interactive",
+			Usage: "Ask whether the columns that look renamed have actually been renamed, instead of dropping them and adding new ones.",
+		},
+		cli.BoolFlag{
+			Name:  "safe",
+			Usage: "Warn about the changes that rewrite whole tables, locking them against reads and writes until they are done.",
+		},
+		cli.BoolFlag{
+			Name:  "go",
+			Usage: "Generate an empty migration written in Go, instead of generating one for the changes in the models. Migrations written in Go are run with kallax.Migrator.",
+		},
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "Report the changes in the models since last migration without writing any file, and fail if they do not comply with the policies in `fail-on`.",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "Format of the report of `check`: text or json.",
+			Value: "text",
+		},
+		cli.StringSliceFlag{
+			Name:  "fail-on",
+			Usage: "Policy the changes reported by `check` must comply with: any (any change), destructive (changes that drop data), manual (changes that need to be made by hand) or rewrite (changes that rewrite whole tables). You can use this flag as many times as you want. By default, the policies are destructive and manual.",
+		},
 	},
 	Subcommands: cli.Commands{
 		Up,
 		Down,
+		Status,
+		Check,
+		Squash,
 	},
 }
 
-var migrationFlags = []cli.Flag{
-	cli.StringFlag{
+var (
+	dirFlag = cli.StringFlag{
 		Name:  "dir, d",
 		Value: "./migrations",
 		Usage: "Directory where your migrations are stored",
-	},
-	cli.StringFlag{
+	}
+	dsnFlag = cli.StringFlag{
 		Name:  "dsn",
 		Usage: "PostgreSQL data source name. Example: `user:pass@localhost:5432/database?sslmode=enable`",
-	},
+	}
+)
+
+var migrationFlags = []cli.Flag{
+	dirFlag,
+	dsnFlag,
 	cli.UintFlag{
 		Name:  "steps, n",
 		Usage: "Number of migrations to run",
@@ -55,16 +109,26 @@ var migrationFlags = []cli.Flag{
 		Name:  "version, v",
 		Usage: "Migrate to a specific version. If `steps` and this flag are given, this will be used.",
 	},
+	cli.DurationFlag{
+		Name:  "lock-timeout",
+		Usage: "Maximum time the statements of the migrations wait to lock a table before failing (e.g. `5s`). By default, they wait indefinitely, blocking the queries that come after them.",
+	},
 }
 
 var Up = cli.Command{
 	Name:   "up",
 	Usage:  "Executes the migrations from the current version until the specified version.",
 	Action: runMigrationAction(upAction),
-	Flags: append(migrationFlags, cli.BoolFlag{
-		Name:  "all",
-		Usage: "If this flag is used, the database will be migrated all the way up.",
-	}),
+	Flags: append(migrationFlags,
+		cli.BoolFlag{
+			Name:  "all",
+			Usage: "If this flag is used, the database will be migrated all the way up.",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Print the SQL of the migrations that would be run, without running them.",
+		},
+	),
 }
 
 var Down = cli.Command{
@@ -74,6 +138,298 @@ var Down = cli.Command{
 	Flags:  migrationFlags,
 }
 
+var Status = cli.Command{
+	Name:   "status",
+	Usage:  "Lists the migrations that have been run and the ones that are pending.",
+	Action: statusAction,
+	Flags: []cli.Flag{
+		dirFlag,
+		dsnFlag,
+	},
+}
+
+var Check = cli.Command{
+	Name:   "check",
+	Usage:  "Checks that the schema of the database matches the lock and, if `input` is given, that there are no changes in the models since last migration.",
+	Action: checkAction,
+	Flags: []cli.Flag{
+		dirFlag,
+		dsnFlag,
+		cli.StringSliceFlag{
+			Name:  "input, i",
+			Usage: "List of directories to scan models from. You can use this flag as many times as you want.",
+		},
+		cli.StringSliceFlag{
+			Name:  "ignore",
+			Usage: "Tables of the database that are not managed by kallax and must be ignored. You can use this flag as many times as you want.",
+		},
+	},
+}
+
+var Squash = cli.Command{
+	Name:   "squash",
+	Usage:  "Replaces the migrations up to the given version with a single migration that creates the schema at that version from scratch.",
+	Action: squashAction,
+	Flags: []cli.Flag{
+		dirFlag,
+		cli.UintFlag{
+			Name:  "until, u",
+			Usage: "Version of the last migration to squash.",
+		},
+		cli.StringFlag{
+			Name:  "name, n",
+			Usage: "Descriptive name for the squashed migration",
+			Value: "baseline",
+		},
+		cli.StringFlag{
+			Name:  "lock, l",
+			Usage: "Lock file with the schema at the version of `until`, such as the one in version control at that point. It is only needed if there are migrations after that version.",
+		},
+		cli.StringSliceFlag{
+			Name:  "dsn",
+			Usage: "Database to check before squashing, which can not be at the version of a squashed migration other than the last one. You can use this flag as many times as you want.",
+		},
+	},
+}
+
+// migrationsTable is the table where the version of the migrations run is
+// stored.
+const migrationsTable = "schema_migrations"
+
+func checkAction(c *cli.Context) error {
+	dir := c.String("dir")
+	ok, err := isDirectory(dir)
+	if err != nil {
+		return fmt.Errorf("kallax: cannot check if `dir` is a directory: %s", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("kallax: argument `dir` must be a valid directory")
+	}
+
+	pkgs, err := processPackages(c.StringSlice("input"))
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s", c.String("dsn")))
+	if err != nil {
+		return fmt.Errorf("kallax: unable to open a connection with the database: %s", err)
+	}
+	defer db.Close()
+
+	ignore := append(c.StringSlice("ignore"), migrationsTable)
+	return generator.NewMigrationGenerator("", dir).Check(db, ignore, pkgs...)
+}
+
+func statusAction(c *cli.Context) error {
+	dir := c.String("dir")
+	ok, err := isDirectory(dir)
+	if err != nil {
+		return fmt.Errorf("kallax: cannot check if `dir` is a directory: %s", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("kallax: argument `dir` must be a valid directory")
+	}
+
+	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s", c.String("dsn")))
+	if err != nil {
+		return fmt.Errorf("kallax: unable to open a connection with the database: %s", err)
+	}
+	defer db.Close()
+
+	m := kallax.NewMigrator(db, dir)
+	migrations, err := m.Migrations()
+	if err != nil {
+		return err
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	if _, err := kallax.MigrationIndex(migrations, version); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STATUS\tVERSION\tGENERATED AT\tNAME")
+	for _, mig := range migrations {
+		status := "pending"
+		if mig.Version < version || (mig.Version == version && !dirty) {
+			status = "applied"
+		} else if mig.Version == version {
+			status = "failed"
+		}
+
+		name := mig.Name
+		if mig.IsGo() {
+			name += " (Go)"
+		}
+
+		generatedAt := time.Unix(int64(mig.Version), 0).UTC().Format("2006-01-02 15:04:05")
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", status, mig.Version, generatedAt, name)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if version == 0 {
+		fmt.Println("\nNo migration has been run yet.")
+	} else if dirty {
+		fmt.Printf("\nDatabase is at version %d, but its migration failed and needs to be fixed by hand.\n", version)
+	} else {
+		fmt.Printf("\nDatabase is at version %d.\n", version)
+	}
+	return nil
+}
+
+// dryRunUp prints the SQL of the migrations upAction would run with the
+// same arguments.
+func dryRunUp(db *sql.DB, dir string, steps, version uint, all bool) error {
+	m := kallax.NewMigrator(db, dir)
+	migrations, err := m.Migrations()
+	if err != nil {
+		return err
+	}
+
+	current, _, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	from, err := kallax.MigrationIndex(migrations, current)
+	if err != nil {
+		return err
+	}
+
+	var to int
+	if all {
+		to = len(migrations) - 1
+	} else if version > 0 {
+		if to, err = kallax.MigrationIndex(migrations, version); err != nil {
+			return fmt.Errorf("kallax: there is no migration with version %d", version)
+		}
+	} else if steps > 0 {
+		to = from + int(steps)
+		if to >= len(migrations) {
+			to = len(migrations) - 1
+		}
+	} else {
+		return fmt.Errorf("WARN: No `version` or `steps` provided")
+	}
+
+	if from == to {
+		fmt.Println("-- The database is up to date, no migration would be run.")
+		return nil
+	}
+
+	for i := from + 1; i <= to; i++ {
+		stmts, err := migrations[i].UpSQL()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("-- %d_%s.up.sql\n%s\n", migrations[i].Version, migrations[i].Name, stmts)
+	}
+
+	// a lower version is migrated down
+	for i := from; i > to; i-- {
+		stmts, err := migrations[i].DownSQL()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("-- %d_%s.down.sql\n%s\n", migrations[i].Version, migrations[i].Name, stmts)
+	}
+
+	return nil
+}
+
+// withLockTimeout returns the given data source name with the given lock
+// timeout, which is set for every connection opened with it.
+func withLockTimeout(dsn string, timeout time.Duration) string {
+	if timeout <= 0 {
+		return dsn
+	}
+
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return fmt.Sprintf("%s%slock_timeout=%d", dsn, sep, timeout/time.Millisecond)
+}
+
+func squashAction(c *cli.Context) error {
+	dir := c.String("dir")
+	ok, err := isDirectory(dir)
+	if err != nil {
+		return fmt.Errorf("kallax: cannot check if `dir` is a directory: %s", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("kallax: argument `dir` must be a valid directory")
+	}
+
+	until := c.Uint("until")
+	if until == 0 {
+		return fmt.Errorf("kallax: no `until` provided")
+	}
+
+	var schema *generator.DBSchema
+	if lock := c.String("lock"); lock != "" {
+		content, err := ioutil.ReadFile(lock)
+		if err != nil {
+			return fmt.Errorf("kallax: unable to read `lock`: %s", err)
+		}
+
+		schema = new(generator.DBSchema)
+		if err := json.Unmarshal(content, schema); err != nil {
+			return fmt.Errorf("kallax: unable to parse `lock`: %s", err)
+		}
+	}
+
+	for _, dsn := range c.StringSlice("dsn") {
+		if err := checkSquashable(dsn, dir, until); err != nil {
+			return err
+		}
+	}
+
+	removed, err := generator.NewMigrationGenerator(c.String("name"), dir).Squash(int64(until), schema)
+	for _, f := range removed {
+		fmt.Printf("Removed %s\n", f)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("The migrations up to version %d have been squashed. Databases at the version of one of the removed migrations need to run them up to version %d before they can be migrated.\n", until, until)
+	return nil
+}
+
+// checkSquashable returns an error if the database with the given DSN is at
+// the version of a migration that would be squashed and removed, other than
+// the last one, as it could not be migrated afterwards.
+func checkSquashable(dsn, dir string, until uint) error {
+	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s", dsn))
+	if err != nil {
+		return fmt.Errorf("kallax: unable to open a connection with the database: %s", err)
+	}
+	defer db.Close()
+
+	version, _, err := kallax.NewMigrator(db, dir).Version()
+	if err != nil {
+		return err
+	}
+
+	if version > 0 && version < until {
+		return fmt.Errorf("kallax: a database is at version %d, which would be squashed. Migrate it up to version %d before squashing", version, until)
+	}
+	return nil
+}
+
 func upAction(m *migrate.Migrate, steps, version uint, all bool) error {
 	if all {
 		if err := m.Up(); err != nil {
@@ -126,7 +482,7 @@ func runMigrationAction(fn runMigrationFunc) cli.ActionFunc {
 	return func(c *cli.Context) error {
 		var (
 			dir     = c.String("dir")
-			dsn     = c.String("dsn")
+			dsn     = withLockTimeout(c.String("dsn"), c.Duration("lock-timeout"))
 			steps   = c.Uint("steps")
 			version = c.Uint("version")
 			all     = c.Bool("all")
@@ -146,6 +502,29 @@ func runMigrationAction(fn runMigrationFunc) cli.ActionFunc {
 			return fmt.Errorf("kallax: cannot get absolute path of `dir`: %s", err)
 		}
 
+		goFiles, err := filepath.Glob(filepath.Join(dir, "*.migration.go"))
+		if err != nil {
+			return fmt.Errorf("kallax: cannot look for Go migrations in `dir`: %s", err)
+		}
+
+		if len(goFiles) > 0 {
+			return fmt.Errorf("kallax: there are migrations written in Go in `dir`, which can only be run from Go with kallax.Migrator")
+		}
+
+		db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s", dsn))
+		if err != nil {
+			return fmt.Errorf("kallax: unable to open a connection with the database: %s", err)
+		}
+		defer db.Close()
+
+		if c.Bool("dry-run") {
+			return dryRunUp(db, dir, steps, version, all)
+		}
+
+		if err := checkVersion(kallax.NewMigrator(db, dir)); err != nil {
+			return err
+		}
+
 		m, err := migrate.New(pathToFileURL(dir), fmt.Sprintf("postgres://%s", dsn))
 		if err != nil {
 			return fmt.Errorf("kallax: unable to open a connection with the database: %s", err)
@@ -155,6 +534,23 @@ func runMigrationAction(fn runMigrationFunc) cli.ActionFunc {
 	}
 }
 
+// checkVersion returns an error if the database is at a version with no
+// migration, such as one that was squashed, which can not be migrated.
+func checkVersion(m *kallax.Migrator) error {
+	migrations, err := m.Migrations()
+	if err != nil {
+		return err
+	}
+
+	version, _, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	_, err = kallax.MigrationIndex(migrations, version)
+	return err
+}
+
 func pathToFileURL(path string) string {
 	if !filepath.IsAbs(path) {
 		var err error
@@ -166,31 +562,91 @@ func pathToFileURL(path string) string {
 	return fmt.Sprintf("file://%s", filepath.ToSlash(path))
 }
 
-func migrateAction(c *cli.Context) error {
-	dirs := c.StringSlice("input")
-	dir := c.String("out")
-	name := c.String("name")
+// goPackageName returns the name of the Go package in the given directory
+// or, if there is none, a name for it based on the name of the directory.
+func goPackageName(dir string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", fmt.Errorf("kallax: cannot look for Go files in %s: %s", dir, err)
+	}
 
+	for _, f := range files {
+		file, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return "", fmt.Errorf("kallax: cannot parse %s: %s", f, err)
+		}
+
+		if name := file.Name.Name; !strings.HasSuffix(name, "_test") {
+			return name, nil
+		}
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("kallax: cannot get absolute path of %s: %s", dir, err)
+	}
+
+	var name []rune
+	for _, r := range strings.ToLower(filepath.Base(abs)) {
+		if unicode.IsLetter(r) || (unicode.IsDigit(r) && len(name) > 0) || (r == '_' && len(name) > 0) {
+			name = append(name, r)
+		}
+	}
+
+	if len(name) == 0 {
+		return "migrations", nil
+	}
+	return string(name), nil
+}
+
+// processPackages processes the packages of models in the given
+// directories.
+func processPackages(dirs []string) ([]*generator.Package, error) {
 	var pkgs []*generator.Package
 	for _, dir := range dirs {
 		ok, err := isDirectory(dir)
 		if err != nil {
-			return fmt.Errorf("kallax: cannot check directory in `input`: %s", err)
+			return nil, fmt.Errorf("kallax: cannot check directory in `input`: %s", err)
 		}
 
 		if !ok {
-			return fmt.Errorf("kallax: `input` must be a valid directory")
+			return nil, fmt.Errorf("kallax: `input` must be a valid directory")
 		}
 
 		p := generator.NewProcessor(dir, nil)
 		p.Silent()
 		pkg, err := p.Do()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		pkgs = append(pkgs, pkg)
 	}
+	return pkgs, nil
+}
+
+// defaultMigrationPolicies are the policies the changes reported by
+// `kallax migrate --check` must comply with by default.
+var defaultMigrationPolicies = []string{"destructive", "manual"}
+
+// dialects are the SQL dialects migrations can be generated for.
+var dialects = []kallax.Dialect{kallax.PostgreSQL, kallax.SQLite}
+
+// dialectByName returns the SQL dialect with the given name.
+func dialectByName(name string) (kallax.Dialect, error) {
+	var names []string
+	for _, d := range dialects {
+		if d.Name() == name {
+			return d, nil
+		}
+		names = append(names, d.Name())
+	}
+	return nil, fmt.Errorf("kallax: unknown dialect %q, it must be one of: %s", name, strings.Join(names, ", "))
+}
+
+func migrateAction(c *cli.Context) error {
+	dir := c.String("out")
+	name := c.String("name")
 
 	ok, err := isDirectory(dir)
 	if err != nil {
@@ -202,10 +658,64 @@ func migrateAction(c *cli.Context) error {
 	}
 
 	g := generator.NewMigrationGenerator(name, dir)
+	if c.Bool("go") {
+		pkg, err := goPackageName(dir)
+		if err != nil {
+			return err
+		}
+
+		file, err := g.GenerateGo(pkg)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Migration written in %s. Run it with kallax.Migrator, which also runs the SQL migrations.\n", file)
+		return nil
+	}
+
+	pkgs, err := processPackages(c.StringSlice("input"))
+	if err != nil {
+		return err
+	}
+
+	dialect, err := dialectByName(c.String("dialect"))
+	if err != nil {
+		return err
+	}
+	g.UseDialect(dialect)
+
+	if c.Bool("concurrent-indexes") {
+		g.ConcurrentIndexes()
+	}
+
+	if c.Bool("safe") {
+		g.SafeMode()
+	}
+
+	check := c.Bool("check")
+	if !check && c.String("format") != "text" {
+		return fmt.Errorf("kallax: `format` can only be used with `check`")
+	}
+
+	if c.Bool("interactive") {
+		if check {
+			return fmt.Errorf("kallax: `interactive` can not be used with `check`")
+		}
+		g.Interactive(os.Stdin, os.Stdout)
+	}
+
 	migration, err := g.Build(pkgs...)
 	if err != nil {
 		return err
 	}
 
+	if check {
+		policies := c.StringSlice("fail-on")
+		if len(policies) == 0 {
+			policies = defaultMigrationPolicies
+		}
+		return g.Review(migration, c.String("format"), policies...)
+	}
+
 	return g.Generate(migration)
 }
@@ -3,11 +3,14 @@
 package cmd
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-kallax.v1"
 )
 
 func TestPathToFileURL(t *testing.T) {
@@ -26,3 +29,52 @@ func TestPathToFileURL(t *testing.T) {
 		require.Equal(t, tt.expected, pathToFileURL(tt.input), tt.input)
 	}
 }
+
+func TestGoPackageName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kallax-go-package")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	migrations := filepath.Join(dir, "my-migrations")
+	require.NoError(t, os.Mkdir(migrations, 0755))
+
+	name, err := goPackageName(migrations)
+	require.NoError(t, err)
+	require.Equal(t, "mymigrations", name)
+
+	err = ioutil.WriteFile(filepath.Join(migrations, "doc.go"), []byte("package db\n"), 0644)
+	require.NoError(t, err)
+
+	name, err = goPackageName(migrations)
+	require.NoError(t, err)
+	require.Equal(t, "db", name)
+}
+
+func TestWithLockTimeout(t *testing.T) {
+	cases := []struct {
+		dsn      string
+		timeout  time.Duration
+		expected string
+	}{
+		{"localhost/db", 0, "localhost/db"},
+		{"localhost/db", 5 * time.Second, "localhost/db?lock_timeout=5000"},
+		{"localhost/db?sslmode=disable", 500 * time.Millisecond, "localhost/db?sslmode=disable&lock_timeout=500"},
+	}
+
+	for _, tt := range cases {
+		require.Equal(t, tt.expected, withLockTimeout(tt.dsn, tt.timeout), tt.dsn)
+	}
+}
+
+func TestDialectByName(t *testing.T) {
+	d, err := dialectByName("sqlite")
+	require.NoError(t, err)
+	require.Equal(t, kallax.SQLite, d)
+
+	d, err = dialectByName("postgres")
+	require.NoError(t, err)
+	require.Equal(t, kallax.PostgreSQL, d)
+
+	_, err = dialectByName("mysql")
+	require.EqualError(t, err, `kallax: unknown dialect "mysql", it must be one of: postgres, sqlite`)
+}
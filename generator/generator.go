@@ -3,18 +3,25 @@
 package generator // import "gopkg.in/src-d/go-kallax.v1/generator"
 
 import (
+	"bufio"
 	"bytes"
+	"database/sql"
 	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
+	"gopkg.in/src-d/go-kallax.v1"
 )
 
 // Generator is in charge of generating files for packages.
@@ -59,6 +66,10 @@ func (g *Generator) writeFile(pkg *Package) (err error) {
 	return Base.Execute(file, pkg)
 }
 
+// ErrDrift is returned when the schema of the database or the models does
+// not match the lock.
+var ErrDrift = errors.New("kallax: the schema does not match the lock")
+
 // Timestamper is a function that returns the current time.
 type Timestamper func() time.Time
 
@@ -67,6 +78,21 @@ type MigrationGenerator struct {
 	name string
 	dir  string
 	now  Timestamper
+	// dialect is the SQL dialect of the migrations.
+	dialect kallax.Dialect
+	// concurrentIndexes reports whether the indexes are created and dropped
+	// concurrently.
+	concurrentIndexes bool
+	// safe reports whether the changes that rewrite whole tables are
+	// reported with a warning.
+	safe bool
+	// in and out are used to ask the user whether the likely renames of
+	// columns are actual renames. If in is nil, the user is not asked.
+	in  *bufio.Reader
+	out io.Writer
+	// likelyRenames are the likely renames found in the last build that were
+	// not confirmed by the user.
+	likelyRenames []*LikelyRename
 }
 
 type migrationFileType string
@@ -75,12 +101,46 @@ const (
 	migrationUp   = migrationFileType("up.sql")
 	migrationDown = migrationFileType("down.sql")
 	migrationLock = migrationFileType("lock.json")
+	migrationGo   = migrationFileType("migration.go")
 )
 
 // NewMigrationGenerator returns a new migration generator with the given
 // migrations directory.
 func NewMigrationGenerator(name, dir string) *MigrationGenerator {
-	return &MigrationGenerator{slugify(name), dir, time.Now}
+	return &MigrationGenerator{name: slugify(name), dir: dir, now: time.Now, dialect: kallax.PostgreSQL}
+}
+
+// UseDialect makes the generator write the migrations in the given SQL
+// dialect instead of PostgreSQL. The changes the dialect can not make to
+// existing tables, such as altering their columns in SQLite, are reported as
+// manual changes.
+func (g *MigrationGenerator) UseDialect(dialect kallax.Dialect) {
+	g.dialect = dialect
+}
+
+// ConcurrentIndexes makes the generated migrations create and drop the
+// indexes of existing tables concurrently, so the tables are not locked
+// against writes while the indexes are built. As this can not be done in a
+// transaction, these statements are placed after the transaction of the
+// migration. It has no effect in the dialects that can not do it.
+func (g *MigrationGenerator) ConcurrentIndexes() {
+	g.concurrentIndexes = true
+}
+
+// SafeMode makes the generator warn about the changes that rewrite whole
+// tables, such as most changes of the type of a column. Tables are locked
+// against reads and writes while they are rewritten, which may take a long
+// time for big tables.
+func (g *MigrationGenerator) SafeMode() {
+	g.safe = true
+}
+
+// Interactive makes the generator ask the user, reading the answers from the
+// given reader, whether the columns that look renamed have actually been
+// renamed instead of dropping them and adding new ones.
+func (g *MigrationGenerator) Interactive(in io.Reader, out io.Writer) {
+	g.in = bufio.NewReader(in)
+	g.out = out
 }
 
 // Build creates a new migration from a set of scanned packages.
@@ -90,12 +150,67 @@ func (g *MigrationGenerator) Build(pkgs ...*Package) (*Migration, error) {
 		return nil, err
 	}
 
-	new, err := SchemaFromPackages(pkgs...)
+	new, err := DialectSchemaFromPackages(g.dialect, pkgs...)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewMigration(old, new)
+	g.likelyRenames = nil
+	for _, r := range LikelyRenames(old, new) {
+		ok, err := g.confirmRename(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			r.New.OldName = r.Old.Name
+		} else {
+			g.likelyRenames = append(g.likelyRenames, r)
+		}
+	}
+
+	migration, err := NewMigration(old, new)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := migrationDialectOf(g.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	migration.Up = d.supported(migration.Up)
+	migration.Down = d.supported(migration.Down)
+	if g.concurrentIndexes && d.concurrentIndexes {
+		migration.Up = migration.Up.withConcurrentIndexes()
+		migration.Down = migration.Down.withConcurrentIndexes()
+	}
+	return migration, nil
+}
+
+// confirmRename asks the user whether the given likely rename is an actual
+// rename, if the generator is interactive.
+func (g *MigrationGenerator) confirmRename(r *LikelyRename) (bool, error) {
+	if g.in == nil {
+		return false, nil
+	}
+
+	fmt.Fprintf(
+		g.out,
+		"The column %q of table %q has been removed and %q, of the same type, added. Has it been renamed? [y/N] ",
+		r.Old.Name, r.Table, r.New.Name,
+	)
+
+	answer, err := g.in.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
 }
 
 // Generate will generate the given migration.
@@ -107,6 +222,48 @@ func (g *MigrationGenerator) Generate(migration *Migration) error {
 	return g.writeMigration(migration)
 }
 
+// GenerateGo writes an empty migration written in Go in the package with the
+// given name, which is run along with the SQL migrations by kallax.Migrator.
+// It returns the name of the file written.
+func (g *MigrationGenerator) GenerateGo(pkg string) (string, error) {
+	t := g.now()
+	file := g.migrationFile(migrationGo, t)
+	migration := &goMigration{pkg: pkg, version: t.Unix(), name: g.name}
+	if err := g.createFile(file, migration); err != nil {
+		return "", err
+	}
+	return file, nil
+}
+
+type goMigration struct {
+	pkg     string
+	version int64
+	name    string
+}
+
+const goMigrationTpl = `package %[1]s
+
+import kallax "gopkg.in/src-d/go-kallax.v1"
+
+func init() {
+	kallax.RegisterMigration(%[2]d, %[3]q, up%[2]d, down%[2]d)
+}
+
+// up%[2]d runs the migration in a transaction of the given store.
+func up%[2]d(store *kallax.Store) error {
+	return nil
+}
+
+// down%[2]d reverts the migration in a transaction of the given store.
+func down%[2]d(store *kallax.Store) error {
+	return nil
+}
+`
+
+func (m *goMigration) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf(goMigrationTpl, m.pkg, m.version, m.name)), nil
+}
+
 func (g *MigrationGenerator) printMigrationInfo(migration *Migration) {
 	if len(migration.Up) == 0 {
 		fmt.Println("There are no changes since last migration. Nothing will be generated.")
@@ -114,18 +271,206 @@ func (g *MigrationGenerator) printMigrationInfo(migration *Migration) {
 	}
 
 	fmt.Println("There are changes since last migration.\n\nThese are the proposed changes:")
-	for _, change := range migration.Up {
+	g.printChanges(migration.Up)
+
+	for _, r := range g.likelyRenames {
+		color.New(color.FgYellow, color.Bold).Printf("\n    HINT: ")
+		fmt.Printf(
+			"the column %q of table %q looks renamed to %q. If it is, declare it with the `oldname` struct tag or run the command with --interactive, otherwise its data will be lost.\n",
+			r.Old.Name, r.Table, r.New.Name,
+		)
+	}
+}
+
+func (g *MigrationGenerator) printChanges(cs ChangeSet) {
+	for _, change := range cs {
 		c := color.FgGreen
 		switch change.(type) {
-		case *DropColumn, *DropTable:
+		case *DropColumn, *DropTable, *DropIndex, *DropForeignKey, *DropUnique, *DropCheck, *DropEnum:
 			c = color.FgRed
 		case *ManualChange:
 			c = color.FgYellow
 		}
-		color := color.New(c, color.Bold)
-		color.Printf(" => ")
+		color.New(c, color.Bold).Printf(" => ")
 		fmt.Println(change.String())
+
+		if g.safe && rewritesTable(change) {
+			color.New(color.FgYellow, color.Bold).Printf("    WARNING: ")
+			fmt.Println("this change rewrites the whole table, which will be locked against reads and writes until it is done.")
+		}
+	}
+}
+
+// Check compares the schema of the given database with the lock, ignoring
+// the given tables, and, if any packages are given, the schema of their
+// models with the lock as well. The differences found are reported and, if
+// there are any, ErrDrift is returned.
+func (g *MigrationGenerator) Check(db *sql.DB, ignore []string, pkgs ...*Package) error {
+	lock, err := g.LoadLock()
+	if err != nil {
+		return err
+	}
+
+	live, err := IntrospectSchema(db, ignore...)
+	if err != nil {
+		return err
 	}
+
+	var drift bool
+	if cs := SchemaDrift(lock, live); len(cs) > 0 {
+		drift = true
+		fmt.Println("The database does not match the lock.\n\nThese are the changes it needs to match it:")
+		g.printChanges(cs)
+	} else {
+		fmt.Println("The database matches the lock.")
+	}
+
+	if len(pkgs) > 0 {
+		models, err := SchemaFromPackages(pkgs...)
+		if err != nil {
+			return err
+		}
+
+		if cs := SchemaDiff(lock, models); len(cs) > 0 {
+			drift = true
+			fmt.Println("\nThere are changes in the models since last migration:")
+			g.printChanges(cs)
+		} else {
+			fmt.Println("There are no changes in the models since last migration.")
+		}
+	}
+
+	if drift {
+		return ErrDrift
+	}
+	return nil
+}
+
+// migrationFileName matches the names of the files of migrations, which start
+// with their version.
+var migrationFileName = regexp.MustCompile(`^([0-9]+)_.*\.(up\.sql|down\.sql|migration\.go)$`)
+
+// Squash replaces all the migrations up to the given version, which must be
+// the version of one of them, with a single migration with the same version
+// that creates the given schema from scratch. The schema must be the one of
+// the database at that version. If it is nil, the lock is used, which is
+// only possible if there are no SQL migrations after the given version.
+// Databases that have already run the squashed migrations are at the given
+// version or a later one, so they do not run the new migration. Its down
+// migration drops the whole schema, so databases can still be migrated down
+// below that version. The files removed are returned.
+func (g *MigrationGenerator) Squash(until int64, schema *DBSchema) ([]string, error) {
+	files, err := ioutil.ReadDir(g.dir)
+	if err != nil {
+		return nil, fmt.Errorf("kallax: unable to read the migrations directory: %s", err)
+	}
+
+	var squashed []string
+	var found, later bool
+	for _, f := range files {
+		match := migrationFileName.FindStringSubmatch(f.Name())
+		if f.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("kallax: invalid version of migration %s: %s", f.Name(), err)
+		}
+
+		if version <= until {
+			// the Go code of the migration would be lost
+			if match[2] == string(migrationGo) {
+				return nil, fmt.Errorf("kallax: migration %s is written in Go and can not be squashed, squash only the migrations before it", f.Name())
+			}
+			squashed = append(squashed, filepath.Join(g.dir, f.Name()))
+			found = found || version == until
+		} else if match[2] != string(migrationGo) {
+			later = true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("kallax: there is no migration with version %d", until)
+	}
+
+	if schema == nil {
+		if later {
+			return nil, fmt.Errorf("kallax: the lock is the schema after the last migration, not at version %d. The schema at that version is needed to squash the migrations up to it", until)
+		}
+
+		if schema, err = g.LoadLock(); err != nil {
+			return nil, err
+		}
+	}
+
+	migration, err := NewMigration(new(DBSchema), schema)
+	if err != nil {
+		return nil, err
+	}
+
+	upFile := g.migrationFile(migrationUp, time.Unix(until, 0))
+	if err := g.createFile(upFile, migration.Up); err != nil {
+		return nil, err
+	}
+
+	downFile := g.migrationFile(migrationDown, time.Unix(until, 0))
+	if err := g.createFile(downFile, migration.Down); err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, f := range squashed {
+		if f == upFile || f == downFile {
+			continue
+		}
+
+		if err := os.Remove(f); err != nil {
+			return removed, fmt.Errorf("kallax: unable to remove squashed migration %s: %s", f, err)
+		}
+		removed = append(removed, f)
+	}
+
+	return removed, nil
+}
+
+// ErrLockExists is returned when the lock is seeded but it already exists.
+var ErrLockExists = errors.New("kallax: the lock already exists")
+
+// SeedLock writes the lock with the schema of the models of the given
+// packages, so migrations can be generated for an existing database whose
+// schema, the given one, was not created by them. The lock must not exist
+// yet. The differences between the database and the models are reported, as
+// no migration will make them, so they need to be fixed by hand.
+func (g *MigrationGenerator) SeedLock(live *DBSchema, pkgs ...*Package) error {
+	if g.HasLock() {
+		return ErrLockExists
+	}
+	lockFile := filepath.Join(g.dir, string(migrationLock))
+
+	models, err := SchemaFromPackages(pkgs...)
+	if err != nil {
+		return err
+	}
+
+	if err := g.createFile(lockFile, models); err != nil {
+		return err
+	}
+
+	if cs := SchemaDrift(models, live); len(cs) > 0 {
+		fmt.Println("The database does not match the models.\n\nThese are the changes it needs to match them, which no migration will make:")
+		g.printChanges(cs)
+	} else {
+		fmt.Println("The models match the database.")
+	}
+
+	return nil
+}
+
+// HasLock reports whether the migrations directory already has a lock.
+func (g *MigrationGenerator) HasLock() bool {
+	_, err := os.Stat(filepath.Join(g.dir, string(migrationLock)))
+	return err == nil
 }
 
 // LoadLock loads the lock file.
@@ -1,13 +1,20 @@
 package generator
 
 import (
+	"bytes"
+	"database/sql"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-kallax.v1"
 )
 
 func TestMigrationGeneratorLoadLock(t *testing.T) {
@@ -50,6 +57,112 @@ func TestMigrationGeneratorBuild(t *testing.T) {
 	require.NotNil(t, migration)
 }
 
+const renamesSourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type User struct {
+	kallax.Model ` + "`table:\"users\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	FullName string
+}
+`
+
+func TestMigrationGeneratorBuild_Interactive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kallax-migration-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	content, err := mkSchema(mkTable(
+		"users",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("name", TextColumn, false, true, nil),
+	)).MarshalText()
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, string(migrationLock)), content, 0755)
+	require.NoError(t, err)
+
+	pkg, err := processFixture(renamesSourceFixture)
+	require.NoError(t, err)
+
+	cases := []struct {
+		answer   string
+		expected ChangeSet
+	}{
+		{
+			"y\n",
+			ChangeSet{
+				&RenameColumn{Table: "users", Name: "name", NewName: "full_name"},
+			},
+		},
+		{
+			"n\n",
+			ChangeSet{
+				&DropColumn{Table: "users", Name: "name"},
+				&AddColumn{Table: "users", Column: mkCol("full_name", TextColumn, false, true, nil)},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		var out bytes.Buffer
+		g := NewMigrationGenerator("migration", dir)
+		g.Interactive(strings.NewReader(c.answer), &out)
+
+		migration, err := g.Build(pkg)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, migration.Up, c.answer)
+		require.Contains(t, out.String(), `The column "name" of table "users" has been removed and "full_name"`)
+	}
+}
+
+func TestMigrationGeneratorBuild_SQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kallax-migration-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pkg, err := processFixture(enumsSourceFixture)
+	require.NoError(t, err)
+
+	g := NewMigrationGenerator("migration", dir)
+	g.UseDialect(kallax.SQLite)
+	g.ConcurrentIndexes()
+	migration, err := g.Build(pkg)
+	require.NoError(t, err)
+
+	up, err := migration.Up.MarshalText()
+	require.NoError(t, err)
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(string(up))
+	require.NoError(t, err)
+
+	insert := "INSERT INTO tasks (status, priority, flags, kind, size, month) VALUES (?, 1, 1, ?, 2, 1)"
+	_, err = db.Exec(insert, "active", "bug")
+	require.NoError(t, err)
+	_, err = db.Exec(insert, "active", "chore")
+	require.Error(t, err)
+
+	lock, err := migration.Lock.MarshalText()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, string(migrationLock)), lock, 0755))
+
+	pkg, err = processFixture(strings.Replace(enumsSourceFixture, "bug, feature", "bug, feature, chore", 1))
+	require.NoError(t, err)
+
+	migration, err = g.Build(pkg)
+	require.NoError(t, err)
+	require.Len(t, migration.Up, 2)
+	for _, c := range migration.Up {
+		require.IsType(t, new(ManualChange), c)
+	}
+}
+
 func TestMigrationGeneratorGenerate(t *testing.T) {
 	old := mkSchema(table1)
 	new := mkSchema(table1, table2)
@@ -84,6 +197,145 @@ func TestMigrationGeneratorGenerate(t *testing.T) {
 	require.Equal(t, string(expected), string(content))
 }
 
+func TestMigrationGeneratorGenerateGo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kallax-migration-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	g := NewMigrationGenerator("backfill scores", dir)
+	g.now = func() time.Time {
+		return time.Unix(1500000000, 0)
+	}
+
+	file, err := g.GenerateGo("migrations")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "1500000000_backfill_scores.migration.go"), file)
+
+	content, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "package migrations\n")
+	require.Contains(t, string(content), `kallax.RegisterMigration(1500000000, "backfill_scores", up1500000000, down1500000000)`)
+
+	_, err = parser.ParseFile(token.NewFileSet(), file, content, 0)
+	require.NoError(t, err)
+}
+
+func TestMigrationGeneratorSquash(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "kallax-migration-generator")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	for _, f := range []string{
+		"1_initial.up.sql", "1_initial.down.sql",
+		"2_posts.up.sql", "2_posts.down.sql",
+		"3_backfill.migration.go",
+		"4_tags.up.sql", "4_tags.down.sql",
+	} {
+		require.NoError(ioutil.WriteFile(filepath.Join(dir, f), nil, 0644))
+	}
+
+	schema := mkSchema(
+		mkTable(
+			"posts",
+			mkCol("id", SerialColumn, true, true, nil),
+			mkCol("user_id", BigIntColumn, false, true, mkRef("users", "id", false)),
+		),
+		mkTable("users", mkCol("id", SerialColumn, true, true, nil)),
+	)
+
+	g := NewMigrationGenerator("baseline", dir)
+	_, err = g.Squash(5, schema)
+	require.Error(err)
+
+	_, err = g.Squash(2, nil)
+	require.Error(err, "the lock is not the schema at version 2")
+
+	removed, err := g.Squash(2, schema)
+	require.NoError(err)
+	require.Equal([]string{
+		filepath.Join(dir, "1_initial.down.sql"),
+		filepath.Join(dir, "1_initial.up.sql"),
+		filepath.Join(dir, "2_posts.down.sql"),
+		filepath.Join(dir, "2_posts.up.sql"),
+	}, removed)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(err)
+	require.Equal([]string{
+		filepath.Join(dir, "2_baseline.down.sql"),
+		filepath.Join(dir, "2_baseline.up.sql"),
+		filepath.Join(dir, "3_backfill.migration.go"),
+		filepath.Join(dir, "4_tags.down.sql"),
+		filepath.Join(dir, "4_tags.up.sql"),
+	}, files)
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "2_baseline.up.sql"))
+	require.NoError(err)
+	require.True(
+		strings.Index(string(content), "CREATE TABLE users") < strings.Index(string(content), "CREATE TABLE posts"),
+		"users must be created before posts",
+	)
+
+	content, err = ioutil.ReadFile(filepath.Join(dir, "2_baseline.down.sql"))
+	require.NoError(err)
+	require.True(
+		strings.Index(string(content), "DROP TABLE posts") < strings.Index(string(content), "DROP TABLE users"),
+		"posts must be dropped before users",
+	)
+	require.Contains(string(content), "DROP TABLE posts")
+
+	lock, err := schema.MarshalText()
+	require.NoError(err)
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, string(migrationLock)), lock, 0644))
+
+	_, err = g.Squash(4, nil)
+	require.Error(err, "3_backfill is written in Go")
+	require.Len(mustGlob(t, filepath.Join(dir, "*")), 6, "nothing is written nor removed")
+
+	require.NoError(os.Remove(filepath.Join(dir, "3_backfill.migration.go")))
+	removed, err = g.Squash(4, nil)
+	require.NoError(err)
+	require.Len(removed, 4)
+	require.Equal([]string{
+		filepath.Join(dir, "4_baseline.down.sql"),
+		filepath.Join(dir, "4_baseline.up.sql"),
+		filepath.Join(dir, string(migrationLock)),
+	}, mustGlob(t, filepath.Join(dir, "*")))
+}
+
+func mustGlob(t *testing.T, pattern string) []string {
+	files, err := filepath.Glob(pattern)
+	require.NoError(t, err)
+	return files
+}
+
+func TestMigrationGeneratorSeedLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kallax-migration-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pkg, err := processFixture(renamesSourceFixture)
+	require.NoError(t, err)
+
+	live := mkSchema(mkTable(
+		"users",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("full_name", TextColumn, false, true, nil),
+	))
+
+	g := NewMigrationGenerator("migration", dir)
+	require.False(t, g.HasLock())
+	require.NoError(t, g.SeedLock(live, pkg))
+	require.True(t, g.HasLock())
+
+	lock, err := g.LoadLock()
+	require.NoError(t, err)
+	require.Equal(t, live, lock)
+
+	require.Equal(t, ErrLockExists, g.SeedLock(live, pkg))
+}
+
 func TestSlugify(t *testing.T) {
 	cases := []struct {
 		input    string
@@ -0,0 +1,472 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ImportModels returns the source code of a Go package with the given name
+// containing a model for each table of the given schema, which is usually
+// introspected from an existing database, and the names of the tables that
+// could not be imported because they do not have a primary key of a single
+// column with a type kallax can use as identifier.
+// Columns are mapped to the Go types that are mapped to their SQL types in
+// migrations. Columns of other types are stored in strings, or in times for
+// dates and timestamps, with the `sqltype` struct tag. Foreign keys to the
+// primary key of another model become inverse relationships, and enum types
+// become string types with a constant for each one of their values.
+func ImportModels(schema *DBSchema, pkg string) ([]byte, []string, error) {
+	i := &modelImporter{
+		schema:  schema,
+		models:  make(map[string]string),
+		pks:     make(map[string]*ColumnSchema),
+		enums:   make(map[string]string),
+		imports: make(map[string]bool),
+		types:   map[string]string{"Schema": ""},
+	}
+
+	for _, t := range schema.Tables {
+		if pk := importablePK(t); pk != nil {
+			i.models[t.Name] = i.modelName(t.Name)
+			i.pks[t.Name] = pk
+		} else {
+			i.skipped = append(i.skipped, t.Name)
+		}
+	}
+
+	var body bytes.Buffer
+	for _, t := range schema.Tables {
+		if _, ok := i.models[t.Name]; ok {
+			i.writeModel(&body, t)
+		}
+	}
+
+	var enums bytes.Buffer
+	for _, e := range schema.Enums {
+		if name := i.enums[e.Name]; name != "" {
+			writeEnum(&enums, name, e)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n", pkg)
+	var imports = []string{"gopkg.in/src-d/go-kallax.v1"}
+	for imp := range i.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	// the packages of the standard library go first
+	sort.SliceStable(imports, func(a, b int) bool {
+		return !strings.Contains(imports[a], ".") && strings.Contains(imports[b], ".")
+	})
+	for j, imp := range imports {
+		if j > 0 && strings.Contains(imp, ".") && !strings.Contains(imports[j-1], ".") {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "%q\n", imp)
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(enums.Bytes())
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("kallax: unable to format the imported models: %s", err)
+	}
+	return src, i.skipped, nil
+}
+
+type modelImporter struct {
+	schema *DBSchema
+	// models is a map from table name to model name.
+	models map[string]string
+	// pks is a map from table name to the schema of its primary key.
+	pks map[string]*ColumnSchema
+	// enums is a map from enum type name to the name of the Go type of its
+	// values, for the enum types that can be imported.
+	enums   map[string]string
+	imports map[string]bool
+	// types is a map from the name of each declared type to its SQL name.
+	types   map[string]string
+	skipped []string
+}
+
+// modelSuffixes are the suffixes of the names of the types generated for
+// each model.
+var modelSuffixes = []string{"", "Store", "Query", "ResultSet"}
+
+// modelName returns a name for the model of the given table such that
+// neither it nor the names of its generated types are used by other types.
+func (i *modelImporter) modelName(table string) string {
+	name := goName(table)
+	if name == "" {
+		name = "Table"
+	}
+
+	result := name
+	for n := 2; i.isTaken(result); n++ {
+		result = fmt.Sprintf("%s%d", name, n)
+	}
+
+	for _, s := range modelSuffixes {
+		i.types[result+s] = table
+	}
+	return result
+}
+
+func (i *modelImporter) isTaken(model string) bool {
+	for _, s := range modelSuffixes {
+		if _, ok := i.types[model+s]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// importablePK returns the primary key of the given table if it can be used
+// as the primary key of a model.
+func importablePK(t *TableSchema) *ColumnSchema {
+	var pk *ColumnSchema
+	for _, c := range t.Columns {
+		if c.PrimaryKey {
+			if pk != nil {
+				return nil
+			}
+			pk = c
+		}
+	}
+
+	if pk == nil {
+		return nil
+	}
+
+	switch canonicalType(pk.Type) {
+	case SmallSerialColumn, SerialColumn, BigSerialColumn,
+		SmallIntColumn, IntegerColumn, BigIntColumn, UUIDColumn:
+		return pk
+	}
+	return nil
+}
+
+// importTypes are the Go types of the columns of each SQL type, which are
+// mapped back to the same SQL type in migrations.
+var importTypes = map[ColumnType]string{
+	SmallIntColumn:    "int16",
+	IntegerColumn:     "int32",
+	BigIntColumn:      "int64",
+	RealColumn:        "float32",
+	DoubleColumn:      "float64",
+	BooleanColumn:     "bool",
+	TextColumn:        "string",
+	TimestamptzColumn: "time.Time",
+	NumericColumn(20): "uint64",
+	JSONBColumn:       "map[string]interface{}",
+	ByteaColumn:       "[]byte",
+	UUIDColumn:        "kallax.UUID",
+	TSVectorColumn:    "types.TSVector",
+}
+
+// importSQLTypes are the Go types of the columns of each SQL type that needs
+// the `sqltype` struct tag. Columns of any other type are stored in strings.
+var importSQLTypes = map[ColumnType]string{
+	SmallSerialColumn:             "int16",
+	SerialColumn:                  "int32",
+	BigSerialColumn:               "int64",
+	"date":                        "time.Time",
+	"timestamp without time zone": "time.Time",
+}
+
+// importPackages are the packages that need to be imported to use the types
+// with the given prefix.
+var importPackages = map[string]string{
+	"time.":  "time",
+	"types.": "gopkg.in/src-d/go-kallax.v1/types",
+}
+
+// reservedFieldNames are the names that can not be used by fields of models
+// because they are the names of their methods.
+var reservedFieldNames = map[string]string{
+	"Model":                 "",
+	"GetID":                 "",
+	"ColumnAddress":         "",
+	"Value":                 "",
+	"NewRelationshipRecord": "",
+	"SetRelationship":       "",
+	"IsPersisted":           "",
+	"IsWritable":            "",
+	"IsSaving":              "",
+	"SetSaving":             "",
+	"ClearVirtualColumns":   "",
+	"AddVirtualColumn":      "",
+	"VirtualColumn":         "",
+}
+
+func (i *modelImporter) writeModel(buf *bytes.Buffer, t *TableSchema) {
+	var names = make(map[string]string)
+	for k, v := range reservedFieldNames {
+		names[k] = v
+	}
+
+	fmt.Fprintf(buf, "type %s struct {\n", i.models[t.Name])
+	fmt.Fprintf(buf, "kallax.Model `table:%q`\n", t.Name)
+
+	pk := i.pks[t.Name]
+	var tags []string
+	typ := "int64"
+	switch canonicalType(pk.Type) {
+	case SerialColumn:
+		tags = append(tags, `pk:"autoincr"`)
+	case SmallSerialColumn, BigSerialColumn:
+		tags = append(tags, `pk:"autoincr"`, fmt.Sprintf("sqltype:%q", pk.Type))
+	case UUIDColumn:
+		typ = "kallax.UUID"
+		tags = append(tags, `pk:""`)
+	default:
+		tags = append(tags, `pk:""`, fmt.Sprintf("sqltype:%q", pk.Type))
+	}
+	i.writeField(buf, names, pk, goName(pk.Name), typ, tags)
+
+	for _, c := range t.Columns {
+		if c.PrimaryKey {
+			continue
+		}
+
+		if ref := c.Reference; ref != nil && i.models[ref.Table] != "" && i.pks[ref.Table].Name == ref.Column {
+			i.writeRelationship(buf, names, t, c)
+			continue
+		}
+
+		typ, sqltype := i.fieldType(c)
+		var tags []string
+		if sqltype != "" {
+			tags = append(tags, fmt.Sprintf("sqltype:%q", sqltype))
+		}
+
+		if !c.NotNull && canBeNull(typ) {
+			typ = "*" + typ
+		}
+		i.writeField(buf, names, c, goName(c.Name), typ, tags)
+	}
+
+	buf.WriteString("}\n\n")
+}
+
+// writeRelationship writes the inverse relationship with the model of the
+// table referenced by the given column. Relationships with the model itself
+// are always pointers, as a struct can not contain itself.
+func (i *modelImporter) writeRelationship(buf *bytes.Buffer, names map[string]string, t *TableSchema, c *ColumnSchema) {
+	typ := i.models[c.Reference.Table]
+	if !c.NotNull || c.Reference.Table == t.Name {
+		typ = "*" + typ
+	}
+
+	tags := []string{fmt.Sprintf("fk:%q", c.Name+",inverse")}
+	if fkType := i.foreignKeyType(c.Reference.Table); canonicalType(c.Type) != fkType {
+		tags = append(tags, fmt.Sprintf("sqltype:%q", c.Type))
+	}
+
+	name := goName(strings.TrimSuffix(c.Name, "_id"))
+	if name == "" {
+		name = goName(c.Name)
+	}
+	i.writeField(buf, names, c, name, typ, tags)
+}
+
+// foreignKeyType returns the type migrations give to the foreign keys
+// referencing the imported primary key of the given table.
+func (i *modelImporter) foreignKeyType(table string) ColumnType {
+	switch typ := canonicalType(i.pks[table].Type); typ {
+	case SerialColumn:
+		return BigIntColumn
+	default:
+		return typ
+	}
+}
+
+func (i *modelImporter) writeField(buf *bytes.Buffer, names map[string]string, c *ColumnSchema, name, typ string, tags []string) {
+	if name == "" {
+		name = "Column"
+	}
+	name = uniqueName(name, names)
+	names[name] = c.Name
+
+	for prefix, pkg := range importPackages {
+		if strings.HasPrefix(strings.TrimLeft(typ, "*[]"), prefix) {
+			i.imports[pkg] = true
+		}
+	}
+
+	isRelationship := len(tags) > 0 && strings.HasPrefix(tags[0], "fk:")
+	if toLowerSnakeCase(name) != c.Name && !isRelationship {
+		tags = append(tags, fmt.Sprintf("kallax:%q", c.Name))
+	}
+
+	if c.Unique {
+		tags = append(tags, `unique:"true"`)
+	}
+
+	if c.Default != "" {
+		tags = append(tags, fmt.Sprintf("default:%q", c.Default))
+	}
+
+	if c.Check != "" {
+		tags = append(tags, fmt.Sprintf("check:%q", c.Check))
+	}
+
+	tag := strings.Join(tags, " ")
+	if tag == "" {
+		fmt.Fprintf(buf, "%s %s\n", name, typ)
+		return
+	}
+
+	if strings.Contains(tag, "`") {
+		tag = strconv.Quote(tag)
+	} else {
+		tag = "`" + tag + "`"
+	}
+	fmt.Fprintf(buf, "%s %s %s\n", name, typ, tag)
+}
+
+// fieldType returns the Go type of the field of the given column and the
+// SQL type of its `sqltype` struct tag, if it needs one.
+func (i *modelImporter) fieldType(c *ColumnSchema) (string, ColumnType) {
+	typ := canonicalType(c.Type)
+	if name, ok := i.enumType(string(typ)); ok {
+		return name, ""
+	}
+
+	if goType, ok := importTypes[typ]; ok {
+		return goType, ""
+	}
+
+	if elem := ColumnType(strings.TrimSuffix(string(typ), "[]")); elem != typ {
+		switch goType := importTypes[elem]; goType {
+		case "int16", "int32", "int64", "float32", "float64", "bool", "string":
+			return "[]" + goType, ""
+		}
+	}
+
+	if goType, ok := importSQLTypes[typ]; ok {
+		return goType, c.Type
+	}
+	return "string", c.Type
+}
+
+// enumType returns the name of the Go type of the enum type with the given
+// name, if it is an enum type that can be imported. That is the case if the
+// name of the Go type is mapped back to the same enum type in migrations and
+// all its values can be the names of constants.
+func (i *modelImporter) enumType(name string) (string, bool) {
+	if typ, ok := i.enums[name]; ok {
+		return typ, typ != ""
+	}
+
+	var enum = i.schema.Enum(name)
+	if enum == nil {
+		return "", false
+	}
+
+	typ := goName(name)
+	var names = []string{typ}
+	for _, v := range enum.Values {
+		names = append(names, typ+goName(v))
+		if goName(v) == "" {
+			typ = ""
+		}
+	}
+
+	if toLowerSnakeCase(typ) != name {
+		typ = ""
+	}
+
+	var declared = make(map[string]bool)
+	for _, n := range names {
+		if _, ok := i.types[n]; ok || declared[n] {
+			typ = ""
+		}
+		declared[n] = true
+	}
+
+	i.enums[name] = typ
+	if typ != "" {
+		for n := range declared {
+			i.types[n] = name
+		}
+	}
+	return typ, typ != ""
+}
+
+func writeEnum(buf *bytes.Buffer, name string, enum *EnumSchema) {
+	fmt.Fprintf(buf, "type %s string\n\nconst (\n", name)
+	for _, v := range enum.Values {
+		fmt.Fprintf(buf, "%s%s %s = %q\n", name, goName(v), name, v)
+	}
+	buf.WriteString(")\n\n")
+}
+
+// canBeNull reports whether a field of the given type can be a pointer, so
+// its column can be nullable.
+func canBeNull(typ string) bool {
+	return !strings.HasPrefix(typ, "[]")
+}
+
+// commonInitialisms are the words written in upper case in Go names.
+var commonInitialisms = map[string]bool{
+	"api":  true,
+	"html": true,
+	"http": true,
+	"id":   true,
+	"ip":   true,
+	"json": true,
+	"sql":  true,
+	"uri":  true,
+	"url":  true,
+	"uuid": true,
+}
+
+// goName returns the exported Go name for the given SQL name, such as the
+// name of a table or a column.
+//   goName("user_id") // UserID
+func goName(name string) string {
+	var buf bytes.Buffer
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, w := range words {
+		if commonInitialisms[strings.ToLower(w)] {
+			buf.WriteString(strings.ToUpper(w))
+			continue
+		}
+
+		for j, r := range w {
+			if j == 0 {
+				r = unicode.ToUpper(r)
+			}
+			buf.WriteRune(r)
+		}
+	}
+
+	result := buf.String()
+	if result != "" && !unicode.IsLetter([]rune(result)[0]) {
+		result = "X" + result
+	}
+	return result
+}
+
+// uniqueName returns the given name, or the name followed by a number if it
+// is already taken.
+func uniqueName(name string, taken map[string]string) string {
+	result := name
+	for n := 2; ; n++ {
+		if _, ok := taken[result]; !ok {
+			return result
+		}
+		result = fmt.Sprintf("%s%d", name, n)
+	}
+}
@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{"users", "Users"},
+		{"user_id", "UserID"},
+		{"api_key", "APIKey"},
+		{"createdAt", "CreatedAt"},
+		{"2fa_secret", "X2faSecret"},
+		{"_", ""},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, goName(c.name), c.name)
+	}
+}
+
+func importSchemaFixture() *DBSchema {
+	users := mkTable(
+		"users",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkColUnique("name", TextColumn, false, true, nil),
+		mkCol("email", "character varying(255)", false, false, nil),
+		mkCol("status", "user_status", false, true, nil),
+		mkCol("tags", ArrayColumn(TextColumn), false, true, nil),
+		mkCol("settings", JSONBColumn, false, false, nil),
+		mkColDefault("score", "numeric(10,2)", "0", "score >= 0"),
+		mkCol("value", BigIntColumn, false, true, nil),
+		mkCol("created_at", TimestamptzColumn, false, true, nil),
+		mkCol("birthday", "date", false, false, nil),
+	)
+
+	posts := mkTable(
+		"posts",
+		mkCol("id", UUIDColumn, true, true, nil),
+		mkCol("user_id", BigIntColumn, false, true, &Reference{Table: "users", Column: "id"}),
+		mkCol("editor_id", IntegerColumn, false, false, &Reference{Table: "users", Column: "id"}),
+		mkCol("parent_id", UUIDColumn, false, false, &Reference{Table: "posts", Column: "id"}),
+		mkCol("title", TextColumn, false, true, nil),
+	)
+
+	postTags := mkTable(
+		"post_tags",
+		mkCol("post_id", UUIDColumn, false, true, &Reference{Table: "posts", Column: "id"}),
+		mkCol("tag", TextColumn, false, true, nil),
+	)
+
+	schema := mkSchema(postTags, posts, users)
+	schema.Enums = []*EnumSchema{
+		{"unused", []string{"a", "b"}},
+		{"user_status", []string{"active", "inactive"}},
+	}
+	return schema
+}
+
+const expectedImportedModels = `package models
+
+import (
+	"time"
+
+	"gopkg.in/src-d/go-kallax.v1"
+)
+
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "active"
+	UserStatusInactive UserStatus = "inactive"
+)
+
+type Posts struct {
+	kallax.Model ` + "`table:\"posts\"`" + `
+	ID           kallax.UUID ` + "`pk:\"\"`" + `
+	User         Users       ` + "`fk:\"user_id,inverse\"`" + `
+	Editor       *Users      ` + "`fk:\"editor_id,inverse\" sqltype:\"integer\"`" + `
+	Parent       *Posts      ` + "`fk:\"parent_id,inverse\"`" + `
+	Title        string
+}
+
+type Users struct {
+	kallax.Model ` + "`table:\"users\"`" + `
+	ID           int64   ` + "`pk:\"autoincr\"`" + `
+	Name         string  ` + "`unique:\"true\"`" + `
+	Email        *string ` + "`sqltype:\"character varying(255)\"`" + `
+	Status       UserStatus
+	Tags         []string
+	Settings     *map[string]interface{}
+	Score        string ` + "`sqltype:\"numeric(10,2)\" default:\"0\" check:\"score >= 0\"`" + `
+	Value2       int64  ` + "`kallax:\"value\"`" + `
+	CreatedAt    time.Time
+	Birthday     *time.Time ` + "`sqltype:\"date\"`" + `
+}
+`
+
+func TestImportModels(t *testing.T) {
+	require := require.New(t)
+	live := importSchemaFixture()
+
+	src, skipped, err := ImportModels(live, "models")
+	require.NoError(err)
+	require.Equal([]string{"post_tags"}, skipped)
+	require.Equal(expectedImportedModels, string(src))
+
+	pkg, err := processFixture(string(src))
+	require.NoError(err)
+
+	models, err := SchemaFromPackages(pkg)
+	require.NoError(err)
+	require.Equal(ChangeSet{
+		&DropTable{Name: "post_tags"},
+		&DropEnum{Name: "unused"},
+	}, SchemaDrift(models, live))
+}
@@ -0,0 +1,328 @@
+package generator
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// IntrospectSchema reads the schema of the tables and enum types in the
+// current schema of the given database, which is usually `public`. The given
+// tables, such as the one where the version of the migrations is stored, are
+// ignored.
+// Only the constraints and indexes kallax can declare are read, that is,
+// primary keys, unique constraints, foreign keys and check constraints of a
+// single column, and indexes of columns that do not back a constraint.
+func IntrospectSchema(db *sql.DB, ignore ...string) (*DBSchema, error) {
+	var ignored = make(map[string]bool)
+	for _, t := range ignore {
+		ignored[t] = true
+	}
+
+	i := &introspector{
+		db:      db,
+		schema:  new(DBSchema),
+		ignored: ignored,
+	}
+
+	steps := []func() error{
+		i.enums,
+		i.columns,
+		i.constraints,
+		i.indexes,
+	}
+
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return nil, fmt.Errorf("kallax: unable to introspect the database schema: %s", err)
+		}
+	}
+
+	return i.schema, nil
+}
+
+type introspector struct {
+	db      *sql.DB
+	schema  *DBSchema
+	ignored map[string]bool
+}
+
+const introspectEnumsQuery = `
+SELECT t.typname, e.enumlabel
+FROM pg_type t
+JOIN pg_enum e ON e.enumtypid = t.oid
+JOIN pg_namespace n ON n.oid = t.typnamespace
+WHERE n.nspname = current_schema()
+ORDER BY t.typname, e.enumsortorder`
+
+func (i *introspector) enums() error {
+	return i.query(introspectEnumsQuery, func(rows *sql.Rows) error {
+		var name, value string
+		if err := rows.Scan(&name, &value); err != nil {
+			return err
+		}
+
+		enum := i.schema.Enum(name)
+		if enum == nil {
+			enum = &EnumSchema{Name: name}
+			i.schema.Enums = append(i.schema.Enums, enum)
+		}
+		enum.Values = append(enum.Values, value)
+		return nil
+	})
+}
+
+const introspectColumnsQuery = `
+SELECT c.relname, a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull,
+	COALESCE(pg_get_expr(d.adbin, d.adrelid), '')
+FROM pg_attribute a
+JOIN pg_class c ON c.oid = a.attrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE c.relkind IN ('r', 'p') AND n.nspname = current_schema()
+	AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY c.relname, a.attnum`
+
+func (i *introspector) columns() error {
+	return i.query(introspectColumnsQuery, func(rows *sql.Rows) error {
+		var (
+			table, typ string
+			col        ColumnSchema
+		)
+		if err := rows.Scan(&table, &col.Name, &typ, &col.NotNull, &col.Default); err != nil {
+			return err
+		}
+
+		if i.ignored[table] {
+			return nil
+		}
+
+		col.Type = canonicalType(ColumnType(typ))
+		if serial, ok := serialTypes[col.Type]; ok && strings.HasPrefix(col.Default, "nextval(") {
+			col.Type = serial
+			col.Default = ""
+		}
+
+		t := i.schema.Table(table)
+		if t == nil {
+			t = &TableSchema{Name: table}
+			i.schema.Tables = append(i.schema.Tables, t)
+		}
+		t.Columns = append(t.Columns, &col)
+		return nil
+	})
+}
+
+// serialTypes are the auto-incrementable types of each integer type, which
+// are just integers whose default value is the next value of a sequence.
+var serialTypes = map[ColumnType]ColumnType{
+	SmallIntColumn: SmallSerialColumn,
+	IntegerColumn:  SerialColumn,
+	BigIntColumn:   BigSerialColumn,
+}
+
+const introspectConstraintsQuery = `
+SELECT c.relname, con.contype, a.attname, COALESCE(fc.relname, ''),
+	COALESCE(fa.attname, ''), pg_get_constraintdef(con.oid)
+FROM pg_constraint con
+JOIN pg_class c ON c.oid = con.conrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+JOIN pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = con.conkey[1]
+LEFT JOIN pg_class fc ON fc.oid = con.confrelid
+LEFT JOIN pg_attribute fa ON fa.attrelid = con.confrelid AND fa.attnum = con.confkey[1]
+WHERE n.nspname = current_schema() AND con.contype IN ('p', 'u', 'f', 'c')
+	AND array_length(con.conkey, 1) = 1
+ORDER BY c.relname, con.conname`
+
+func (i *introspector) constraints() error {
+	return i.query(introspectConstraintsQuery, func(rows *sql.Rows) error {
+		var table, kind, column, refTable, refColumn, def string
+		if err := rows.Scan(&table, &kind, &column, &refTable, &refColumn, &def); err != nil {
+			return err
+		}
+
+		t := i.schema.Table(table)
+		if t == nil {
+			return nil
+		}
+
+		col := t.Column(column)
+		switch kind {
+		case "p":
+			col.PrimaryKey = true
+		case "u":
+			col.Unique = true
+		case "f":
+			col.Reference = &Reference{Table: refTable, Column: refColumn}
+		case "c":
+			col.Check = strings.TrimSuffix(strings.TrimPrefix(def, "CHECK ("), ")")
+		}
+		return nil
+	})
+}
+
+const introspectIndexesQuery = `
+SELECT t.relname, i.relname, am.amname, ix.indisunique,
+	COALESCE(pg_get_expr(ix.indpred, ix.indrelid), ''),
+	array_to_string(ARRAY(
+		SELECT a.attname
+		FROM unnest(ix.indkey::int2[]) WITH ORDINALITY AS k(attnum, ord)
+		JOIN pg_attribute a ON a.attrelid = ix.indrelid AND a.attnum = k.attnum
+		ORDER BY k.ord
+	), ',')
+FROM pg_index ix
+JOIN pg_class t ON t.oid = ix.indrelid
+JOIN pg_class i ON i.oid = ix.indexrelid
+JOIN pg_am am ON am.oid = i.relam
+JOIN pg_namespace n ON n.oid = t.relnamespace
+WHERE n.nspname = current_schema() AND NOT (0 = ANY(ix.indkey::int2[]))
+	AND NOT EXISTS (SELECT 1 FROM pg_constraint con WHERE con.conindid = ix.indexrelid)
+ORDER BY t.relname, i.relname`
+
+func (i *introspector) indexes() error {
+	return i.query(introspectIndexesQuery, func(rows *sql.Rows) error {
+		var (
+			table, columns string
+			idx            IndexSchema
+		)
+		if err := rows.Scan(&table, &idx.Name, &idx.Method, &idx.Unique, &idx.Where, &columns); err != nil {
+			return err
+		}
+
+		t := i.schema.Table(table)
+		if t == nil {
+			return nil
+		}
+
+		idx.Columns = strings.Split(columns, ",")
+		t.Indexes = append(t.Indexes, &idx)
+		return nil
+	})
+}
+
+func (i *introspector) query(query string, fn func(*sql.Rows) error) error {
+	rows, err := i.db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+var (
+	numericType = regexp.MustCompile(`^(?:numeric|decimal)\((\d+)(?:,0)?\)$`)
+	decimalType = regexp.MustCompile(`^decimal\((\d+),(\d+)\)$`)
+	varcharType = regexp.MustCompile(`^varchar\((\d+)\)$`)
+	charType    = regexp.MustCompile(`^char(?:acter)?\((\d+)\)$`)
+)
+
+// typeSynonyms are the names PostgreSQL gives to the types that can be
+// written in several ways.
+var typeSynonyms = map[ColumnType]ColumnType{
+	"int":                      IntegerColumn,
+	"int4":                     IntegerColumn,
+	"int8":                     BigIntColumn,
+	"int2":                     SmallIntColumn,
+	"serial4":                  SerialColumn,
+	"serial8":                  BigSerialColumn,
+	"serial2":                  SmallSerialColumn,
+	"float8":                   DoubleColumn,
+	"double":                   DoubleColumn,
+	"float4":                   RealColumn,
+	"bool":                     BooleanColumn,
+	"varchar":                  "character varying",
+	"decimal":                  "numeric",
+	"timestamp with time zone": TimestamptzColumn,
+	"timestamp":                "timestamp without time zone",
+}
+
+// canonicalType returns the name of the given type kallax uses, so the types
+// written in different ways, such as `int` and `integer` or the ones
+// returned by PostgreSQL, can be compared.
+func canonicalType(typ ColumnType) ColumnType {
+	t := strings.Replace(strings.ToLower(strings.TrimSpace(string(typ))), ", ", ",", -1)
+	if strings.HasSuffix(t, "[]") {
+		return ArrayColumn(canonicalType(ColumnType(strings.TrimSuffix(t, "[]"))))
+	}
+
+	if m := numericType.FindStringSubmatch(t); m != nil {
+		return ColumnType(fmt.Sprintf("numeric(%s)", m[1]))
+	}
+
+	if m := decimalType.FindStringSubmatch(t); m != nil {
+		return ColumnType(fmt.Sprintf("numeric(%s,%s)", m[1], m[2]))
+	}
+
+	if m := varcharType.FindStringSubmatch(t); m != nil {
+		return ColumnType(fmt.Sprintf("character varying(%s)", m[1]))
+	}
+
+	if m := charType.FindStringSubmatch(t); m != nil {
+		return ColumnType(fmt.Sprintf("char(%s)", m[1]))
+	}
+
+	if synonym, ok := typeSynonyms[ColumnType(t)]; ok {
+		return synonym
+	}
+	return ColumnType(t)
+}
+
+// SchemaDrift returns the changes that would make the given schema,
+// introspected from a database, match the expected one, which usually is the
+// one in the lock. Types are compared regardless of how they are written.
+// PostgreSQL normalizes the SQL expressions of default values, check
+// constraints and partial indexes, so only their presence is compared.
+func SchemaDrift(expected, live *DBSchema) ChangeSet {
+	return SchemaDiff(comparableSchema(live, expected), comparableSchema(expected, nil))
+}
+
+// comparableSchema returns a copy of the given schema with canonical types
+// and, if other is not nil, the SQL expressions of the tables in other, as
+// long as both schemas have them.
+func comparableSchema(s, other *DBSchema) *DBSchema {
+	if other == nil {
+		other = new(DBSchema)
+	}
+
+	var result = &DBSchema{Enums: s.Enums}
+	for _, t := range s.Tables {
+		otherTable := other.Table(t.Name)
+		if otherTable == nil {
+			otherTable = new(TableSchema)
+		}
+
+		table := &TableSchema{Name: t.Name}
+		for _, c := range t.Columns {
+			col := *c
+			col.Type = canonicalType(c.Type)
+			col.OldName = ""
+			if oc := otherTable.Column(c.Name); oc != nil {
+				if col.Default != "" && oc.Default != "" {
+					col.Default = oc.Default
+				}
+
+				if col.Check != "" && oc.Check != "" {
+					col.Check = oc.Check
+				}
+			}
+			table.Columns = append(table.Columns, &col)
+		}
+
+		for _, idx := range t.Indexes {
+			index := *idx
+			if oi := otherTable.Index(idx.Name); oi != nil && index.Where != "" && oi.Where != "" {
+				index.Where = oi.Where
+			}
+			table.Indexes = append(table.Indexes, &index)
+		}
+		result.Tables = append(result.Tables, table)
+	}
+	return result
+}
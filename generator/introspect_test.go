@@ -0,0 +1,199 @@
+package generator
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCanonicalType(t *testing.T) {
+	cases := []struct {
+		typ      ColumnType
+		expected ColumnType
+	}{
+		{"integer", IntegerColumn},
+		{"INT", IntegerColumn},
+		{"int8", BigIntColumn},
+		{"timestamp with time zone", TimestamptzColumn},
+		{"timestamptz", TimestamptzColumn},
+		{"numeric(20,0)", "numeric(20)"},
+		{"numeric(20)", "numeric(20)"},
+		{"decimal(10, 2)", "numeric(10,2)"},
+		{"numeric(10,2)", "numeric(10,2)"},
+		{"character(1)", "char(1)"},
+		{"varchar(255)", "character varying(255)"},
+		{"character varying(255)", "character varying(255)"},
+		{"double precision", DoubleColumn},
+		{"int4[]", ArrayColumn(IntegerColumn)},
+		{"status", "status"},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, canonicalType(c.typ), string(c.typ))
+	}
+}
+
+func TestSchemaDrift(t *testing.T) {
+	require := require.New(t)
+	expected := mkSchema(mkTable(
+		"products",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkColDefault("price", BigIntColumn, "0", "price >= 0"),
+		mkCol("name", "varchar(20)", false, false, nil),
+	))
+	expected.Tables[0].Indexes = []*IndexSchema{
+		mkIndex("products__name__btree", BTreeIndex, "price > 0", "name"),
+	}
+
+	live := mkSchema(mkTable(
+		"products",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkColDefault("price", BigIntColumn, "'0'::bigint", "(price >= 0)"),
+		mkCol("name", "character varying(20)", false, false, nil),
+	))
+	live.Tables[0].Indexes = []*IndexSchema{
+		mkIndex("products__name__btree", BTreeIndex, "(price > 0)", "name"),
+	}
+	require.Len(SchemaDrift(expected, live), 0)
+
+	live.Tables[0].Columns[1].Check = ""
+	live.Tables[0].Columns = append(live.Tables[0].Columns, mkCol("extra", TextColumn, false, false, nil))
+	live.Tables[0].Indexes = nil
+	require.Equal(ChangeSet{
+		&AddCheck{Table: "products", Name: "price", Check: "price >= 0"},
+		&DropColumn{Table: "products", Name: "extra"},
+		&CreateIndex{Table: "products", Index: expected.Tables[0].Indexes[0]},
+	}, SchemaDrift(expected, live))
+}
+
+func TestIntrospect(t *testing.T) {
+	suite.Run(t, new(IntrospectSuite))
+}
+
+type IntrospectSuite struct {
+	suite.Suite
+	db *sql.DB
+}
+
+const introspectSchema = "kallax_introspect"
+
+func (s *IntrospectSuite) SetupTest() {
+	db, err := sql.Open("postgres", fmt.Sprintf(
+		"postgres://%s:%s@%s/%s?sslmode=disable",
+		envOrDefault("DBUSER", "testing"),
+		envOrDefault("DBPASS", "testing"),
+		envOrDefault("DBHOST", "0.0.0.0:5432"),
+		envOrDefault("DBNAME", "testing"),
+	))
+	s.Require().NoError(err)
+
+	// the search path is set for the connection, so there can only be one
+	db.SetMaxOpenConns(1)
+	s.db = db
+
+	_, err = db.Exec(fmt.Sprintf("CREATE SCHEMA %s; SET search_path TO %s", introspectSchema, introspectSchema))
+	s.Require().NoError(err)
+}
+
+func (s *IntrospectSuite) TearDownTest() {
+	_, err := s.db.Exec(fmt.Sprintf("DROP SCHEMA %s CASCADE", introspectSchema))
+	s.NoError(err)
+	s.db.Close()
+}
+
+func (s *IntrospectSuite) TestIntrospect() {
+	require := s.Require()
+	users := mkTable(
+		"users",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkColDefault("name", TextColumn, "''", ""),
+		mkCol("email", "varchar(255)", false, false, nil),
+		mkCol("status", "user_status", false, true, nil),
+		mkCol("tags", ArrayColumn(TextColumn), false, false, nil),
+	)
+	users.Columns[2].Unique = true
+	users.Indexes = []*IndexSchema{
+		mkIndex("users__name_email__btree", BTreeIndex, "", "name", "email"),
+	}
+
+	posts := mkTable(
+		"posts",
+		mkCol("id", UUIDColumn, true, true, nil),
+		mkCol("user_id", BigIntColumn, false, true, &Reference{Table: "users", Column: "id"}),
+		mkColDefault("score", NumericColumn(20), "0", "score >= 0"),
+		mkCol("created_at", TimestamptzColumn, false, true, nil),
+	)
+
+	expected := mkSchema(users, posts)
+	expected.Enums = []*EnumSchema{{"user_status", []string{"active", "inactive"}}}
+
+	m, err := NewMigration(mkSchema(), expected)
+	require.NoError(err)
+	up, err := m.Up.MarshalText()
+	require.NoError(err)
+	_, err = s.db.Exec(string(up))
+	require.NoError(err)
+
+	_, err = s.db.Exec("CREATE TABLE schema_migrations (version bigint)")
+	require.NoError(err)
+
+	live, err := IntrospectSchema(s.db, "schema_migrations")
+	require.NoError(err)
+	require.Nil(live.Table("schema_migrations"))
+	require.Equal(expected.Enums, live.Enums)
+	require.Len(SchemaDrift(expected, live), 0)
+
+	_, err = s.db.Exec(`ALTER TABLE users DROP COLUMN tags;
+		ALTER TABLE posts ALTER COLUMN created_at DROP NOT NULL;
+		DROP INDEX users__name_email__btree`)
+	require.NoError(err)
+
+	live, err = IntrospectSchema(s.db, "schema_migrations")
+	require.NoError(err)
+	require.Equal(ChangeSet{
+		&SetNotNull{Table: "posts", Name: "created_at"},
+		&AddColumn{Table: "users", Column: users.Columns[4]},
+		&CreateIndex{Table: "users", Index: users.Indexes[0]},
+	}, SchemaDrift(expected, live))
+}
+
+func (s *IntrospectSuite) TestImport() {
+	require := s.Require()
+	expected := importSchemaFixture()
+	expected.Tables = expected.Tables[1:]
+	expected.Enums = expected.Enums[1:]
+
+	m, err := NewMigration(mkSchema(), expected)
+	require.NoError(err)
+	up, err := m.Up.MarshalText()
+	require.NoError(err)
+	_, err = s.db.Exec(string(up))
+	require.NoError(err)
+
+	live, err := IntrospectSchema(s.db)
+	require.NoError(err)
+
+	src, skipped, err := ImportModels(live, "models")
+	require.NoError(err)
+	require.Len(skipped, 0)
+
+	pkg, err := processFixture(string(src))
+	require.NoError(err)
+
+	models, err := SchemaFromPackages(pkg)
+	require.NoError(err)
+	require.Len(SchemaDrift(models, live), 0)
+}
+
+func envOrDefault(key string, def string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		v = def
+	}
+	return v
+}
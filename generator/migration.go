@@ -5,7 +5,10 @@ import (
 	"encoding"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+
+	"gopkg.in/src-d/go-kallax.v1"
 )
 
 // Migration contains all the data to represent a schema migration.
@@ -48,18 +51,32 @@ func NewMigration(old, new *DBSchema) (*Migration, error) {
 type DBSchema struct {
 	// Tables are the schema of all the tables.
 	Tables []*TableSchema
+	// Enums are the schema of all the enum types used by the tables.
+	Enums []*EnumSchema
 }
 
 // SchemaFromPackages returns a schema for the given packages models.
 func SchemaFromPackages(pkgs ...*Package) (*DBSchema, error) {
-	t := newPackageTransformer()
+	return DialectSchemaFromPackages(kallax.PostgreSQL, pkgs...)
+}
+
+// DialectSchemaFromPackages returns a schema for the given packages models
+// in the given SQL dialect.
+func DialectSchemaFromPackages(dialect kallax.Dialect, pkgs ...*Package) (*DBSchema, error) {
+	d, err := migrationDialectOf(dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	t := newPackageTransformer(d)
 	return t.transform(pkgs...)
 }
 
 func (s *DBSchema) MarshalText() ([]byte, error) {
 	schema := struct {
 		Tables []*TableSchema
-	}{s.Tables}
+		Enums  []*EnumSchema `json:",omitempty"`
+	}{s.Tables, s.Enums}
 	return json.MarshalIndent(schema, "", "  ")
 }
 
@@ -73,6 +90,38 @@ func (s *DBSchema) Table(name string) *TableSchema {
 	return nil
 }
 
+// Enum finds an enum type with the given name.
+func (s *DBSchema) Enum(name string) *EnumSchema {
+	for _, e := range s.Enums {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// renamed returns a copy of the schema in which the tables and columns
+// renamed in the given change set already have their new names.
+func (s *DBSchema) renamed(cs ChangeSet) *DBSchema {
+	var result = &DBSchema{Tables: make([]*TableSchema, len(s.Tables)), Enums: s.Enums}
+	copy(result.Tables, s.Tables)
+	for _, c := range cs {
+		for i, t := range result.Tables {
+			switch c := c.(type) {
+			case *RenameTable:
+				if t.Name == c.Name {
+					result.Tables[i] = t.renamed(c.NewName)
+				}
+			case *RenameColumn:
+				if t.Name == c.Table {
+					result.Tables[i] = t.renamedColumn(c.Name, c.NewName)
+				}
+			}
+		}
+	}
+	return result
+}
+
 func (s *DBSchema) index() map[string]*TableSchema {
 	var result = make(map[string]*TableSchema)
 	for _, t := range s.Tables {
@@ -87,6 +136,11 @@ type TableSchema struct {
 	Name string
 	// Columns are the schemas of the columns in the table.
 	Columns []*ColumnSchema
+	// Indexes are the schemas of the indexes of the table.
+	Indexes []*IndexSchema
+	// OldName is the previous name of the table, if it has been renamed.
+	// It is not stored in the lock.
+	OldName string `json:"-"`
 }
 
 type relationship struct {
@@ -111,19 +165,58 @@ func (s *TableSchema) relationships() []relationship {
 func (s *TableSchema) String() string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("CREATE TABLE %s (\n", s.Name))
+	// a primary key of several columns is a constraint of the table
+	pk := s.primaryKey()
+	composite := len(pk) > 1
 	for i, c := range s.Columns {
+		if composite {
+			col := *c
+			col.PrimaryKey = false
+			c = &col
+		}
+
 		buf.WriteRune('\t')
 		buf.WriteString(c.String())
-		if i < len(s.Columns)-1 {
+		if i < len(s.Columns)-1 || composite {
 			buf.WriteString(",\n")
 		} else {
 			buf.WriteRune('\n')
 		}
 	}
-	buf.WriteString(");\n\n")
+
+	if composite {
+		buf.WriteString(fmt.Sprintf("\tPRIMARY KEY (%s)\n", strings.Join(pk, ", ")))
+	}
+	buf.WriteString(");\n")
+	for _, idx := range s.Indexes {
+		buf.WriteString(idx.statement(s.Name, false))
+	}
+	buf.WriteRune('\n')
 	return buf.String()
 }
 
+// primaryKey returns the names of the columns of the primary key of the
+// table.
+func (s *TableSchema) primaryKey() []string {
+	var result []string
+	for _, c := range s.Columns {
+		if c.PrimaryKey {
+			result = append(result, c.Name)
+		}
+	}
+	return result
+}
+
+// hasColumns reports whether the table has all the given columns.
+func (s *TableSchema) hasColumns(names []string) bool {
+	for _, name := range names {
+		if s.Column(name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // Columns returns the schema of the column with the given name.
 func (s *TableSchema) Column(name string) *ColumnSchema {
 	for _, c := range s.Columns {
@@ -134,8 +227,50 @@ func (s *TableSchema) Column(name string) *ColumnSchema {
 	return nil
 }
 
+// Index returns the schema of the index with the given name.
+func (s *TableSchema) Index(name string) *IndexSchema {
+	for _, idx := range s.Indexes {
+		if idx.Name == name {
+			return idx
+		}
+	}
+	return nil
+}
+
+// renamed returns a copy of the table schema with the given name. Its
+// indexes are renamed as well, if they are named after the table.
+func (s *TableSchema) renamed(name string) *TableSchema {
+	t := *s
+	t.Name = name
+	t.Indexes = make([]*IndexSchema, len(s.Indexes))
+	for i, idx := range s.Indexes {
+		renamed := *idx
+		renamed.Name = renamedIndex(idx.Name, s.Name, name)
+		t.Indexes[i] = &renamed
+	}
+	return &t
+}
+
+// renamedColumn returns a copy of the table schema in which the column with
+// the given name is renamed.
+func (s *TableSchema) renamedColumn(name, newName string) *TableSchema {
+	t := *s
+	t.Columns = make([]*ColumnSchema, len(s.Columns))
+	for i, c := range s.Columns {
+		if c.Name == name {
+			renamed := *c
+			renamed.Name = newName
+			c = &renamed
+		}
+		t.Columns[i] = c
+	}
+	return &t
+}
+
 func (s *TableSchema) Equals(s2 *TableSchema) bool {
-	if s.Name != s2.Name || len(s.Columns) != len(s2.Columns) {
+	if s.Name != s2.Name ||
+		len(s.Columns) != len(s2.Columns) ||
+		len(s.Indexes) != len(s2.Indexes) {
 		return false
 	}
 
@@ -145,9 +280,53 @@ func (s *TableSchema) Equals(s2 *TableSchema) bool {
 		}
 	}
 
+	for i, idx := range s.Indexes {
+		if !idx.Equals(s2.Indexes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EnumSchema represents the schema of an enum type.
+type EnumSchema struct {
+	// Name of the type.
+	Name string
+	// Values are the values of the enum, in order.
+	Values []string
+}
+
+func (s *EnumSchema) Equals(s2 *EnumSchema) bool {
+	if s.Name != s2.Name || len(s.Values) != len(s2.Values) {
+		return false
+	}
+
+	for i, v := range s.Values {
+		if v != s2.Values[i] {
+			return false
+		}
+	}
 	return true
 }
 
+func (s *EnumSchema) hasValue(value string) bool {
+	for _, v := range s.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *EnumSchema) String() string {
+	var values = make([]string, len(s.Values))
+	for i, v := range s.Values {
+		values[i] = quoteLiteral(v)
+	}
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);\n", s.Name, strings.Join(values, ", "))
+}
+
 // ColumnSchema represents the schema of a column.
 type ColumnSchema struct {
 	// Name of the column.
@@ -163,6 +342,15 @@ type ColumnSchema struct {
 	NotNull bool
 	// Unique reports whether the column has a unique constraint
 	Unique bool
+	// Default is the SQL expression of the default value of the column, if
+	// any.
+	Default string
+	// Check is the SQL expression of the check constraint of the column, if
+	// any.
+	Check string
+	// OldName is the previous name of the column, if it has been renamed.
+	// It is not stored in the lock.
+	OldName string `json:"-"`
 }
 
 func (s *ColumnSchema) Equals(s2 *ColumnSchema) bool {
@@ -171,6 +359,8 @@ func (s *ColumnSchema) Equals(s2 *ColumnSchema) bool {
 		s.PrimaryKey == s2.PrimaryKey &&
 		s.NotNull == s2.NotNull &&
 		s.Unique == s2.Unique &&
+		s.Default == s2.Default &&
+		s.Check == s2.Check &&
 		s.Reference.Equals(s2.Reference)
 }
 
@@ -180,6 +370,11 @@ func (s *ColumnSchema) String() string {
 	buf.WriteRune(' ')
 	buf.WriteString(string(s.Type))
 
+	if s.Default != "" {
+		buf.WriteString(" DEFAULT ")
+		buf.WriteString(s.Default)
+	}
+
 	if s.NotNull {
 		buf.WriteString(" NOT NULL")
 	}
@@ -192,6 +387,12 @@ func (s *ColumnSchema) String() string {
 		buf.WriteString(" PRIMARY KEY")
 	}
 
+	if s.Check != "" {
+		buf.WriteString(" CHECK (")
+		buf.WriteString(s.Check)
+		buf.WriteRune(')')
+	}
+
 	if s.Reference != nil {
 		buf.WriteString(" REFERENCES ")
 		buf.WriteString(s.Reference.String())
@@ -218,6 +419,9 @@ const (
 	JSONBColumn       ColumnType = "jsonb"
 	BooleanColumn     ColumnType = "boolean"
 	UUIDColumn        ColumnType = "uuid"
+	TSVectorColumn    ColumnType = "tsvector"
+	BlobColumn        ColumnType = "blob"
+	TimestampColumn   ColumnType = "timestamp"
 )
 
 func NumericColumn(precision int) ColumnType {
@@ -237,6 +441,74 @@ func ArrayColumn(typ ColumnType) ColumnType {
 	return typ + "[]"
 }
 
+// IndexSchema represents the schema of an index.
+type IndexSchema struct {
+	// Name of the index.
+	Name string
+	// Method is the index method, e.g. btree, hash or gin.
+	Method string
+	// Columns are the indexed columns.
+	Columns []string
+	// Unique reports whether the index is unique.
+	Unique bool
+	// Where is the predicate of a partial index, if any.
+	Where string
+}
+
+// Index methods supported in the `index` struct tag.
+const (
+	BTreeIndex = "btree"
+	HashIndex  = "hash"
+	GINIndex   = "gin"
+)
+
+func (s *IndexSchema) Equals(s2 *IndexSchema) bool {
+	if s.Name != s2.Name ||
+		s.Method != s2.Method ||
+		s.Unique != s2.Unique ||
+		s.Where != s2.Where ||
+		len(s.Columns) != len(s2.Columns) {
+		return false
+	}
+
+	for i, c := range s.Columns {
+		if c != s2.Columns[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// statement returns the statement to create the index on the given table,
+// concurrently or not.
+func (s *IndexSchema) statement(table string, concurrently bool) string {
+	var buf bytes.Buffer
+	buf.WriteString("CREATE ")
+	if s.Unique {
+		buf.WriteString("UNIQUE ")
+	}
+
+	buf.WriteString("INDEX ")
+	if concurrently {
+		buf.WriteString("CONCURRENTLY ")
+	}
+
+	buf.WriteString(fmt.Sprintf("%s ON %s ", s.Name, table))
+	if s.Method != "" {
+		buf.WriteString(fmt.Sprintf("USING %s ", s.Method))
+	}
+	buf.WriteString(fmt.Sprintf("(%s)", strings.Join(s.Columns, ", ")))
+
+	if s.Where != "" {
+		buf.WriteString(" WHERE ")
+		buf.WriteString(s.Where)
+	}
+
+	buf.WriteString(";\n")
+	return buf.String()
+}
+
 // Reference represents a reference to another table column.
 type Reference struct {
 	// Table is the referenced table.
@@ -265,13 +537,20 @@ func (r *Reference) String() string {
 type ChangeSet []Change
 
 // sorted sorts the given changeset with the given order:
-// - first the create tables ordered by their relationships. For example,
+// - first the changes that come before the tables and columns are renamed,
+//   as they refer to them by their current names, and the renames themselves.
+// - second the creation of enum types and the values added to them, as
+//   they may be used by the tables and columns.
+// - third the create tables ordered by their relationships. For example,
 //  if profiles depends on
 //   users, users will be created first, and then profiles.
-// - second the drop tables, ordered in reverse order by their relationships.
+// - fourth the foreign keys being dropped, as they may reference tables that
+//   are going to be dropped.
+// - fifth the drop tables, ordered in reverse order by their relationships.
 //   For example, if profiles depends on users, profiles will be removed first
 //   and then users.
-// - Finally, rest of the changes.
+// - then, rest of the changes.
+// - Finally, the drop of enum types, once no column uses them.
 // dropIndex and createIndex are indexes of table name to table schema
 // used to look for dependencies of changes in drops and creates respectively.
 func (cs ChangeSet) sorted(dropIndex, createIndex map[string]*TableSchema) (ChangeSet, error) {
@@ -280,12 +559,21 @@ func (cs ChangeSet) sorted(dropIndex, createIndex map[string]*TableSchema) (Chan
 		dropTables   = make(map[string]Change)
 		createGraph  = newGraph()
 		dropGraph    = newGraph()
+		dropFKs      ChangeSet
+		renames      ChangeSet
+		enums        ChangeSet
+		dropEnums    ChangeSet
 		others       ChangeSet
 		result       ChangeSet
 	)
 
 	for _, c := range cs {
 		switch c := c.(type) {
+		case *RenameTable, *RenameColumn:
+			if len(renames) == 0 {
+				result, others = others, nil
+			}
+			renames = append(renames, c)
 		case *CreateTable:
 			createTables[c.Name] = c
 			if rels := createIndex[c.Name].relationships(); len(rels) > 0 {
@@ -312,11 +600,19 @@ func (cs ChangeSet) sorted(dropIndex, createIndex map[string]*TableSchema) (Chan
 			} else {
 				dropGraph.add(c.Name)
 			}
+		case *DropForeignKey:
+			dropFKs = append(dropFKs, c)
+		case *CreateEnum, *AddEnumValue:
+			enums = append(enums, c)
+		case *DropEnum:
+			dropEnums = append(dropEnums, c)
 		default:
 			others = append(others, c)
 		}
 	}
 
+	result = append(result, renames...)
+	result = append(result, enums...)
 	creates, err := createGraph.resolve()
 	if err != nil {
 		return nil, err
@@ -333,6 +629,7 @@ func (cs ChangeSet) sorted(dropIndex, createIndex map[string]*TableSchema) (Chan
 		return nil, err
 	}
 
+	result = append(result, dropFKs...)
 	drops = reverse(drops)
 	for _, d := range drops {
 		if change, ok := dropTables[d]; ok {
@@ -341,24 +638,91 @@ func (cs ChangeSet) sorted(dropIndex, createIndex map[string]*TableSchema) (Chan
 	}
 
 	result = append(result, others...)
+	result = append(result, dropEnums...)
 	return result, nil
 }
 
+// MarshalText returns the SQL of the change set, wrapped in a transaction.
+// Changes that can not be run inside a transaction are placed outside of it:
+// the values added to enum types before it, so the changes inside it can
+// already use them, and the concurrent creation of indexes after it.
 func (cs ChangeSet) MarshalText() ([]byte, error) {
+	var before, inside, after ChangeSet
+	for _, c := range cs {
+		if _, ok := c.(*AddEnumValue); ok {
+			before = append(before, c)
+		} else if inTransaction(c) {
+			inside = append(inside, c)
+		} else {
+			after = append(after, c)
+		}
+	}
+
 	var buf bytes.Buffer
+	if err := writeChanges(&buf, before); err != nil {
+		return nil, err
+	}
+
 	buf.WriteString("BEGIN;\n\n")
-	for _, c := range cs {
+	if err := writeChanges(&buf, inside); err != nil {
+		return nil, err
+	}
+	buf.WriteString("COMMIT;\n")
+
+	for _, c := range after {
 		bytes, err := c.MarshalText()
 		if err != nil {
 			return nil, err
 		}
-		buf.Write(bytes)
 		buf.WriteRune('\n')
+		buf.Write(bytes)
 	}
-	buf.WriteString("COMMIT;\n")
 	return buf.Bytes(), nil
 }
 
+// writeChanges writes the SQL of the given changes to the buffer, each one
+// followed by an empty line.
+func writeChanges(buf *bytes.Buffer, cs ChangeSet) error {
+	for _, c := range cs {
+		bytes, err := c.MarshalText()
+		if err != nil {
+			return err
+		}
+		buf.Write(bytes)
+		buf.WriteRune('\n')
+	}
+	return nil
+}
+
+// inTransaction reports whether the given change can be run inside a
+// transaction. Values can not be added to enum types inside a transaction
+// before PostgreSQL 12, and they can not be used until it is committed.
+func inTransaction(c Change) bool {
+	switch c := c.(type) {
+	case *CreateIndex:
+		return !c.Concurrently
+	case *DropIndex:
+		return !c.Concurrently
+	case *AddEnumValue:
+		return false
+	}
+	return true
+}
+
+// withConcurrentIndexes returns the change set with all the creations and
+// drops of indexes made concurrently.
+func (cs ChangeSet) withConcurrentIndexes() ChangeSet {
+	for _, c := range cs {
+		switch c := c.(type) {
+		case *CreateIndex:
+			c.Concurrently = true
+		case *DropIndex:
+			c.Concurrently = true
+		}
+	}
+	return cs
+}
+
 func (cs ChangeSet) String() string {
 	var buf bytes.Buffer
 	for _, c := range cs {
@@ -367,11 +731,13 @@ func (cs ChangeSet) String() string {
 	return buf.String()
 }
 
-// Reverse returns the change that will revert the current change set.
+// Reverse returns the change that will revert the current change set, which
+// reverts its changes in reverse order.
 func (cs ChangeSet) Reverse(old *DBSchema) Change {
+	old = old.renamed(cs)
 	var result = make(ChangeSet, len(cs))
 	for i, c := range cs {
-		result[i] = c.Reverse(old)
+		result[len(cs)-1-i] = c.Reverse(old)
 	}
 	return result
 }
@@ -474,131 +840,620 @@ func (c *DropColumn) MarshalText() ([]byte, error) {
 	return []byte(fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;\n", c.Table, c.Name)), nil
 }
 
+// RenameTable is a change that will rename a table, along with the
+// constraints and indexes named after it.
+type RenameTable struct {
+	// Name is the current name of the table.
+	Name string
+	// NewName is the name the table will have.
+	NewName string
+	// Table is the schema of the table before it is renamed.
+	Table *TableSchema
+}
+
+func (c *RenameTable) Reverse(old *DBSchema) Change {
+	return &RenameTable{
+		Name:    c.NewName,
+		NewName: c.Name,
+		Table:   c.Table.renamed(c.NewName),
+	}
+}
+
+func (c *RenameTable) String() string {
+	return fmt.Sprintf("Table %q has been renamed to %q.", c.Name, c.NewName)
+}
+
+func (c *RenameTable) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("ALTER TABLE %s RENAME TO %s;\n", c.Name, c.NewName))
+	for _, col := range c.Table.Columns {
+		if col.Unique {
+			buf.WriteString(renameConstraint(c.NewName, c.Name, col.Name, c.NewName, col.Name, "key"))
+		}
+
+		if col.Reference != nil {
+			buf.WriteString(renameConstraint(c.NewName, c.Name, col.Name, c.NewName, col.Name, "fkey"))
+		}
+
+		if col.Check != "" {
+			buf.WriteString(renameConstraint(c.NewName, c.Name, col.Name, c.NewName, col.Name, "check"))
+		}
+	}
+
+	for _, idx := range c.Table.Indexes {
+		if name := renamedIndex(idx.Name, c.Name, c.NewName); name != idx.Name {
+			buf.WriteString(fmt.Sprintf("ALTER INDEX %s RENAME TO %s;\n", idx.Name, name))
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// RenameColumn is a change that will rename a column, along with its
+// constraints.
+type RenameColumn struct {
+	// Table name.
+	Table string
+	// Name is the current name of the column.
+	Name string
+	// NewName is the name the column will have.
+	NewName string
+	// Unique reports whether the column has a unique constraint.
+	Unique bool
+	// ForeignKey reports whether the column has a foreign key.
+	ForeignKey bool
+	// Check reports whether the column has a check constraint.
+	Check bool
+}
+
+func (c *RenameColumn) Reverse(old *DBSchema) Change {
+	return &RenameColumn{
+		Table:      c.Table,
+		Name:       c.NewName,
+		NewName:    c.Name,
+		Unique:     c.Unique,
+		ForeignKey: c.ForeignKey,
+		Check:      c.Check,
+	}
+}
+
+func (c *RenameColumn) String() string {
+	return fmt.Sprintf("The column %q of table %q has been renamed to %q.", c.Name, c.Table, c.NewName)
+}
+
+func (c *RenameColumn) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s;\n", c.Table, c.Name, c.NewName))
+	if c.Unique {
+		buf.WriteString(renameConstraint(c.Table, c.Table, c.Name, c.Table, c.NewName, "key"))
+	}
+
+	if c.ForeignKey {
+		buf.WriteString(renameConstraint(c.Table, c.Table, c.Name, c.Table, c.NewName, "fkey"))
+	}
+
+	if c.Check {
+		buf.WriteString(renameConstraint(c.Table, c.Table, c.Name, c.Table, c.NewName, "check"))
+	}
+	return buf.Bytes(), nil
+}
+
+// renameConstraint returns the statement to rename the constraint of the
+// given kind of a column in the given table after the table or the column
+// have been renamed.
+func renameConstraint(table, oldTable, oldColumn, newTable, newColumn, kind string) string {
+	return fmt.Sprintf(
+		"ALTER TABLE %s RENAME CONSTRAINT %s TO %s;\n",
+		table,
+		constraintName(oldTable, oldColumn, kind),
+		constraintName(newTable, newColumn, kind),
+	)
+}
+
 // CreateIndex is a change that will create an index.
 type CreateIndex struct {
 	// Table name.
 	Table string
-	// Column name.
-	Column string
-	// Kind of index.
-	Kind string
+	// Index schema.
+	Index *IndexSchema
+	// Concurrently reports whether the index is created without locking the
+	// table against writes. Indexes can not be created concurrently inside a
+	// transaction.
+	Concurrently bool
 }
 
 func (c *CreateIndex) Reverse(old *DBSchema) Change {
 	return &DropIndex{
-		Table:  c.Table,
-		Column: c.Column,
-		Kind:   c.Kind,
+		Table:        c.Table,
+		Index:        c.Index,
+		Concurrently: c.Concurrently,
 	}
 }
 
 func (c *CreateIndex) String() string {
-	return fmt.Sprintf("A manual change is required because a new %q index has been added at column %q of table %q.", c.Kind, c.Column, c.Table)
+	var method string
+	if c.Index.Method != "" {
+		method = c.Index.Method + " "
+	}
+	return fmt.Sprintf("A new %sindex %q has been added to table %q on the columns: %s.", method, c.Index.Name, c.Table, strings.Join(c.Index.Columns, ", "))
 }
 
 func (c *CreateIndex) MarshalText() ([]byte, error) {
-	var unique string
-	if c.Kind == "unique" {
-		unique = "UNIQUE"
-	}
-	return []byte(fmt.Sprintf(`+++
-THIS REQUIRES MANUAL MIGRATION:
-Adding an index on a table that may not be empty.
-If you're sure about this, here's the SQL for this operation.
-+++
-
-CREATE %s INDEX %s ON %s;
-`, unique, indexName(c.Table, c.Column, c.Kind), c.Table)), nil
+	return []byte(c.Index.statement(c.Table, c.Concurrently)), nil
 }
 
 // DropIndex is a change that will drop an index.
 type DropIndex struct {
 	// Table name.
 	Table string
-	// Column name.
-	Column string
-	// Kind of index.
-	Kind string
+	// Index schema.
+	Index *IndexSchema
+	// Concurrently reports whether the index is dropped without locking the
+	// table. Indexes can not be dropped concurrently inside a transaction.
+	Concurrently bool
 }
 
 func (c *DropIndex) Reverse(old *DBSchema) Change {
 	return &CreateIndex{
-		Table:  c.Table,
-		Column: c.Column,
-		Kind:   c.Kind,
+		Table:        c.Table,
+		Index:        c.Index,
+		Concurrently: c.Concurrently,
 	}
 }
 
 func (c *DropIndex) String() string {
-	return fmt.Sprintf("The %q index at column %q of table %q has been removed and it will be dropped.", c.Kind, c.Column, c.Table)
+	return fmt.Sprintf("The index %q of table %q has been removed and it will be dropped.", c.Index.Name, c.Table)
 }
 
 func (c *DropIndex) MarshalText() ([]byte, error) {
-	return []byte(fmt.Sprintf("DROP INDEX %s;\n", indexName(c.Table, c.Column, c.Kind))), nil
+	var concurrently string
+	if c.Concurrently {
+		concurrently = "CONCURRENTLY "
+	}
+	return []byte(fmt.Sprintf("DROP INDEX %s%s;\n", concurrently, c.Index.Name)), nil
 }
 
-// ManualChange is a change that cannot be made automatically and requires
-// the user to write a proper migration.
-type ManualChange struct {
-	Msg string
+// AlterColumnType is a change that will change the type of a column. The
+// values of the column are converted with a cast to the new type.
+type AlterColumnType struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+	// Type is the new type of the column.
+	Type ColumnType
+	// OldType is the current type of the column.
+	OldType ColumnType
 }
 
-func (c *ManualChange) Reverse(old *DBSchema) Change {
-	return c
+func (c *AlterColumnType) Reverse(old *DBSchema) Change {
+	return &AlterColumnType{
+		Table:   c.Table,
+		Name:    c.Name,
+		Type:    c.OldType,
+		OldType: c.Type,
+	}
 }
 
-func (c *ManualChange) String() string {
-	return fmt.Sprintf("A manual change is required: %s.", c.Msg)
+func (c *AlterColumnType) String() string {
+	return fmt.Sprintf("The type of column %q of table %q has changed from %q to %q.", c.Name, c.Table, c.OldType, c.Type)
 }
 
-func (c *ManualChange) MarshalText() ([]byte, error) {
-	return []byte(fmt.Sprintf("+++ THIS REQUIRES MANUAL MIGRATION: %s +++\n", c.Msg)), nil
+func (c *AlterColumnType) MarshalText() ([]byte, error) {
+	from, to := storageType(c.OldType), storageType(c.Type)
+	return []byte(fmt.Sprintf(
+		"ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s;\n",
+		c.Table, c.Name, to, castExpr(c.Name, from, to),
+	)), nil
 }
 
-type graph struct {
-	nodeList []string
-	nodes    map[string]*node
+func (c *AlterColumnType) rewrites() bool {
+	return storageType(c.OldType) != storageType(c.Type)
 }
 
-type resolutionCtx struct {
-	resolved   map[string]struct{}
-	unresolved map[string]struct{}
-	names      []string
+// SetNotNull is a change that will make a column not nullable.
+type SetNotNull struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
 }
 
-func newGraph() *graph {
-	return &graph{nil, make(map[string]*node)}
+func (c *SetNotNull) Reverse(old *DBSchema) Change {
+	return &DropNotNull{Table: c.Table, Name: c.Name}
 }
 
-// add adds a new root node that has no dependencies.
-func (g *graph) add(name string) *graph {
-	if _, ok := g.nodes[name]; !ok {
-		g.nodeList = append(g.nodeList, name)
-		g.nodes[name] = newNode(name)
-	}
-	return g
+func (c *SetNotNull) String() string {
+	return fmt.Sprintf("The column %q of table %q is no longer nullable.", c.Name, c.Table)
 }
 
-func (g *graph) dependsOn(dependant, dependency string) *graph {
-	g.node(dependency).addDependant(g.node(dependant))
-	g.node(dependant).addDependency(g.node(dependency))
-	return g
+func (c *SetNotNull) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;\n", c.Table, c.Name)), nil
 }
 
-func (g *graph) node(name string) *node {
-	g.add(name)
-	return g.nodes[name]
+// DropNotNull is a change that will make a column nullable.
+type DropNotNull struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
 }
 
-func (g *graph) resolve() ([]string, error) {
-	ctx := &resolutionCtx{
-		make(map[string]struct{}),
-		make(map[string]struct{}),
-		nil,
-	}
+func (c *DropNotNull) Reverse(old *DBSchema) Change {
+	return &SetNotNull{Table: c.Table, Name: c.Name}
+}
 
-	for _, n := range g.nodeList {
-		node := g.nodes[n]
+func (c *DropNotNull) String() string {
+	return fmt.Sprintf("The column %q of table %q is now nullable.", c.Name, c.Table)
+}
+
+func (c *DropNotNull) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL;\n", c.Table, c.Name)), nil
+}
+
+// AddForeignKey is a change that will add a foreign key to a column.
+type AddForeignKey struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+	// Reference is the column referenced by the foreign key.
+	Reference *Reference
+}
+
+func (c *AddForeignKey) Reverse(old *DBSchema) Change {
+	return &DropForeignKey{Table: c.Table, Name: c.Name}
+}
+
+func (c *AddForeignKey) String() string {
+	return fmt.Sprintf("The column %q of table %q now references %s.", c.Name, c.Table, c.Reference)
+}
+
+func (c *AddForeignKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s;\n",
+		c.Table, constraintName(c.Table, c.Name, "fkey"), c.Name, c.Reference,
+	)), nil
+}
+
+// DropForeignKey is a change that will drop the foreign key of a column.
+type DropForeignKey struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+}
+
+func (c *DropForeignKey) Reverse(old *DBSchema) Change {
+	return &AddForeignKey{
+		Table:     c.Table,
+		Name:      c.Name,
+		Reference: old.Table(c.Table).Column(c.Name).Reference,
+	}
+}
+
+func (c *DropForeignKey) String() string {
+	return fmt.Sprintf("The foreign key of column %q of table %q has been removed and it will be dropped.", c.Name, c.Table)
+}
+
+func (c *DropForeignKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;\n", c.Table, constraintName(c.Table, c.Name, "fkey"))), nil
+}
+
+// AddUnique is a change that will add a unique constraint to a column.
+type AddUnique struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+}
+
+func (c *AddUnique) Reverse(old *DBSchema) Change {
+	return &DropUnique{Table: c.Table, Name: c.Name}
+}
+
+func (c *AddUnique) String() string {
+	return fmt.Sprintf("The column %q of table %q is now unique.", c.Name, c.Table)
+}
+
+func (c *AddUnique) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s UNIQUE (%s);\n",
+		c.Table, constraintName(c.Table, c.Name, "key"), c.Name,
+	)), nil
+}
+
+// DropUnique is a change that will drop the unique constraint of a column.
+type DropUnique struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+}
+
+func (c *DropUnique) Reverse(old *DBSchema) Change {
+	return &AddUnique{Table: c.Table, Name: c.Name}
+}
+
+func (c *DropUnique) String() string {
+	return fmt.Sprintf("The unique constraint of column %q of table %q has been removed and it will be dropped.", c.Name, c.Table)
+}
+
+func (c *DropUnique) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;\n", c.Table, constraintName(c.Table, c.Name, "key"))), nil
+}
+
+// AddPrimaryKey is a change that will add a primary key of several columns
+// to a table that has none.
+type AddPrimaryKey struct {
+	// Table name.
+	Table string
+	// Columns of the primary key.
+	Columns []string
+}
+
+func (c *AddPrimaryKey) Reverse(old *DBSchema) Change {
+	return &DropPrimaryKey{Table: c.Table, Columns: c.Columns}
+}
+
+func (c *AddPrimaryKey) String() string {
+	return fmt.Sprintf("The columns %s of table %q are now its primary key.", strings.Join(c.Columns, ", "), c.Table)
+}
+
+func (c *AddPrimaryKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s PRIMARY KEY (%s);\n",
+		c.Table, primaryKeyName(c.Table), strings.Join(c.Columns, ", "),
+	)), nil
+}
+
+// DropPrimaryKey is a change that will drop the primary key of several
+// columns of a table.
+type DropPrimaryKey struct {
+	// Table name.
+	Table string
+	// Columns of the primary key.
+	Columns []string
+}
+
+func (c *DropPrimaryKey) Reverse(old *DBSchema) Change {
+	return &AddPrimaryKey{Table: c.Table, Columns: c.Columns}
+}
+
+func (c *DropPrimaryKey) String() string {
+	return fmt.Sprintf("The primary key of table %q has been removed and it will be dropped.", c.Table)
+}
+
+func (c *DropPrimaryKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;\n", c.Table, primaryKeyName(c.Table))), nil
+}
+
+// AlterColumnDefault is a change that will set or drop the default value of
+// a column.
+type AlterColumnDefault struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+	// Default is the SQL expression of the new default value. If it is
+	// empty, the default value is dropped.
+	Default string
+	// OldDefault is the SQL expression of the current default value.
+	OldDefault string
+}
+
+func (c *AlterColumnDefault) Reverse(old *DBSchema) Change {
+	return &AlterColumnDefault{
+		Table:      c.Table,
+		Name:       c.Name,
+		Default:    c.OldDefault,
+		OldDefault: c.Default,
+	}
+}
+
+func (c *AlterColumnDefault) String() string {
+	if c.Default == "" {
+		return fmt.Sprintf("The column %q of table %q no longer has a default value.", c.Name, c.Table)
+	}
+	return fmt.Sprintf("The default value of column %q of table %q is now %s.", c.Name, c.Table, c.Default)
+}
+
+func (c *AlterColumnDefault) MarshalText() ([]byte, error) {
+	if c.Default == "" {
+		return []byte(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;\n", c.Table, c.Name)), nil
+	}
+	return []byte(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;\n", c.Table, c.Name, c.Default)), nil
+}
+
+// AddCheck is a change that will add a check constraint to a column.
+type AddCheck struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+	// Check is the SQL expression of the constraint.
+	Check string
+}
+
+func (c *AddCheck) Reverse(old *DBSchema) Change {
+	return &DropCheck{Table: c.Table, Name: c.Name}
+}
+
+func (c *AddCheck) String() string {
+	return fmt.Sprintf("The column %q of table %q now has the check constraint: %s.", c.Name, c.Table, c.Check)
+}
+
+func (c *AddCheck) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s);\n",
+		c.Table, constraintName(c.Table, c.Name, "check"), c.Check,
+	)), nil
+}
+
+// DropCheck is a change that will drop the check constraint of a column.
+type DropCheck struct {
+	// Table name.
+	Table string
+	// Name of the column.
+	Name string
+}
+
+func (c *DropCheck) Reverse(old *DBSchema) Change {
+	return &AddCheck{
+		Table: c.Table,
+		Name:  c.Name,
+		Check: old.Table(c.Table).Column(c.Name).Check,
+	}
+}
+
+func (c *DropCheck) String() string {
+	return fmt.Sprintf("The check constraint of column %q of table %q has been removed and it will be dropped.", c.Name, c.Table)
+}
+
+func (c *DropCheck) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;\n", c.Table, constraintName(c.Table, c.Name, "check"))), nil
+}
+
+// CreateEnum is a change that will create a new enum type.
+type CreateEnum struct {
+	*EnumSchema
+}
+
+func (c *CreateEnum) Reverse(old *DBSchema) Change {
+	return &DropEnum{Name: c.Name}
+}
+
+func (c *CreateEnum) String() string {
+	return fmt.Sprintf("The enum type %q has been added and it will be created.", c.Name)
+}
+
+func (c *CreateEnum) MarshalText() ([]byte, error) {
+	return []byte(c.EnumSchema.String()), nil
+}
+
+// DropEnum is a change that will drop an enum type.
+type DropEnum struct {
+	// Name of the type.
+	Name string
+}
+
+func (c *DropEnum) Reverse(old *DBSchema) Change {
+	return &CreateEnum{old.Enum(c.Name)}
+}
+
+func (c *DropEnum) String() string {
+	return fmt.Sprintf("The enum type %q is no longer used and it will be dropped.", c.Name)
+}
+
+func (c *DropEnum) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("DROP TYPE %s;\n", c.Name)), nil
+}
+
+// AddEnumValue is a change that will add a value to an enum type.
+type AddEnumValue struct {
+	// Enum is the name of the type.
+	Enum string
+	// Value is the added value.
+	Value string
+	// After is the value after which the new one is placed. If it is empty,
+	// the new value is placed first.
+	After string
+	// Before is the value before which the new one is placed when it is
+	// placed first.
+	Before string
+}
+
+func (c *AddEnumValue) Reverse(old *DBSchema) Change {
+	return &ManualChange{fmt.Sprintf("the value %q can not be removed from the enum type %q", c.Value, c.Enum)}
+}
+
+func (c *AddEnumValue) String() string {
+	return fmt.Sprintf("The value %q has been added to the enum type %q.", c.Value, c.Enum)
+}
+
+func (c *AddEnumValue) MarshalText() ([]byte, error) {
+	var position string
+	if c.After != "" {
+		position = " AFTER " + quoteLiteral(c.After)
+	} else if c.Before != "" {
+		position = " BEFORE " + quoteLiteral(c.Before)
+	}
+	return []byte(fmt.Sprintf("ALTER TYPE %s ADD VALUE %s%s;\n", c.Enum, quoteLiteral(c.Value), position)), nil
+}
+
+// rewriter is implemented by the changes that can rewrite the whole table,
+// locking it against reads and writes until it is done.
+type rewriter interface {
+	rewrites() bool
+}
+
+// rewritesTable reports whether the given change rewrites its table.
+func rewritesTable(c Change) bool {
+	r, ok := c.(rewriter)
+	return ok && r.rewrites()
+}
+
+// ManualChange is a change that cannot be made automatically and requires
+// the user to write a proper migration.
+type ManualChange struct {
+	Msg string
+}
+
+func (c *ManualChange) Reverse(old *DBSchema) Change {
+	return c
+}
+
+func (c *ManualChange) String() string {
+	return fmt.Sprintf("A manual change is required: %s.", c.Msg)
+}
+
+func (c *ManualChange) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("+++ THIS REQUIRES MANUAL MIGRATION: %s +++\n", c.Msg)), nil
+}
+
+type graph struct {
+	nodeList []string
+	nodes    map[string]*node
+}
+
+type resolutionCtx struct {
+	resolved   map[string]struct{}
+	unresolved map[string]struct{}
+	names      []string
+}
+
+func newGraph() *graph {
+	return &graph{nil, make(map[string]*node)}
+}
+
+// add adds a new root node that has no dependencies.
+func (g *graph) add(name string) *graph {
+	if _, ok := g.nodes[name]; !ok {
+		g.nodeList = append(g.nodeList, name)
+		g.nodes[name] = newNode(name)
+	}
+	return g
+}
+
+func (g *graph) dependsOn(dependant, dependency string) *graph {
+	g.node(dependency).addDependant(g.node(dependant))
+	g.node(dependant).addDependency(g.node(dependency))
+	return g
+}
+
+func (g *graph) node(name string) *node {
+	g.add(name)
+	return g.nodes[name]
+}
+
+func (g *graph) resolve() ([]string, error) {
+	ctx := &resolutionCtx{
+		make(map[string]struct{}),
+		make(map[string]struct{}),
+		nil,
+	}
+
+	for _, n := range g.nodeList {
+		node := g.nodes[n]
 		if len(node.dependencies) == 0 {
 			if err := g.nodes[n].resolve(ctx); err != nil {
 				return nil, err
@@ -653,90 +1508,349 @@ func (n *node) resolve(ctx *resolutionCtx) error {
 }
 
 // SchemaDiff generates a change set with the diff between two schemas.
+// Tables are renamed before any other change is made.
 func SchemaDiff(old, new *DBSchema) ChangeSet {
-	var cs ChangeSet
+	var cs, diffs ChangeSet
 	for _, oldTable := range old.Tables {
-		if t := new.Table(oldTable.Name); t == nil {
-			cs = append(cs, &DropTable{Name: oldTable.Name})
+		t := new.Table(oldTable.Name)
+		if t == nil {
+			if t = renamedTable(old, new, oldTable.Name); t != nil {
+				cs = append(cs, &RenameTable{
+					Name:    oldTable.Name,
+					NewName: t.Name,
+					Table:   oldTable,
+				})
+				oldTable = oldTable.renamed(t.Name)
+			}
+		}
+
+		if t == nil {
+			diffs = append(diffs, &DropTable{Name: oldTable.Name})
 		} else {
-			cs = append(cs, TableSchemaDiff(oldTable, t)...)
+			diffs = append(diffs, TableSchemaDiff(oldTable, t)...)
 		}
 	}
+	cs = append(cs, diffs...)
 
 	for _, newTable := range new.Tables {
-		if t := old.Table(newTable.Name); t == nil {
+		if t := old.Table(newTable.Name); t == nil && !isRenamedTable(old, new, newTable) {
 			cs = append(cs, &CreateTable{newTable})
 		}
 	}
 
+	return append(cs, enumsDiff(old, new)...)
+}
+
+// enumsDiff generates a change set with the diff between the enum types of
+// two schemas. Values can only be added to an enum type, so removing or
+// reordering them requires a manual change.
+func enumsDiff(old, new *DBSchema) ChangeSet {
+	var cs ChangeSet
+	for _, newEnum := range new.Enums {
+		oldEnum := old.Enum(newEnum.Name)
+		if oldEnum == nil {
+			cs = append(cs, &CreateEnum{newEnum})
+			continue
+		}
+
+		var added ChangeSet
+		var i int
+		for j, v := range newEnum.Values {
+			if i < len(oldEnum.Values) && oldEnum.Values[i] == v {
+				i++
+			} else if !oldEnum.hasValue(v) {
+				c := &AddEnumValue{Enum: newEnum.Name, Value: v}
+				if j > 0 {
+					c.After = newEnum.Values[j-1]
+				} else {
+					c.Before = oldEnum.Values[0]
+				}
+				added = append(added, c)
+			}
+		}
+
+		if i < len(oldEnum.Values) {
+			cs = append(cs, &ManualChange{fmt.Sprintf("values of the enum type %q have been removed or reordered", newEnum.Name)})
+		} else {
+			cs = append(cs, added...)
+		}
+	}
+
+	for _, oldEnum := range old.Enums {
+		if new.Enum(oldEnum.Name) == nil {
+			cs = append(cs, &DropEnum{Name: oldEnum.Name})
+		}
+	}
 	return cs
 }
 
 // TableSchemaDiff generates a change set with the diff between two table
-// schemas.
+// schemas. Columns are renamed first, then indexes are dropped before the
+// columns are changed and created after that.
 func TableSchemaDiff(old, new *TableSchema) ChangeSet {
 	var cs ChangeSet
+	for _, newCol := range new.Columns {
+		if isRenamedColumn(old, new, newCol) {
+			oldCol := old.Column(newCol.OldName)
+			cs = append(cs, &RenameColumn{
+				Table:      new.Name,
+				Name:       oldCol.Name,
+				NewName:    newCol.Name,
+				Unique:     oldCol.Unique,
+				ForeignKey: oldCol.Reference != nil,
+				Check:      oldCol.Check != "",
+			})
+		}
+	}
+
+	for _, oldIdx := range old.Indexes {
+		if idx := new.Index(oldIdx.Name); idx == nil || !idx.Equals(oldIdx) {
+			cs = append(cs, &DropIndex{
+				Table: old.Name,
+				Index: oldIdx,
+			})
+		}
+	}
+
+	// primary keys of several columns, such as the ones of join tables, can
+	// be added to and dropped from tables without one
+	oldPK, newPK := old.primaryKey(), new.primaryKey()
+	addPK := len(oldPK) == 0 && len(newPK) > 1 && old.hasColumns(newPK)
+	dropPK := len(oldPK) > 1 && len(newPK) == 0 && new.hasColumns(oldPK)
+	if dropPK {
+		cs = append(cs, &DropPrimaryKey{Table: old.Name, Columns: oldPK})
+	}
+
 	for _, oldCol := range old.Columns {
-		if c := new.Column(oldCol.Name); c == nil {
+		c := new.Column(oldCol.Name)
+		if c == nil {
+			c = renamedColumn(old, new, oldCol.Name)
+		}
+
+		if c == nil {
 			cs = append(cs, &DropColumn{
 				Table: old.Name,
 				Name:  oldCol.Name,
 			})
 		} else {
+			if addPK || dropPK {
+				col := *c
+				col.PrimaryKey = oldCol.PrimaryKey
+				c = &col
+			}
 			cs = append(cs, ColumnSchemaDiff(old.Name, oldCol, c)...)
 		}
 	}
 
 	for _, newCol := range new.Columns {
-		if c := old.Column(newCol.Name); c == nil {
+		if c := old.Column(newCol.Name); c == nil && !isRenamedColumn(old, new, newCol) {
 			cs = append(cs, &AddColumn{
 				Table:  new.Name,
 				Column: newCol,
 			})
 		}
 	}
+
+	if addPK {
+		cs = append(cs, &AddPrimaryKey{Table: new.Name, Columns: newPK})
+	}
+
+	for _, newIdx := range new.Indexes {
+		if idx := old.Index(newIdx.Name); idx == nil || !idx.Equals(newIdx) {
+			cs = append(cs, &CreateIndex{
+				Table: new.Name,
+				Index: newIdx,
+			})
+		}
+	}
 	return cs
 }
 
+// isRenamedTable reports whether the given table of the new schema has been
+// renamed from a table of the old schema. That is the case if its old name
+// is only in the old schema and its name only in the new one.
+func isRenamedTable(old, new *DBSchema, t *TableSchema) bool {
+	return t.OldName != "" &&
+		old.Table(t.OldName) != nil &&
+		new.Table(t.OldName) == nil &&
+		old.Table(t.Name) == nil
+}
+
+// renamedTable returns the table of the new schema that has been renamed
+// from the table of the old schema with the given name, if any.
+func renamedTable(old, new *DBSchema, name string) *TableSchema {
+	for _, t := range new.Tables {
+		if t.OldName == name && isRenamedTable(old, new, t) {
+			return t
+		}
+	}
+	return nil
+}
+
+// isRenamedColumn reports whether the given column of the new table has been
+// renamed from a column of the old table. That is the case if its old name
+// is only in the old table and its name only in the new one.
+func isRenamedColumn(old, new *TableSchema, c *ColumnSchema) bool {
+	return c.OldName != "" &&
+		old.Column(c.OldName) != nil &&
+		new.Column(c.OldName) == nil &&
+		old.Column(c.Name) == nil
+}
+
+// renamedColumn returns the column of the new table that has been renamed
+// from the column of the old table with the given name, if any.
+func renamedColumn(old, new *TableSchema, name string) *ColumnSchema {
+	for _, c := range new.Columns {
+		if c.OldName == name && isRenamedColumn(old, new, c) {
+			return c
+		}
+	}
+	return nil
+}
+
+// LikelyRename is a column that has probably been renamed, although it is
+// not declared as such: it has been dropped and a column with the same type
+// has been added to the same table.
+type LikelyRename struct {
+	// Table name.
+	Table string
+	// Old is the schema of the dropped column.
+	Old *ColumnSchema
+	// New is the schema of the added column.
+	New *ColumnSchema
+}
+
+// LikelyRenames returns the columns that have probably been renamed between
+// two schemas. A dropped column is considered renamed only if it is the only
+// column of its type dropped from the table and there is exactly one column
+// of that type added to it.
+func LikelyRenames(old, new *DBSchema) []*LikelyRename {
+	var result []*LikelyRename
+	for _, newTable := range new.Tables {
+		oldTable := old.Table(newTable.Name)
+		if oldTable == nil && isRenamedTable(old, new, newTable) {
+			oldTable = old.Table(newTable.OldName)
+		}
+
+		if oldTable == nil {
+			continue
+		}
+
+		var (
+			dropped = make(map[ColumnType][]*ColumnSchema)
+			added   = make(map[ColumnType][]*ColumnSchema)
+		)
+
+		for _, c := range oldTable.Columns {
+			if newTable.Column(c.Name) == nil && renamedColumn(oldTable, newTable, c.Name) == nil {
+				dropped[c.Type] = append(dropped[c.Type], c)
+			}
+		}
+
+		for _, c := range newTable.Columns {
+			if oldTable.Column(c.Name) == nil && !isRenamedColumn(oldTable, newTable, c) {
+				added[c.Type] = append(added[c.Type], c)
+			}
+		}
+
+		for _, c := range oldTable.Columns {
+			if cols := dropped[c.Type]; len(cols) == 1 && cols[0] == c && len(added[c.Type]) == 1 {
+				result = append(result, &LikelyRename{
+					Table: newTable.Name,
+					Old:   c,
+					New:   added[c.Type][0],
+				})
+			}
+		}
+	}
+	return result
+}
+
 // ColumnSchemaDiff generates the change set with the diff between two column
-// schemas.
+// schemas. Constraints are dropped before the type of the column is changed
+// and added after that.
 func ColumnSchemaDiff(table string, old, new *ColumnSchema) ChangeSet {
 	var cs ChangeSet
-	if old.Type != new.Type {
-		cs = append(cs, &ManualChange{
-			fmt.Sprintf("don't know how to generate migration for a change of type in %s(%s)", table, new.Name),
-		})
-	}
-
 	if old.PrimaryKey != new.PrimaryKey {
 		cs = append(cs, &ManualChange{
 			fmt.Sprintf("don't know how to generate migration for a change of primary key in %s(%s)", table, new.Name),
 		})
 	}
 
-	if old.NotNull != new.NotNull {
-		cs = append(cs, &ManualChange{
-			fmt.Sprintf("don't know how to generate migration for a change of null/not null in %s(%s)", table, new.Name),
-		})
+	refChanged := referenceChanged(old, new)
+	if refChanged && old.Reference != nil {
+		cs = append(cs, &DropForeignKey{Table: table, Name: new.Name})
 	}
 
 	if old.Unique && !new.Unique {
-		cs = append(cs, &DropIndex{
-			Table:  table,
-			Column: new.Name,
-			Kind:   "unique",
+		cs = append(cs, &DropUnique{Table: table, Name: new.Name})
+	}
+
+	if old.Check != "" && old.Check != new.Check {
+		cs = append(cs, &DropCheck{Table: table, Name: new.Name})
+	}
+
+	if old.NotNull && !new.NotNull {
+		cs = append(cs, &DropNotNull{Table: table, Name: new.Name})
+	}
+
+	// the default may not be valid for the new type, so it is dropped
+	// before changing it and set again after that
+	oldDefault := old.Default
+	if old.Type != new.Type && oldDefault != "" {
+		cs = append(cs, &AlterColumnDefault{
+			Table:      table,
+			Name:       new.Name,
+			OldDefault: oldDefault,
 		})
-	} else if new.Unique && !old.Unique {
-		cs = append(cs, &CreateIndex{
-			Table:  table,
-			Column: new.Name,
-			Kind:   "unique",
+		oldDefault = ""
+	}
+
+	if old.Type != new.Type {
+		if isSerial(old.Type) != isSerial(new.Type) {
+			cs = append(cs, &ManualChange{
+				fmt.Sprintf("don't know how to generate migration for a change of auto increment in %s(%s)", table, new.Name),
+			})
+		} else {
+			cs = append(cs, &AlterColumnType{
+				Table:   table,
+				Name:    new.Name,
+				Type:    new.Type,
+				OldType: old.Type,
+			})
+		}
+	}
+
+	if oldDefault != new.Default {
+		cs = append(cs, &AlterColumnDefault{
+			Table:      table,
+			Name:       new.Name,
+			Default:    new.Default,
+			OldDefault: oldDefault,
 		})
 	}
 
-	if referenceChanged(old, new) {
-		cs = append(cs, &ManualChange{
-			fmt.Sprintf("don't know how to generate migration for a change of foreign key in %s(%s)", table, new.Name),
+	if new.NotNull && !old.NotNull {
+		cs = append(cs, &SetNotNull{Table: table, Name: new.Name})
+	}
+
+	if new.Unique && !old.Unique {
+		cs = append(cs, &AddUnique{Table: table, Name: new.Name})
+	}
+
+	if new.Check != "" && old.Check != new.Check {
+		cs = append(cs, &AddCheck{
+			Table: table,
+			Name:  new.Name,
+			Check: new.Check,
+		})
+	}
+
+	if refChanged && new.Reference != nil {
+		cs = append(cs, &AddForeignKey{
+			Table:     table,
+			Name:      new.Name,
+			Reference: new.Reference,
 		})
 	}
 
@@ -752,6 +1866,8 @@ func referenceChanged(old, new *ColumnSchema) bool {
 }
 
 type packageTransformer struct {
+	// dialect is the SQL dialect of the schema.
+	dialect *migrationDialect
 	// pkg is the current package being transformed.
 	pkg *Package
 	// schema is the final schema being built.
@@ -765,10 +1881,14 @@ type packageTransformer struct {
 	// fks keeps all fks indexed by type name
 	// so they can be added later.
 	fks map[string][]*ColumnSchema
+	// joinTables keeps the join tables of many to many relationships
+	// in the order they were found so they can be added later.
+	joinTables []*TableSchema
 }
 
-func newPackageTransformer() *packageTransformer {
+func newPackageTransformer(dialect *migrationDialect) *packageTransformer {
 	return &packageTransformer{
+		dialect:    dialect,
 		schema:     new(DBSchema),
 		tables:     make(map[string]*TableSchema),
 		tableIndex: make(map[string]string),
@@ -796,9 +1916,50 @@ func (t *packageTransformer) transform(pkgs ...*Package) (*DBSchema, error) {
 		return nil, err
 	}
 
+	if err := t.checkIndexes(); err != nil {
+		return nil, err
+	}
+
+	t.applyJoinTables()
+	if err := t.applyIndexMethods(); err != nil {
+		return nil, err
+	}
 	return t.schema, nil
 }
 
+// applyIndexMethods removes the methods of the indexes if the dialect does
+// not support them, in which case all of them must be btree indexes.
+func (t *packageTransformer) applyIndexMethods() error {
+	if t.dialect.indexMethods {
+		return nil
+	}
+
+	for _, table := range t.schema.Tables {
+		for _, idx := range table.Indexes {
+			if idx.Method != BTreeIndex {
+				return fmt.Errorf("kallax: %s indexes are not supported by the %s dialect, used in index %s of table %s", idx.Method, t.dialect.name, idx.Name, table.Name)
+			}
+			idx.Method = ""
+		}
+	}
+	return nil
+}
+
+// checkIndexes checks that all the columns of the indexes exist, once all
+// the foreign keys have been added to the tables.
+func (t *packageTransformer) checkIndexes() error {
+	for _, table := range t.schema.Tables {
+		for _, idx := range table.Indexes {
+			for _, col := range idx.Columns {
+				if table.Column(col) == nil {
+					return fmt.Errorf("kallax: the column %s of index %s does not exist in table %s", col, idx.Name, table.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (t *packageTransformer) applyForeignKeys() error {
 	for typ, fks := range t.fks {
 		table, ok := t.tableIndex[typ]
@@ -822,6 +1983,81 @@ func (t *packageTransformer) applyForeignKeys() error {
 	return nil
 }
 
+func (t *packageTransformer) applyJoinTables() {
+	for _, table := range t.joinTables {
+		// if there is a model for the join table, it is the one defining
+		// the table
+		if _, ok := t.tables[table.Name]; ok {
+			continue
+		}
+
+		t.schema.Tables = append(t.schema.Tables, table)
+		t.tables[table.Name] = table
+	}
+}
+
+// addJoinTable adds the join table of the given many to many relationship
+// field, which will have a column referencing each side of the relationship.
+// Both sides of the relationship can define the same join table, as long as
+// their definitions match.
+func (t *packageTransformer) addJoinTable(f *Field) error {
+	typ := removeTypePrefix(f.Type)
+	table, ok := t.tableIndex[typ]
+	if !ok {
+		return fmt.Errorf("kallax: unable to find table for type %s in field %s of model %s. Is the model type part of the generation input?", typ, f.Name, f.Model.Name)
+	}
+
+	ownerType, err := t.transformType(f.Model.ID, false)
+	if err != nil {
+		return err
+	}
+
+	relatedType, err := t.transformType(t.pkIndex[table], false)
+	if err != nil {
+		return err
+	}
+
+	// both columns are the primary key, so the same records can not be
+	// linked twice
+	schema := &TableSchema{
+		Name: f.ThroughTable(),
+		Columns: []*ColumnSchema{
+			{
+				Name:       f.ForeignKey(),
+				Type:       ownerType,
+				PrimaryKey: true,
+				NotNull:    true,
+				Reference:  &Reference{Table: f.Model.Table, Column: f.Model.ID.ColumnName()},
+			},
+			{
+				Name:       f.ThroughForeignKey(),
+				Type:       relatedType,
+				PrimaryKey: true,
+				NotNull:    true,
+				Reference:  &Reference{Table: table, Column: t.pkIndex[table].ColumnName()},
+			},
+		},
+	}
+
+	// columns are sorted so both sides of the relationship generate the
+	// exact same table
+	sort.Slice(schema.Columns, func(i, j int) bool {
+		return schema.Columns[i].Name < schema.Columns[j].Name
+	})
+
+	for _, jt := range t.joinTables {
+		if jt.Name == schema.Name {
+			if !jt.Equals(schema) {
+				return fmt.Errorf("kallax: found conflicting definitions for the join table %s of field %s in model %s", schema.Name, f.Name, f.Model.Name)
+			}
+			return nil
+		}
+	}
+
+	t.joinTables = append(t.joinTables, schema)
+	return nil
+}
+
 func (t *packageTransformer) transformPkg(pkg *Package) error {
 	for _, m := range pkg.Models {
 		table, err := t.transformModel(m)
@@ -840,7 +2076,7 @@ func (t *packageTransformer) transformPkg(pkg *Package) error {
 }
 
 func (t *packageTransformer) transformModel(m *Model) (*TableSchema, error) {
-	schema := &TableSchema{Name: m.Table}
+	schema := &TableSchema{Name: m.Table, OldName: m.OldTable}
 	var columns = make(map[string]*ColumnSchema)
 	var err error
 	schema.Columns, err = t.transformFields(m.Fields, columns)
@@ -848,9 +2084,69 @@ func (t *packageTransformer) transformModel(m *Model) (*TableSchema, error) {
 		return nil, err
 	}
 
+	if err := t.transformIndexes(schema, m.Fields); err != nil {
+		return nil, err
+	}
+
+	for _, idx := range m.Indexes {
+		if err := addIndex(schema, idx); err != nil {
+			return nil, err
+		}
+	}
+
 	return schema, nil
 }
 
+// transformIndexes adds to the given table schema the indexes declared in
+// the given fields.
+func (t *packageTransformer) transformIndexes(schema *TableSchema, fields []*Field) error {
+	for _, f := range fields {
+		if f.IsEmbedded {
+			if err := t.transformIndexes(schema, f.Fields); err != nil {
+				return err
+			}
+			continue
+		}
+
+		idx, err := f.Index()
+		if err != nil {
+			return err
+		} else if idx == nil {
+			continue
+		}
+
+		switch {
+		case f.Kind != Relationship:
+			idx.Columns = []string{f.ColumnName()}
+		case isOneToOneRelationship(f) && f.IsInverse():
+			idx.Columns = []string{f.ForeignKey()}
+		default:
+			return fmt.Errorf("kallax: relationship field %s of model %s can not have an index, its foreign key is not in the table of the model", f.Name, f.Model.Name)
+		}
+
+		if err := addIndex(schema, idx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addIndex adds the given index to the table schema.
+func addIndex(schema *TableSchema, idx *Index) error {
+	name := indexName(schema.Name, strings.Join(idx.Columns, "_"), idx.Method)
+	if schema.Index(name) != nil {
+		return fmt.Errorf("kallax: there is more than one %s index on columns %s in table %s", idx.Method, strings.Join(idx.Columns, ", "), schema.Name)
+	}
+
+	schema.Indexes = append(schema.Indexes, &IndexSchema{
+		Name:    name,
+		Method:  idx.Method,
+		Columns: idx.Columns,
+		Where:   idx.Where,
+	})
+	return nil
+}
+
 func (t *packageTransformer) transformFields(fields []*Field, columns map[string]*ColumnSchema) ([]*ColumnSchema, error) {
 	var result []*ColumnSchema
 
@@ -861,6 +2157,10 @@ func (t *packageTransformer) transformFields(fields []*Field, columns map[string
 				return nil, err
 			}
 			result = append(result, cols...)
+		} else if f.IsManyToManyRelationship() {
+			if err := t.addJoinTable(f); err != nil {
+				return nil, err
+			}
 		} else {
 			column, err := t.transformField(f)
 			if err != nil {
@@ -901,32 +2201,80 @@ func (t *packageTransformer) transformField(f *Field) (*ColumnSchema, error) {
 		name = f.ForeignKey()
 	}
 
+	enum, err := f.Enum()
+	if err != nil {
+		return nil, err
+	}
+
+	check := f.SQLCheck()
+	if enum != nil && enum.IsString && f.SQLType() == "" && !t.dialect.enums {
+		if check == "" {
+			var values = make([]string, len(enum.Values))
+			for i, v := range enum.Values {
+				values[i] = quoteLiteral(v)
+			}
+			check = fmt.Sprintf("%s IN (%s)", name, strings.Join(values, ", "))
+		}
+	} else if enum != nil && enum.IsString && f.SQLType() == "" {
+		schema := &EnumSchema{Name: f.Model.Table + "_" + name, Values: enum.Values}
+		if enum.Name != "" {
+			schema.Name = toLowerSnakeCase(enum.Name)
+		}
+
+		if err := t.addEnum(schema); err != nil {
+			return nil, err
+		}
+		typ = ColumnType(schema.Name)
+	} else if enum != nil && !enum.IsString && check == "" {
+		check = fmt.Sprintf("%s IN (%s)", name, strings.Join(enum.Values, ", "))
+	}
+
 	return &ColumnSchema{
 		Name:       name,
+		OldName:    f.OldColumnName(),
 		PrimaryKey: f.IsPrimaryKey(),
 		NotNull:    !f.IsPtr,
 		Type:       typ,
 		Reference:  ref,
 		Unique:     f.IsUnique(),
+		Default:    f.SQLDefault(),
+		Check:      check,
 	}, nil
 }
 
+// addEnum adds the given enum type to the schema. The same enum type can be
+// used by several columns, as long as its values are the same.
+func (t *packageTransformer) addEnum(enum *EnumSchema) error {
+	if e := t.schema.Enum(enum.Name); e != nil {
+		if !e.Equals(enum) {
+			return fmt.Errorf("kallax: found conflicting definitions for the enum type %s", enum.Name)
+		}
+		return nil
+	}
+
+	t.schema.Enums = append(t.schema.Enums, enum)
+	return nil
+}
+
 func (t *packageTransformer) transformType(f *Field, pk bool) (ColumnType, error) {
 	if typ := f.SQLType(); typ != "" {
 		return ColumnType(typ), nil
 	}
 
 	if f.IsJSON {
-		return JSONBColumn, nil
+		return t.dialect.json, nil
 	}
 
 	if f.Kind == Array || f.Kind == Slice {
 		typ := removeTypePrefix(f.Type)
 		if typ == "byte" {
-			return ByteaColumn, nil
+			return t.dialect.bytes, nil
 		}
 
-		return ArrayColumn(typeMappings[typ]), nil
+		if t.dialect.arrays != "" {
+			return t.dialect.arrays, nil
+		}
+		return ArrayColumn(t.dialect.types[typ]), nil
 	}
 
 	if pk {
@@ -934,11 +2282,11 @@ func (t *packageTransformer) transformType(f *Field, pk bool) (ColumnType, error
 			return ColumnType(""), fmt.Errorf("kallax: type %s is not a valid type for a primary key. On field %s of model %s.", f.Type, f.Name, f.Model.Name)
 		}
 
-		return idTypeMappings[identifierType(f)], nil
+		return t.dialect.idTypes[identifierType(f)], nil
 	}
 
 	if f.Kind == Basic {
-		typ, ok := typeMappings[f.Type]
+		typ, ok := t.dialect.types[f.Type]
 		if !ok {
 			return ColumnType(""), fmt.Errorf("kallax: type %s can not be converted to a SQL type. On field %s of model %s. Consider using the struct tag `sqltype` to set a custom type for this column.", f.Type, f.Name, f.Model.Name)
 		}
@@ -961,7 +2309,7 @@ func (t *packageTransformer) transformType(f *Field, pk bool) (ColumnType, error
 
 	if f.Kind == Interface {
 		typ := removeTypePrefix(typeName(f.Node.Type()))
-		if typ, ok := typeMappings[typ]; ok {
+		if typ, ok := t.dialect.types[typ]; ok {
 			return typ, nil
 		}
 	}
@@ -1010,6 +2358,7 @@ var typeMappings = map[string]ColumnType{
 	"url.URL":                               TextColumn,
 	"time.Time":                             TimestamptzColumn,
 	"time.Duration":                         BigIntColumn,
+	tsvectorType:                            TSVectorColumn,
 }
 
 var idTypeMappings = map[string]ColumnType{
@@ -1018,6 +2367,161 @@ var idTypeMappings = map[string]ColumnType{
 	"kallax.NumericID": SerialColumn,
 }
 
+// sqliteTypeMappings are the SQLite types of the Go types. Integers of all
+// sizes are stored in the same way, and times are timestamp columns so the
+// driver parses them back.
+var sqliteTypeMappings = map[string]ColumnType{
+	"gopkg.in/src-d/go-kallax.v1.ULID":      TextColumn,
+	"gopkg.in/src-d/go-kallax.v1.UUID":      TextColumn,
+	"gopkg.in/src-d/go-kallax.v1.NumericID": IntegerColumn,
+	"github.com/satori/go.uuid.UUID":        TextColumn,
+	"github.com/gofrs/uuid.UUID":            TextColumn,
+	"string":                                TextColumn,
+	"rune":                                  TextColumn,
+	"uint8":                                 IntegerColumn,
+	"int8":                                  IntegerColumn,
+	"byte":                                  IntegerColumn,
+	"uint16":                                IntegerColumn,
+	"int16":                                 IntegerColumn,
+	"uint32":                                IntegerColumn,
+	"int32":                                 IntegerColumn,
+	"uint":                                  IntegerColumn,
+	"int":                                   IntegerColumn,
+	"int64":                                 IntegerColumn,
+	"uint64":                                IntegerColumn,
+	"float32":                               RealColumn,
+	"float64":                               RealColumn,
+	"bool":                                  BooleanColumn,
+	"url.URL":                               TextColumn,
+	"time.Time":                             TimestampColumn,
+	"time.Duration":                         IntegerColumn,
+}
+
+// sqliteIDTypeMappings are the SQLite types of the primary keys. An integer
+// primary key is an alias of the row id, so it is generated on insert.
+var sqliteIDTypeMappings = map[string]ColumnType{
+	"kallax.ULID":      TextColumn,
+	"kallax.UUID":      TextColumn,
+	"kallax.NumericID": IntegerColumn,
+}
+
+// migrationDialect defines how the schema of the models is written in a SQL
+// dialect.
+type migrationDialect struct {
+	name string
+	// types are the column types of the Go types.
+	types map[string]ColumnType
+	// idTypes are the column types of the Go types of the primary keys.
+	idTypes map[string]ColumnType
+	// json is the column type of the fields stored as JSON.
+	json ColumnType
+	// bytes is the column type of the byte slices.
+	bytes ColumnType
+	// arrays is the column type of all the other slices and arrays. If it is
+	// empty, they are arrays of the column type of their elements.
+	arrays ColumnType
+	// enums reports whether string enums are enum types. Otherwise, they are
+	// text columns with a check of their values.
+	enums bool
+	// indexMethods reports whether indexes can use other methods than btree.
+	indexMethods bool
+	// concurrentIndexes reports whether indexes can be created and dropped
+	// concurrently.
+	concurrentIndexes bool
+	// alterColumns reports whether the columns of existing tables can be
+	// altered and their constraints added and dropped. Otherwise, these
+	// changes need to be made by hand, rebuilding the table.
+	alterColumns bool
+}
+
+var migrationDialects = map[string]*migrationDialect{
+	kallax.PostgreSQL.Name(): {
+		name:              kallax.PostgreSQL.Name(),
+		types:             typeMappings,
+		idTypes:           idTypeMappings,
+		json:              JSONBColumn,
+		bytes:             ByteaColumn,
+		enums:             true,
+		indexMethods:      true,
+		concurrentIndexes: true,
+		alterColumns:      true,
+	},
+	kallax.SQLite.Name(): {
+		name:    kallax.SQLite.Name(),
+		types:   sqliteTypeMappings,
+		idTypes: sqliteIDTypeMappings,
+		json:    TextColumn,
+		bytes:   BlobColumn,
+		arrays:  TextColumn,
+	},
+}
+
+// migrationDialectOf returns the definition of the given SQL dialect, if
+// migrations can be generated for it.
+func migrationDialectOf(dialect kallax.Dialect) (*migrationDialect, error) {
+	d, ok := migrationDialects[dialect.Name()]
+	if !ok {
+		return nil, fmt.Errorf("kallax: migrations can not be generated for the %s dialect", dialect.Name())
+	}
+	return d, nil
+}
+
+// supported returns the given changes with the ones the dialect does not
+// support replaced by manual changes.
+func (d *migrationDialect) supported(cs ChangeSet) ChangeSet {
+	if d.alterColumns {
+		return cs
+	}
+
+	var result = make(ChangeSet, len(cs))
+	for i, c := range cs {
+		switch c.(type) {
+		case *AlterColumnType, *SetNotNull, *DropNotNull, *AddForeignKey,
+			*DropForeignKey, *AddUnique, *DropUnique, *AlterColumnDefault,
+			*AddCheck, *DropCheck, *AddPrimaryKey, *DropPrimaryKey:
+			result[i] = &ManualChange{fmt.Sprintf(
+				"the %s dialect can not alter columns, the table needs to be rebuilt: %s",
+				d.name, strings.TrimSuffix(c.String(), "."),
+			)}
+		case *RenameTable, *RenameColumn:
+			if !renamesConstraints(c) {
+				result[i] = c
+				break
+			}
+
+			result[i] = &ManualChange{fmt.Sprintf(
+				"the %s dialect can not rename constraints nor indexes, the table needs to be rebuilt: %s",
+				d.name, strings.TrimSuffix(c.String(), "."),
+			)}
+		default:
+			result[i] = c
+		}
+	}
+	return result
+}
+
+// renamesConstraints reports whether the given rename of a table or a column
+// also renames constraints or indexes named after them.
+func renamesConstraints(c Change) bool {
+	switch c := c.(type) {
+	case *RenameTable:
+		for _, col := range c.Table.Columns {
+			if col.Unique || col.Reference != nil || col.Check != "" {
+				return true
+			}
+		}
+
+		for _, idx := range c.Table.Indexes {
+			if renamedIndex(idx.Name, c.Name, c.NewName) != idx.Name {
+				return true
+			}
+		}
+	case *RenameColumn:
+		return c.Unique || c.ForeignKey || c.Check
+	}
+	return false
+}
+
 func reverse(slice []string) []string {
 	result := make([]string, len(slice))
 	len := len(slice)
@@ -1030,3 +2534,80 @@ func reverse(slice []string) []string {
 func indexName(table, column, kind string) string {
 	return fmt.Sprintf("%s__%s__%s", table, column, kind)
 }
+
+// renamedIndex returns the name of the given index once its table is
+// renamed, which only changes if it is named after the table.
+func renamedIndex(index, table, newTable string) string {
+	if strings.HasPrefix(index, table+"__") {
+		return newTable + strings.TrimPrefix(index, table)
+	}
+	return index
+}
+
+// maxIdentifierLen is the maximum length of an identifier in PostgreSQL.
+const maxIdentifierLen = 63
+
+// primaryKeyName returns the name PostgreSQL gives to the primary key
+// constraint of the given table.
+func primaryKeyName(table string) string {
+	if len(table) > maxIdentifierLen-len("_pkey") {
+		table = table[:maxIdentifierLen-len("_pkey")]
+	}
+	return table + "_pkey"
+}
+
+// constraintName returns the name PostgreSQL gives to the constraint of the
+// given kind on a column when it is declared without a name, e.g.
+// table_column_key for unique constraints and table_column_fkey for
+// foreign keys. Like PostgreSQL does, the longest of table and column is
+// truncated until the name fits in an identifier.
+func constraintName(table, column, kind string) string {
+	var (
+		available = maxIdentifierLen - len(kind) - 2
+		tableLen  = len(table)
+		columnLen = len(column)
+	)
+
+	for tableLen+columnLen > available {
+		if tableLen > columnLen {
+			tableLen--
+		} else {
+			columnLen--
+		}
+	}
+
+	return fmt.Sprintf("%s_%s_%s", table[:tableLen], column[:columnLen], kind)
+}
+
+// storageType returns the type the column is actually stored as. Serial
+// types are just integers with a sequence as default value.
+func storageType(typ ColumnType) ColumnType {
+	switch typ {
+	case SmallSerialColumn:
+		return SmallIntColumn
+	case SerialColumn:
+		return IntegerColumn
+	case BigSerialColumn:
+		return BigIntColumn
+	}
+	return typ
+}
+
+func isSerial(typ ColumnType) bool {
+	return storageType(typ) != typ
+}
+
+// quoteLiteral returns the given string quoted as an SQL literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+// castExpr returns the expression to convert the values of a column from a
+// type to another.
+func castExpr(column string, from, to ColumnType) string {
+	if from == JSONBColumn && to == TextColumn {
+		// a cast would keep the quotes of JSON strings
+		return fmt.Sprintf("%s #>> '{}'", column)
+	}
+	return fmt.Sprintf("%s::%s", column, to)
+}
@@ -1,10 +1,14 @@
 package generator
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/src-d/go-kallax.v1"
 
 	// we need this external libs to be able to generate code corrrectly
 	_ "github.com/gofrs/uuid"
@@ -60,10 +64,10 @@ func TestMigrationOrder(t *testing.T) {
 	}
 
 	expectedDown := ChangeSet{
-		&DropTable{"d"},
 		&DropTable{"c"},
 		&DropTable{"b"},
 		&DropTable{"a"},
+		&DropTable{"d"},
 	}
 
 	require.Equal(t, expectedUp, migration.Up)
@@ -150,6 +154,32 @@ func TestCreateTable(t *testing.T) {
 `)
 }
 
+func TestCreateTable_Indexes(t *testing.T) {
+	table := mkTable(
+		"table",
+		mkCol("id", SerialColumn, true, false, nil),
+		mkCol("document", TSVectorColumn, false, false, nil),
+		mkCol("foo", TextColumn, false, false, nil),
+	)
+	table.Indexes = []*IndexSchema{
+		mkIndex("table__document__gin", GINIndex, "", "document"),
+		mkIndex("table__foo_id__btree", BTreeIndex, "foo IS NOT NULL", "foo", "id"),
+	}
+
+	assertChange(
+		t,
+		&CreateTable{table},
+		`CREATE TABLE table (
+	id serial PRIMARY KEY,
+	document tsvector,
+	foo text
+);
+CREATE INDEX table__document__gin ON table USING gin (document);
+CREATE INDEX table__foo_id__btree ON table USING btree (foo, id) WHERE foo IS NOT NULL;
+
+`)
+}
+
 func TestDropTable(t *testing.T) {
 	assertChange(
 		t,
@@ -194,6 +224,72 @@ func TestAddColumn(t *testing.T) {
 		},
 		"ALTER TABLE table ADD COLUMN foo smallint NOT NULL;\n",
 	)
+
+	assertChange(
+		t,
+		&AddColumn{
+			mkColDefault("foo", SmallIntColumn, "0", "foo >= 0"),
+			"table",
+		},
+		"ALTER TABLE table ADD COLUMN foo smallint DEFAULT 0 NOT NULL CHECK (foo >= 0);\n",
+	)
+}
+
+func TestCreateIndex(t *testing.T) {
+	assertChange(
+		t,
+		&CreateIndex{"table", mkIndex("table__foo__gin", GINIndex, "", "foo"), false},
+		"CREATE INDEX table__foo__gin ON table USING gin (foo);\n",
+	)
+
+	assertChange(
+		t,
+		&CreateIndex{"table", mkIndex("table__foo_bar__btree", BTreeIndex, "bar > 0", "foo", "bar"), true},
+		"CREATE INDEX CONCURRENTLY table__foo_bar__btree ON table USING btree (foo, bar) WHERE bar > 0;\n",
+	)
+
+	assertChange(
+		t,
+		&CreateIndex{"table", &IndexSchema{Name: "table__foo__unique", Method: BTreeIndex, Columns: []string{"foo"}, Unique: true}, false},
+		"CREATE UNIQUE INDEX table__foo__unique ON table USING btree (foo);\n",
+	)
+}
+
+func TestDropIndex(t *testing.T) {
+	assertChange(
+		t,
+		&DropIndex{"table", mkIndex("table__foo__gin", GINIndex, "", "foo"), false},
+		"DROP INDEX table__foo__gin;\n",
+	)
+
+	assertChange(
+		t,
+		&DropIndex{"table", mkIndex("table__foo__gin", GINIndex, "", "foo"), true},
+		"DROP INDEX CONCURRENTLY table__foo__gin;\n",
+	)
+}
+
+func TestChangeSet_ConcurrentIndexes(t *testing.T) {
+	assertChange(
+		t,
+		ChangeSet{
+			&AddColumn{mkCol("foo", TextColumn, false, false, nil), "table"},
+			&CreateIndex{Table: "table", Index: mkIndex("table__foo__hash", HashIndex, "", "foo")},
+		}.withConcurrentIndexes(),
+		"BEGIN;\n\nALTER TABLE table ADD COLUMN foo text;\n\nCOMMIT;\n\nCREATE INDEX CONCURRENTLY table__foo__hash ON table USING hash (foo);\n",
+	)
+}
+
+func TestChangeSet_AddEnumValue(t *testing.T) {
+	assertChange(
+		t,
+		ChangeSet{
+			&AddColumn{mkCol("foo", TextColumn, false, false, nil), "table"},
+			&AddEnumValue{Enum: "status", Value: "deleted", After: "active"},
+			&CreateIndex{Table: "table", Index: mkIndex("table__foo__hash", HashIndex, "", "foo"), Concurrently: true},
+		},
+		"ALTER TYPE status ADD VALUE 'deleted' AFTER 'active';\n\nBEGIN;\n\nALTER TABLE table ADD COLUMN foo text;\n\nCOMMIT;\n\nCREATE INDEX CONCURRENTLY table__foo__hash ON table USING hash (foo);\n",
+	)
 }
 
 func TestDropColumn(t *testing.T) {
@@ -204,6 +300,192 @@ func TestDropColumn(t *testing.T) {
 	)
 }
 
+func TestRenameTable(t *testing.T) {
+	table := mkTable(
+		"users",
+		mkColUnique("email", TextColumn, false, true, nil),
+		mkCol("group_id", BigIntColumn, false, false, mkRef("groups", "id", false)),
+	)
+	table.Indexes = []*IndexSchema{
+		mkIndex("users__email__hash", HashIndex, "", "email"),
+		mkIndex("custom", BTreeIndex, "", "group_id"),
+	}
+
+	assertChange(
+		t,
+		&RenameTable{Name: "users", NewName: "people", Table: table},
+		`ALTER TABLE users RENAME TO people;
+ALTER TABLE people RENAME CONSTRAINT users_email_key TO people_email_key;
+ALTER TABLE people RENAME CONSTRAINT users_group_id_fkey TO people_group_id_fkey;
+ALTER INDEX users__email__hash RENAME TO people__email__hash;
+`)
+}
+
+func TestRenameColumn(t *testing.T) {
+	assertChange(
+		t,
+		&RenameColumn{Table: "users", Name: "name", NewName: "full_name"},
+		"ALTER TABLE users RENAME COLUMN name TO full_name;\n",
+	)
+
+	assertChange(
+		t,
+		&RenameColumn{Table: "users", Name: "group", NewName: "group_id", Unique: true, ForeignKey: true, Check: true},
+		`ALTER TABLE users RENAME COLUMN group TO group_id;
+ALTER TABLE users RENAME CONSTRAINT users_group_key TO users_group_id_key;
+ALTER TABLE users RENAME CONSTRAINT users_group_fkey TO users_group_id_fkey;
+ALTER TABLE users RENAME CONSTRAINT users_group_check TO users_group_id_check;
+`)
+}
+
+func TestAlterColumnType(t *testing.T) {
+	cases := []struct {
+		old, new ColumnType
+		expected string
+	}{
+		{IntegerColumn, BigIntColumn, "ALTER TABLE table ALTER COLUMN col TYPE bigint USING col::bigint;\n"},
+		{TextColumn, JSONBColumn, "ALTER TABLE table ALTER COLUMN col TYPE jsonb USING col::jsonb;\n"},
+		{JSONBColumn, TextColumn, "ALTER TABLE table ALTER COLUMN col TYPE text USING col #>> '{}';\n"},
+		{SerialColumn, BigSerialColumn, "ALTER TABLE table ALTER COLUMN col TYPE bigint USING col::bigint;\n"},
+		{ArrayColumn(IntegerColumn), ArrayColumn(BigIntColumn), "ALTER TABLE table ALTER COLUMN col TYPE bigint[] USING col::bigint[];\n"},
+	}
+
+	for _, c := range cases {
+		assertChange(
+			t,
+			&AlterColumnType{Table: "table", Name: "col", Type: c.new, OldType: c.old},
+			c.expected,
+		)
+	}
+}
+
+func TestAlterColumnType_Rewrites(t *testing.T) {
+	require.True(t, rewritesTable(&AlterColumnType{Table: "table", Name: "col", Type: BigIntColumn, OldType: IntegerColumn}))
+	require.False(t, rewritesTable(&AlterColumnType{Table: "table", Name: "col", Type: IntegerColumn, OldType: SerialColumn}))
+	require.False(t, rewritesTable(&SetNotNull{Table: "table", Name: "col"}))
+}
+
+func TestNotNull(t *testing.T) {
+	assertChange(
+		t,
+		&SetNotNull{Table: "table", Name: "col"},
+		"ALTER TABLE table ALTER COLUMN col SET NOT NULL;\n",
+	)
+
+	assertChange(
+		t,
+		&DropNotNull{Table: "table", Name: "col"},
+		"ALTER TABLE table ALTER COLUMN col DROP NOT NULL;\n",
+	)
+}
+
+func TestForeignKey(t *testing.T) {
+	assertChange(
+		t,
+		&AddForeignKey{Table: "table", Name: "other_id", Reference: mkRef("other", "id", false)},
+		"ALTER TABLE table ADD CONSTRAINT table_other_id_fkey FOREIGN KEY (other_id) REFERENCES other(id);\n",
+	)
+
+	assertChange(
+		t,
+		&DropForeignKey{Table: "table", Name: "other_id"},
+		"ALTER TABLE table DROP CONSTRAINT table_other_id_fkey;\n",
+	)
+}
+
+func TestUnique(t *testing.T) {
+	assertChange(
+		t,
+		&AddUnique{Table: "table", Name: "col"},
+		"ALTER TABLE table ADD CONSTRAINT table_col_key UNIQUE (col);\n",
+	)
+
+	assertChange(
+		t,
+		&DropUnique{Table: "table", Name: "col"},
+		"ALTER TABLE table DROP CONSTRAINT table_col_key;\n",
+	)
+}
+
+func TestPrimaryKey(t *testing.T) {
+	assertChange(
+		t,
+		&AddPrimaryKey{Table: "table", Columns: []string{"a_id", "b_id"}},
+		"ALTER TABLE table ADD CONSTRAINT table_pkey PRIMARY KEY (a_id, b_id);\n",
+	)
+
+	assertChange(
+		t,
+		&DropPrimaryKey{Table: "table", Columns: []string{"a_id", "b_id"}},
+		"ALTER TABLE table DROP CONSTRAINT table_pkey;\n",
+	)
+}
+
+func TestConstraintName(t *testing.T) {
+	require.Equal(t, "users_email_key", constraintName("users", "email", "key"))
+
+	name := constraintName(strings.Repeat("t", 50), strings.Repeat("c", 20), "fkey")
+	require.Equal(t, strings.Repeat("t", 37)+"_"+strings.Repeat("c", 20)+"_fkey", name)
+	require.Len(t, name, maxIdentifierLen)
+
+	name = constraintName(strings.Repeat("t", 40), strings.Repeat("c", 40), "key")
+	require.Equal(t, strings.Repeat("t", 29)+"_"+strings.Repeat("c", 29)+"_key", name)
+}
+
+func TestAlterColumnDefault(t *testing.T) {
+	assertChange(
+		t,
+		&AlterColumnDefault{Table: "table", Name: "col", Default: "'foo'", OldDefault: "'bar'"},
+		"ALTER TABLE table ALTER COLUMN col SET DEFAULT 'foo';\n",
+	)
+
+	assertChange(
+		t,
+		&AlterColumnDefault{Table: "table", Name: "col", OldDefault: "'bar'"},
+		"ALTER TABLE table ALTER COLUMN col DROP DEFAULT;\n",
+	)
+}
+
+func TestCheck(t *testing.T) {
+	assertChange(
+		t,
+		&AddCheck{Table: "table", Name: "col", Check: "col > 0"},
+		"ALTER TABLE table ADD CONSTRAINT table_col_check CHECK (col > 0);\n",
+	)
+
+	assertChange(
+		t,
+		&DropCheck{Table: "table", Name: "col"},
+		"ALTER TABLE table DROP CONSTRAINT table_col_check;\n",
+	)
+}
+
+func TestEnum(t *testing.T) {
+	assertChange(
+		t,
+		&CreateEnum{&EnumSchema{"status", []string{"active", "can't"}}},
+		"CREATE TYPE status AS ENUM ('active', 'can''t');\n",
+	)
+
+	assertChange(
+		t,
+		&DropEnum{Name: "status"},
+		"DROP TYPE status;\n",
+	)
+
+	assertChange(
+		t,
+		&AddEnumValue{Enum: "status", Value: "deleted", After: "active"},
+		"ALTER TYPE status ADD VALUE 'deleted' AFTER 'active';\n",
+	)
+
+	assertChange(
+		t,
+		&AddEnumValue{Enum: "status", Value: "pending", Before: "active"},
+		"ALTER TYPE status ADD VALUE 'pending' BEFORE 'active';\n",
+	)
+}
+
 func TestManualChange(t *testing.T) {
 	assertChange(
 		t,
@@ -273,16 +555,16 @@ func TestColumnSchemaDiff_Unique(t *testing.T) {
 		result   Change
 	}{
 		{
-			"unique index added",
+			"unique constraint added",
 			mkCol("foo", TextColumn, false, false, nil),
 			mkColUnique("foo", TextColumn, false, false, nil),
-			&CreateIndex{"table", "foo", "unique"},
+			&AddUnique{Table: "table", Name: "foo"},
 		},
 		{
-			"unique index dropped",
+			"unique constraint dropped",
 			mkColUnique("foo", TextColumn, false, false, nil),
 			mkCol("foo", TextColumn, false, false, nil),
-			&DropIndex{"table", "foo", "unique"},
+			&DropUnique{Table: "table", Name: "foo"},
 		},
 	}
 
@@ -295,134 +577,490 @@ func TestColumnSchemaDiff_Unique(t *testing.T) {
 	}
 }
 
+func TestTableSchemaDiff_Indexes(t *testing.T) {
+	var (
+		removed = mkIndex("table__removed__btree", BTreeIndex, "", "removed")
+		shared  = mkIndex("table__shared__gin", GINIndex, "", "shared")
+		old     = mkIndex("table__changed__btree", BTreeIndex, "", "changed")
+		changed = mkIndex("table__changed__btree", BTreeIndex, "changed > 0", "changed")
+		added   = mkIndex("table__new__btree", BTreeIndex, "", "new")
+	)
+
+	oldTable := mkTable(
+		"table",
+		mkCol("removed", TextColumn, false, false, nil),
+		mkCol("shared", TextColumn, false, false, nil),
+		mkCol("changed", TextColumn, false, false, nil),
+	)
+	oldTable.Indexes = []*IndexSchema{removed, shared, old}
+
+	newTable := mkTable(
+		"table",
+		mkCol("new", TextColumn, false, false, nil),
+		mkCol("shared", TextColumn, false, false, nil),
+		mkCol("changed", TextColumn, false, false, nil),
+	)
+	newTable.Indexes = []*IndexSchema{shared, changed, added}
+
+	expected := ChangeSet{
+		&DropIndex{Table: "table", Index: removed},
+		&DropIndex{Table: "table", Index: old},
+		&DropColumn{"removed", "table"},
+		&AddColumn{mkCol("new", TextColumn, false, false, nil), "table"},
+		&CreateIndex{Table: "table", Index: changed},
+		&CreateIndex{Table: "table", Index: added},
+	}
+
+	require.Equal(t, expected, TableSchemaDiff(oldTable, newTable))
+}
+
 func TestColumnSchemaDiff(t *testing.T) {
 	cases := []struct {
-		name                 string
-		old, new             *ColumnSchema
-		requiresManualChange bool
+		name     string
+		old, new *ColumnSchema
+		expected ChangeSet
 	}{
 		{
 			"type change",
 			mkCol("foo", TextColumn, false, false, nil),
 			mkCol("foo", SmallIntColumn, false, false, nil),
-			true,
+			ChangeSet{
+				&AlterColumnType{Table: "table", Name: "foo", Type: SmallIntColumn, OldType: TextColumn},
+			},
+		},
+		{
+			"auto increment change",
+			mkCol("foo", IntegerColumn, false, false, nil),
+			mkCol("foo", SerialColumn, false, false, nil),
+			ChangeSet{
+				&ManualChange{"don't know how to generate migration for a change of auto increment in table(foo)"},
+			},
 		},
 		{
 			"pk change",
 			mkCol("foo", TextColumn, true, false, nil),
 			mkCol("foo", TextColumn, false, false, nil),
-			true,
+			ChangeSet{
+				&ManualChange{"don't know how to generate migration for a change of primary key in table(foo)"},
+			},
+		},
+		{
+			"not null added",
+			mkCol("foo", TextColumn, false, false, nil),
+			mkCol("foo", TextColumn, false, true, nil),
+			ChangeSet{
+				&SetNotNull{Table: "table", Name: "foo"},
+			},
 		},
 		{
-			"not null change",
+			"not null removed",
 			mkCol("foo", TextColumn, false, true, nil),
 			mkCol("foo", TextColumn, false, false, nil),
-			true,
+			ChangeSet{
+				&DropNotNull{Table: "table", Name: "foo"},
+			},
 		},
 		{
 			"ref added",
 			mkCol("foo", TextColumn, false, false, nil),
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "bar", false)),
-			true,
+			ChangeSet{
+				&AddForeignKey{Table: "table", Name: "foo", Reference: mkRef("foo", "bar", false)},
+			},
 		},
 		{
 			"ref removed",
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "bar", false)),
 			mkCol("foo", TextColumn, false, false, nil),
-			true,
+			ChangeSet{
+				&DropForeignKey{Table: "table", Name: "foo"},
+			},
 		},
 		{
 			"ref table changed",
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "bar", false)),
 			mkCol("foo", TextColumn, false, false, mkRef("bar", "bar", false)),
-			true,
+			ChangeSet{
+				&DropForeignKey{Table: "table", Name: "foo"},
+				&AddForeignKey{Table: "table", Name: "foo", Reference: mkRef("bar", "bar", false)},
+			},
 		},
 		{
 			"ref col changed",
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "bar", false)),
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "foo", false)),
-			true,
+			ChangeSet{
+				&DropForeignKey{Table: "table", Name: "foo"},
+				&AddForeignKey{Table: "table", Name: "foo", Reference: mkRef("foo", "foo", false)},
+			},
 		},
 		{
 			"ref col unchanged",
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "bar", false)),
 			mkCol("foo", TextColumn, false, false, mkRef("foo", "bar", false)),
-			false,
+			nil,
 		},
 		{
-			"equal",
-			mkCol("foo", TextColumn, false, false, nil),
-			mkCol("foo", TextColumn, false, false, nil),
-			false,
+			"constraints dropped before and added after a type change",
+			mkColUnique("foo", IntegerColumn, false, false, mkRef("foo", "bar", false)),
+			mkCol("foo", BigIntColumn, false, true, mkRef("bar", "bar", false)),
+			ChangeSet{
+				&DropForeignKey{Table: "table", Name: "foo"},
+				&DropUnique{Table: "table", Name: "foo"},
+				&AlterColumnType{Table: "table", Name: "foo", Type: BigIntColumn, OldType: IntegerColumn},
+				&SetNotNull{Table: "table", Name: "foo"},
+				&AddForeignKey{Table: "table", Name: "foo", Reference: mkRef("bar", "bar", false)},
+			},
 		},
-	}
-
-	for _, c := range cases {
-		changes := ColumnSchemaDiff("Table", c.old, c.new)
-		if !c.requiresManualChange {
-			require.Len(t, changes, 0, c.name)
-		} else {
-			require.True(t, len(changes) > 0, c.name)
-		}
-	}
-}
-
-func TestReverseChange(t *testing.T) {
-	require := require.New(t)
-	old := mkSchema(
-		mkTable(
-			"foo",
-			mkCol("bar", SmallIntColumn, false, false, nil),
-		),
-	)
-
-	cases := []struct {
-		original Change
-		expected Change
-	}{
 		{
-			&CreateTable{&TableSchema{Name: "foo"}},
-			&DropTable{Name: "foo"},
+			"default added",
+			mkCol("foo", TextColumn, false, true, nil),
+			mkColDefault("foo", TextColumn, "''", ""),
+			ChangeSet{
+				&AlterColumnDefault{Table: "table", Name: "foo", Default: "''"},
+			},
 		},
 		{
-			&DropTable{Name: "foo"},
-			&CreateTable{old.Table("foo")},
+			"default changed",
+			mkColDefault("foo", TextColumn, "'a'", ""),
+			mkColDefault("foo", TextColumn, "'b'", ""),
+			ChangeSet{
+				&AlterColumnDefault{Table: "table", Name: "foo", Default: "'b'", OldDefault: "'a'"},
+			},
 		},
 		{
-			&AddColumn{
-				Table:  "foo",
-				Column: mkCol("bar", SmallIntColumn, false, false, nil),
+			"default dropped",
+			mkColDefault("foo", TextColumn, "'a'", ""),
+			mkCol("foo", TextColumn, false, true, nil),
+			ChangeSet{
+				&AlterColumnDefault{Table: "table", Name: "foo", OldDefault: "'a'"},
 			},
-			&DropColumn{Table: "foo", Name: "bar"},
 		},
 		{
-			&DropColumn{Table: "foo", Name: "bar"},
-			&AddColumn{
-				Table:  "foo",
-				Column: mkCol("bar", SmallIntColumn, false, false, nil),
+			"default dropped before a type change",
+			mkColDefault("foo", TextColumn, "'1'", ""),
+			mkColDefault("foo", IntegerColumn, "1", ""),
+			ChangeSet{
+				&AlterColumnDefault{Table: "table", Name: "foo", OldDefault: "'1'"},
+				&AlterColumnType{Table: "table", Name: "foo", Type: IntegerColumn, OldType: TextColumn},
+				&AlterColumnDefault{Table: "table", Name: "foo", Default: "1"},
 			},
 		},
 		{
-			&CreateIndex{"foo", "bar", "baz"},
-			&DropIndex{"foo", "bar", "baz"},
+			"check changed",
+			mkColDefault("foo", IntegerColumn, "", "foo > 0"),
+			mkColDefault("foo", IntegerColumn, "", "foo > 1"),
+			ChangeSet{
+				&DropCheck{Table: "table", Name: "foo"},
+				&AddCheck{Table: "table", Name: "foo", Check: "foo > 1"},
+			},
 		},
 		{
-			&DropIndex{"foo", "bar", "baz"},
-			&CreateIndex{"foo", "bar", "baz"},
+			"check dropped",
+			mkColDefault("foo", IntegerColumn, "", "foo > 0"),
+			mkCol("foo", IntegerColumn, false, true, nil),
+			ChangeSet{
+				&DropCheck{Table: "table", Name: "foo"},
+			},
 		},
 		{
-			&ManualChange{"foo"},
-			&ManualChange{"foo"},
+			"equal",
+			mkCol("foo", TextColumn, false, false, nil),
+			mkCol("foo", TextColumn, false, false, nil),
+			nil,
 		},
 	}
 
 	for _, c := range cases {
-		require.Equal(c.expected, c.original.Reverse(old), "%T", c.original)
+		require.Equal(t, c.expected, ColumnSchemaDiff("table", c.old, c.new), c.name)
 	}
 }
 
-func TestTableSchemaEquals(t *testing.T) {
-	cases := []struct {
+func TestSchemaDiff_Renames(t *testing.T) {
+	oldUsers := mkTable(
+		"users",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("name", TextColumn, false, true, nil),
+		mkCol("age", IntegerColumn, false, true, nil),
+	)
+	oldUsers.Indexes = []*IndexSchema{mkIndex("users__name__btree", BTreeIndex, "", "name")}
+	old := mkSchema(oldUsers)
+
+	fullName := mkCol("full_name", TextColumn, false, true, nil)
+	fullName.OldName = "name"
+	people := mkTable(
+		"people",
+		mkCol("id", SerialColumn, true, true, nil),
+		fullName,
+		mkCol("age", BigIntColumn, false, true, nil),
+	)
+	people.OldName = "users"
+	people.Indexes = []*IndexSchema{mkIndex("people__full_name__btree", BTreeIndex, "", "full_name")}
+	groups := mkTable(
+		"groups",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("owner_id", BigIntColumn, false, true, mkRef("people", "id", false)),
+	)
+	new := mkSchema(people, groups)
+
+	migration, err := NewMigration(old, new)
+	require.NoError(t, err)
+
+	require.Equal(t, ChangeSet{
+		&RenameTable{Name: "users", NewName: "people", Table: oldUsers},
+		&RenameColumn{Table: "people", Name: "name", NewName: "full_name"},
+		&CreateTable{groups},
+		&DropIndex{Table: "people", Index: mkIndex("people__name__btree", BTreeIndex, "", "name")},
+		&AlterColumnType{Table: "people", Name: "age", Type: BigIntColumn, OldType: IntegerColumn},
+		&CreateIndex{Table: "people", Index: mkIndex("people__full_name__btree", BTreeIndex, "", "full_name")},
+	}, migration.Up)
+
+	require.Equal(t, ChangeSet{
+		&DropIndex{Table: "people", Index: mkIndex("people__full_name__btree", BTreeIndex, "", "full_name")},
+		&AlterColumnType{Table: "people", Name: "age", Type: IntegerColumn, OldType: BigIntColumn},
+		&CreateIndex{Table: "people", Index: mkIndex("people__name__btree", BTreeIndex, "", "name")},
+		&RenameColumn{Table: "people", Name: "full_name", NewName: "name"},
+		&RenameTable{Name: "people", NewName: "users", Table: oldUsers.renamed("people")},
+		&DropTable{Name: "groups"},
+	}, migration.Down)
+}
+
+func TestNewMigration_RenamedTableChanges(t *testing.T) {
+	old := mkSchema(
+		mkTable("groups", mkCol("id", SerialColumn, true, true, nil)),
+		mkTable(
+			"users",
+			mkCol("id", SerialColumn, true, true, nil),
+			mkCol("group", BigIntColumn, false, true, mkRef("groups", "id", false)),
+			mkCol("age", IntegerColumn, false, true, nil),
+		),
+	)
+
+	groupID := mkCol("group_id", BigIntColumn, false, true, nil)
+	groupID.OldName = "group"
+	people := mkTable(
+		"people",
+		mkCol("id", SerialColumn, true, true, nil),
+		groupID,
+	)
+	people.OldName = "users"
+	new := mkSchema(old.Table("groups"), people)
+
+	migration, err := NewMigration(old, new)
+	require.NoError(t, err)
+
+	require.Equal(t, ChangeSet{
+		&RenameTable{Name: "users", NewName: "people", Table: old.Table("users")},
+		&RenameColumn{Table: "people", Name: "group", NewName: "group_id", ForeignKey: true},
+		&DropForeignKey{Table: "people", Name: "group_id"},
+		&DropColumn{Table: "people", Name: "age"},
+	}, migration.Up)
+
+	require.Equal(t, ChangeSet{
+		&AddColumn{Table: "people", Column: mkCol("age", IntegerColumn, false, true, nil)},
+		&AddForeignKey{Table: "people", Name: "group_id", Reference: mkRef("groups", "id", false)},
+		&RenameColumn{Table: "people", Name: "group_id", NewName: "group", ForeignKey: true},
+		&RenameTable{Name: "people", NewName: "users", Table: old.Table("users").renamed("people")},
+	}, migration.Down)
+}
+
+func TestSchemaDiff_StaleOldName(t *testing.T) {
+	old := mkSchema(mkTable("people", mkCol("full_name", TextColumn, false, true, nil)))
+
+	fullName := mkCol("full_name", TextColumn, false, true, nil)
+	fullName.OldName = "name"
+	people := mkTable("people", fullName)
+	people.OldName = "users"
+
+	require.Len(t, SchemaDiff(old, mkSchema(people)), 0)
+}
+
+func TestLikelyRenames(t *testing.T) {
+	old := mkSchema(
+		mkTable(
+			"users",
+			mkCol("name", TextColumn, false, true, nil),
+			mkCol("age", IntegerColumn, false, true, nil),
+			mkCol("email", TextColumn, false, true, nil),
+			mkCol("phone", TextColumn, false, true, nil),
+		),
+		mkTable(
+			"posts",
+			mkCol("title", TextColumn, false, true, nil),
+		),
+	)
+	new := mkSchema(
+		mkTable(
+			"users",
+			mkCol("full_name", TextColumn, false, true, nil),
+			mkCol("years", IntegerColumn, false, true, nil),
+			mkCol("email", TextColumn, false, true, nil),
+			mkCol("contact", TextColumn, false, true, nil),
+		),
+		mkTable(
+			"posts",
+			mkCol("headline", TextColumn, false, true, nil),
+			mkCol("summary", TextColumn, false, true, nil),
+		),
+	)
+
+	renames := LikelyRenames(old, new)
+	require.Equal(t, []*LikelyRename{
+		{
+			Table: "users",
+			Old:   old.Table("users").Column("age"),
+			New:   new.Table("users").Column("years"),
+		},
+	}, renames)
+}
+
+func TestNewMigration_ForeignKeyToDroppedTable(t *testing.T) {
+	old := mkSchema(
+		mkTable("a", mkCol("id", SerialColumn, true, false, nil)),
+		mkTable(
+			"b",
+			mkCol("id", SerialColumn, true, false, nil),
+			mkCol("a_id", BigIntColumn, false, false, mkRef("a", "id", false)),
+		),
+	)
+	new := mkSchema(
+		mkTable(
+			"b",
+			mkCol("id", SerialColumn, true, false, nil),
+			mkCol("a_id", BigIntColumn, false, false, nil),
+		),
+	)
+
+	migration, err := NewMigration(old, new)
+	require.NoError(t, err)
+
+	require.Equal(t, ChangeSet{
+		&DropForeignKey{Table: "b", Name: "a_id"},
+		&DropTable{Name: "a"},
+	}, migration.Up)
+
+	require.Equal(t, ChangeSet{
+		&CreateTable{old.Table("a")},
+		&AddForeignKey{Table: "b", Name: "a_id", Reference: mkRef("a", "id", false)},
+	}, migration.Down)
+}
+
+func TestReverseChange(t *testing.T) {
+	require := require.New(t)
+	old := mkSchema(
+		mkTable(
+			"foo",
+			mkCol("bar", SmallIntColumn, false, false, nil),
+			mkCol("baz", BigIntColumn, false, false, mkRef("qux", "id", false)),
+			mkColDefault("qux", IntegerColumn, "", "qux > 0"),
+		),
+	)
+
+	cases := []struct {
+		original Change
+		expected Change
+	}{
+		{
+			&CreateTable{&TableSchema{Name: "foo"}},
+			&DropTable{Name: "foo"},
+		},
+		{
+			&DropTable{Name: "foo"},
+			&CreateTable{old.Table("foo")},
+		},
+		{
+			&AddColumn{
+				Table:  "foo",
+				Column: mkCol("bar", SmallIntColumn, false, false, nil),
+			},
+			&DropColumn{Table: "foo", Name: "bar"},
+		},
+		{
+			&DropColumn{Table: "foo", Name: "bar"},
+			&AddColumn{
+				Table:  "foo",
+				Column: mkCol("bar", SmallIntColumn, false, false, nil),
+			},
+		},
+		{
+			&CreateIndex{"foo", mkIndex("foo__bar__btree", BTreeIndex, "", "bar"), true},
+			&DropIndex{"foo", mkIndex("foo__bar__btree", BTreeIndex, "", "bar"), true},
+		},
+		{
+			&DropIndex{"foo", mkIndex("foo__bar__btree", BTreeIndex, "", "bar"), false},
+			&CreateIndex{"foo", mkIndex("foo__bar__btree", BTreeIndex, "", "bar"), false},
+		},
+		{
+			ChangeSet{
+				&AddColumn{Table: "foo", Column: mkCol("bar", SmallIntColumn, false, false, nil)},
+				&CreateIndex{Table: "foo", Index: mkIndex("foo__bar__btree", BTreeIndex, "", "bar")},
+			},
+			ChangeSet{
+				&DropIndex{Table: "foo", Index: mkIndex("foo__bar__btree", BTreeIndex, "", "bar")},
+				&DropColumn{Table: "foo", Name: "bar"},
+			},
+		},
+		{
+			&RenameTable{Name: "foo", NewName: "qux", Table: old.Table("foo")},
+			&RenameTable{Name: "qux", NewName: "foo", Table: old.Table("foo").renamed("qux")},
+		},
+		{
+			&RenameColumn{Table: "foo", Name: "bar", NewName: "qux", ForeignKey: true},
+			&RenameColumn{Table: "foo", Name: "qux", NewName: "bar", ForeignKey: true},
+		},
+		{
+			&AlterColumnType{Table: "foo", Name: "bar", Type: IntegerColumn, OldType: SmallIntColumn},
+			&AlterColumnType{Table: "foo", Name: "bar", Type: SmallIntColumn, OldType: IntegerColumn},
+		},
+		{
+			&SetNotNull{Table: "foo", Name: "bar"},
+			&DropNotNull{Table: "foo", Name: "bar"},
+		},
+		{
+			&DropNotNull{Table: "foo", Name: "bar"},
+			&SetNotNull{Table: "foo", Name: "bar"},
+		},
+		{
+			&AddForeignKey{Table: "foo", Name: "bar", Reference: mkRef("qux", "id", false)},
+			&DropForeignKey{Table: "foo", Name: "bar"},
+		},
+		{
+			&DropForeignKey{Table: "foo", Name: "baz"},
+			&AddForeignKey{Table: "foo", Name: "baz", Reference: mkRef("qux", "id", false)},
+		},
+		{
+			&AddUnique{Table: "foo", Name: "bar"},
+			&DropUnique{Table: "foo", Name: "bar"},
+		},
+		{
+			&DropUnique{Table: "foo", Name: "bar"},
+			&AddUnique{Table: "foo", Name: "bar"},
+		},
+		{
+			&AlterColumnDefault{Table: "foo", Name: "bar", Default: "1", OldDefault: "2"},
+			&AlterColumnDefault{Table: "foo", Name: "bar", Default: "2", OldDefault: "1"},
+		},
+		{
+			&AddCheck{Table: "foo", Name: "qux", Check: "qux > 1"},
+			&DropCheck{Table: "foo", Name: "qux"},
+		},
+		{
+			&DropCheck{Table: "foo", Name: "qux"},
+			&AddCheck{Table: "foo", Name: "qux", Check: "qux > 0"},
+		},
+		{
+			&ManualChange{"foo"},
+			&ManualChange{"foo"},
+		},
+	}
+
+	for _, c := range cases {
+		require.Equal(c.expected, c.original.Reverse(old), "%T", c.original)
+	}
+}
+
+func TestTableSchemaEquals(t *testing.T) {
+	cases := []struct {
 		name     string
 		schema   *TableSchema
 		expected bool
@@ -451,6 +1089,20 @@ func TestTableSchemaEquals(t *testing.T) {
 			),
 			false,
 		},
+		{
+			"different indexes",
+			&TableSchema{
+				Name: "foo",
+				Columns: []*ColumnSchema{
+					mkCol("col1", IntegerColumn, false, false, nil),
+					mkCol("col2", IntegerColumn, false, false, nil),
+				},
+				Indexes: []*IndexSchema{
+					mkIndex("foo__col1__btree", BTreeIndex, "", "col1"),
+				},
+			},
+			false,
+		},
 		{
 			"equal",
 			mkTable(
@@ -624,7 +1276,7 @@ type UUIDTable struct {
 `
 
 func (s *PackageTransformerSuite) SetupTest() {
-	s.t = newPackageTransformer()
+	s.t = newPackageTransformer(migrationDialects[kallax.PostgreSQL.Name()])
 	var err error
 	s.pkg, err = processFixture(packageTransformerSourceFixture)
 	s.Require().NoError(err)
@@ -711,6 +1363,552 @@ func (s *PackageTransformerSuite) TestTransform_RepeatedTable() {
 	s.Error(err)
 }
 
+const manyToManySourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type Post struct {
+	kallax.Model ` + "`table:\"posts\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	Tags []*Tag ` + "`through:\"posts_tags\"`" + `
+}
+
+type Tag struct {
+	kallax.Model ` + "`table:\"tags\"`" + `
+	ID kallax.ULID ` + "`pk:\"\"`" + `
+	Name string
+	// same join table from the other side
+	Posts []Post ` + "`through:\"posts_tags\"`" + `
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_ManyToMany() {
+	require := s.Require()
+	pkg, err := processFixture(manyToManySourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	expected := mkSchema(
+		mkTable(
+			"posts",
+			mkCol("id", SerialColumn, true, true, nil),
+		),
+		mkTable(
+			"tags",
+			mkCol("id", UUIDColumn, true, true, nil),
+			mkCol("name", TextColumn, false, true, nil),
+		),
+		mkTable(
+			"posts_tags",
+			mkCol("post_id", BigIntColumn, true, true, mkRef("posts", "id", false)),
+			mkCol("tag_id", UUIDColumn, true, true, mkRef("tags", "id", false)),
+		),
+	)
+	require.Equal(expected, schema)
+
+	m, err := NewMigration(mkSchema(), schema)
+	require.NoError(err)
+	require.Equal("posts_tags", m.Up[len(m.Up)-1].(*CreateTable).Name)
+	require.Equal(
+		"CREATE TABLE posts_tags (\n"+
+			"\tpost_id bigint NOT NULL REFERENCES posts(id),\n"+
+			"\ttag_id uuid NOT NULL REFERENCES tags(id),\n"+
+			"\tPRIMARY KEY (post_id, tag_id)\n"+
+			");\n\n",
+		schema.Table("posts_tags").String(),
+	)
+
+	// join tables created without a primary key get one
+	old := mkSchema(
+		expected.Tables[0],
+		expected.Tables[1],
+		mkTable(
+			"posts_tags",
+			mkCol("post_id", BigIntColumn, false, true, mkRef("posts", "id", false)),
+			mkCol("tag_id", UUIDColumn, false, true, mkRef("tags", "id", false)),
+		),
+	)
+	m, err = NewMigration(old, schema)
+	require.NoError(err)
+	require.Equal(ChangeSet{
+		&AddPrimaryKey{Table: "posts_tags", Columns: []string{"post_id", "tag_id"}},
+	}, m.Up)
+	require.Equal(ChangeSet{
+		&DropPrimaryKey{Table: "posts_tags", Columns: []string{"post_id", "tag_id"}},
+	}, m.Down)
+}
+
+func (s *PackageTransformerSuite) TestTransform_ManyToManyConflictingJoinTable() {
+	pkg, err := processFixture(manyToManySourceFixture)
+	s.Require().NoError(err)
+
+	tags := findField(findModel(pkg, "Tag"), "Posts")
+	tags.Tag = reflect.StructTag(`through:"posts_tags,post"`)
+
+	_, err = s.t.transform(pkg)
+	s.Error(err)
+}
+
+const versionedSourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type Post struct {
+	kallax.Model ` + "`table:\"posts\"`" + `
+	kallax.Versioned
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+}
+
+type Comment struct {
+	kallax.Model ` + "`table:\"comments\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	Rev int32 ` + "`version:\"true\"`" + `
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_Versioned() {
+	require := s.Require()
+	pkg, err := processFixture(versionedSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	expected := mkSchema(
+		mkTable(
+			"comments",
+			mkCol("id", SerialColumn, true, true, nil),
+			mkCol("rev", IntegerColumn, false, true, nil),
+		),
+		mkTable(
+			"posts",
+			mkCol("id", SerialColumn, true, true, nil),
+			mkCol("version", BigIntColumn, false, true, nil),
+		),
+	)
+	require.Equal(expected, schema)
+}
+
+const softDeletesSourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type Post struct {
+	kallax.Model ` + "`table:\"posts\"`" + `
+	kallax.SoftDeletes
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_SoftDeletes() {
+	require := s.Require()
+	pkg, err := processFixture(softDeletesSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	expected := mkSchema(
+		mkTable(
+			"posts",
+			mkCol("id", SerialColumn, true, true, nil),
+			mkCol("deleted_at", TimestamptzColumn, false, false, nil),
+		),
+	)
+	require.Equal(expected, schema)
+}
+
+const fullTextSourceFixture = `
+package foo
+
+import (
+	"gopkg.in/src-d/go-kallax.v1"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+type Post struct {
+	kallax.Model ` + "`table:\"posts\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	Document types.TSVector ` + "`index:\"gin\"`" + `
+	Title string ` + "`sqltype:\"tsvector\"`" + `
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_FullText() {
+	require := s.Require()
+	pkg, err := processFixture(fullTextSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	table := mkTable(
+		"posts",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("document", TSVectorColumn, false, true, nil),
+		mkCol("title", TSVectorColumn, false, true, nil),
+	)
+	table.Indexes = []*IndexSchema{
+		mkIndex("posts__document__gin", GINIndex, "", "document"),
+	}
+	require.Equal(mkSchema(table), schema)
+}
+
+const oldNameSourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type Person struct {
+	kallax.Model ` + "`table:\"people\" oldname:\"users\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	FullName string ` + "`oldname:\"name\"`" + `
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_OldName() {
+	require := s.Require()
+	pkg, err := processFixture(oldNameSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	fullName := mkCol("full_name", TextColumn, false, true, nil)
+	fullName.OldName = "name"
+	table := mkTable(
+		"people",
+		mkCol("id", SerialColumn, true, true, nil),
+		fullName,
+	)
+	table.OldName = "users"
+	require.Equal(mkSchema(table), schema)
+
+	lock, err := schema.MarshalText()
+	require.NoError(err)
+	require.NotContains(string(lock), "OldName")
+}
+
+const defaultsSourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type Product struct {
+	kallax.Model ` + "`table:\"products\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	Name string ` + "`default:\"''\"`" + `
+	Price int64 ` + "`default:\"0\" check:\"price >= 0\"`" + `
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_Defaults() {
+	require := s.Require()
+	pkg, err := processFixture(defaultsSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	expected := mkSchema(mkTable(
+		"products",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkColDefault("name", TextColumn, "''", ""),
+		mkColDefault("price", BigIntColumn, "0", "price >= 0"),
+	))
+	require.Equal(expected, schema)
+
+	lock, err := schema.MarshalText()
+	require.NoError(err)
+
+	var loaded DBSchema
+	require.NoError(json.Unmarshal(lock, &loaded))
+	require.Equal(expected, &loaded)
+}
+
+const enumsSourceFixture = `
+package foo
+
+import (
+	"time"
+
+	"gopkg.in/src-d/go-kallax.v1"
+)
+
+type Status string
+
+const (
+	Active   Status = "active"
+	Inactive Status = "inactive"
+	Enabled         = Active
+)
+
+type Priority int
+
+const (
+	Low Priority = iota
+	High
+)
+
+type Flags int
+
+const FlagA Flags = 1
+
+type Task struct {
+	kallax.Model ` + "`table:\"tasks\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	Status Status
+	PrevStatus *Status
+	Priority Priority
+	Flags Flags ` + "`enum:\"-\"`" + `
+	Kind string ` + "`enum:\"bug, feature\"`" + `
+	Size int ` + "`enum:\"1, 2, 4\" check:\"size > 0\"`" + `
+	Month time.Month
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_Enums() {
+	require := s.Require()
+	pkg, err := processFixture(enumsSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	expected := mkSchema(mkTable(
+		"tasks",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("status", ColumnType("status"), false, true, nil),
+		mkCol("prev_status", ColumnType("status"), false, false, nil),
+		mkColDefault("priority", BigIntColumn, "", "priority IN (0, 1)"),
+		mkCol("flags", BigIntColumn, false, true, nil),
+		mkCol("kind", ColumnType("tasks_kind"), false, true, nil),
+		mkColDefault("size", BigIntColumn, "", "size > 0"),
+		mkCol("month", BigIntColumn, false, true, nil),
+	))
+	expected.Enums = []*EnumSchema{
+		{"status", []string{"active", "inactive"}},
+		{"tasks_kind", []string{"bug", "feature"}},
+	}
+	require.Equal(expected, schema)
+
+	lock, err := schema.MarshalText()
+	require.NoError(err)
+
+	var loaded DBSchema
+	require.NoError(json.Unmarshal(lock, &loaded))
+	require.Equal(expected, &loaded)
+}
+
+func (s *PackageTransformerSuite) TestTransform_InvalidEnum() {
+	pkg, err := processFixture(strings.Replace(enumsSourceFixture, "1, 2, 4", "1, two", 1))
+	s.Require().Error(err)
+	s.Nil(pkg)
+}
+
+const indexesSourceFixture = `
+package foo
+
+import "gopkg.in/src-d/go-kallax.v1"
+
+type User struct {
+	kallax.Model ` + "`table:\"users\" index:\"btree(name, email); hash(email) WHERE deleted = false\"`" + `
+	ID int64 ` + "`pk:\"autoincr\"`" + `
+	Name string ` + "`index:\"btree\"`" + `
+	Email string
+	Deleted bool
+}
+`
+
+func (s *PackageTransformerSuite) TestTransform_Indexes() {
+	require := s.Require()
+	pkg, err := processFixture(indexesSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	table := mkTable(
+		"users",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("name", TextColumn, false, true, nil),
+		mkCol("email", TextColumn, false, true, nil),
+		mkCol("deleted", BooleanColumn, false, true, nil),
+	)
+	table.Indexes = []*IndexSchema{
+		mkIndex("users__name__btree", BTreeIndex, "", "name"),
+		mkIndex("users__name_email__btree", BTreeIndex, "", "name", "email"),
+		mkIndex("users__email__hash", HashIndex, "deleted = false", "email"),
+	}
+	require.Equal(mkSchema(table), schema)
+}
+
+func (s *PackageTransformerSuite) TestTransform_IndexUnknownColumn() {
+	pkg, err := processFixture(strings.Replace(indexesSourceFixture, "btree(name, email)", "btree(name, phone)", 1))
+	s.Require().NoError(err)
+
+	_, err = s.t.transform(pkg)
+	s.Error(err)
+}
+
+func (s *PackageTransformerSuite) TestTransform_DuplicatedIndex() {
+	pkg, err := processFixture(strings.Replace(indexesSourceFixture, "btree(name, email)", "btree(name)", 1))
+	s.Require().NoError(err)
+
+	_, err = s.t.transform(pkg)
+	s.Error(err)
+}
+
+func (s *PackageTransformerSuite) TestTransform_UnknownIndex() {
+	pkg, err := processFixture(strings.Replace(fullTextSourceFixture, `index:"gin"`, `index:"foo"`, 1))
+	s.Require().NoError(err)
+
+	_, err = s.t.transform(pkg)
+	s.Error(err)
+}
+
+func (s *PackageTransformerSuite) TestTransform_SQLite() {
+	require := s.Require()
+	s.t = newPackageTransformer(migrationDialects[kallax.SQLite.Name()])
+	schema, err := s.t.transform(s.pkg)
+	require.NoError(err)
+
+	expected := mkSchema(
+		mkTable(
+			"profiles",
+			mkCol("id", IntegerColumn, true, true, nil),
+			mkCol("color", ColumnType("char(6)"), false, true, nil),
+			mkCol("background", TextColumn, false, true, nil),
+			mkCol("user_id", TextColumn, false, false, mkRef("users", "id", true)),
+			mkCol("spouse", TextColumn, false, false, nil),
+			mkCol("some_data", BlobColumn, false, true, nil),
+		),
+		mkTable(
+			"metadata",
+			mkCol("id", IntegerColumn, true, true, nil),
+			mkCol("metadata", TextColumn, false, true, nil),
+			mkCol("profile_id", IntegerColumn, false, true, mkRef("profiles", "id", false)),
+		),
+		mkTable(
+			"uuidtable",
+			mkCol("id", TextColumn, true, true, nil),
+			mkCol("satori_uuid", TextColumn, false, true, nil),
+			mkCol("gofrs_uuid", TextColumn, false, true, nil),
+		),
+		mkTable(
+			"users",
+			mkCol("id", TextColumn, true, true, nil),
+			mkColUnique("username", TextColumn, false, true, nil),
+			mkCol("emails", TextColumn, false, true, nil),
+			mkCol("phone", TextColumn, false, false, nil),
+		),
+	)
+
+	require.Equal(expected, schema)
+}
+
+func (s *PackageTransformerSuite) TestTransform_SQLiteEnums() {
+	require := s.Require()
+	s.t = newPackageTransformer(migrationDialects[kallax.SQLite.Name()])
+	pkg, err := processFixture(enumsSourceFixture)
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+
+	status := mkColDefault("prev_status", TextColumn, "", "prev_status IN ('active', 'inactive')")
+	status.NotNull = false
+	expected := mkSchema(mkTable(
+		"tasks",
+		mkCol("id", IntegerColumn, true, true, nil),
+		mkColDefault("status", TextColumn, "", "status IN ('active', 'inactive')"),
+		status,
+		mkColDefault("priority", IntegerColumn, "", "priority IN (0, 1)"),
+		mkCol("flags", IntegerColumn, false, true, nil),
+		mkColDefault("kind", TextColumn, "", "kind IN ('bug', 'feature')"),
+		mkColDefault("size", IntegerColumn, "", "size > 0"),
+		mkCol("month", IntegerColumn, false, true, nil),
+	))
+	require.Equal(expected, schema)
+}
+
+func (s *PackageTransformerSuite) TestTransform_SQLiteIndexes() {
+	require := s.Require()
+	s.t = newPackageTransformer(migrationDialects[kallax.SQLite.Name()])
+	pkg, err := processFixture(indexesSourceFixture)
+	require.NoError(err)
+
+	_, err = s.t.transform(pkg)
+	require.EqualError(err, "kallax: hash indexes are not supported by the sqlite dialect, used in index users__email__hash of table users")
+
+	s.t = newPackageTransformer(migrationDialects[kallax.SQLite.Name()])
+	pkg, err = processFixture(strings.Replace(indexesSourceFixture, "hash(email)", "btree(email)", 1))
+	require.NoError(err)
+
+	schema, err := s.t.transform(pkg)
+	require.NoError(err)
+	require.Equal([]*IndexSchema{
+		mkIndex("users__name__btree", "", "", "name"),
+		mkIndex("users__name_email__btree", "", "", "name", "email"),
+		mkIndex("users__email__btree", "", "deleted = false", "email"),
+	}, schema.Tables[0].Indexes)
+	require.Equal(
+		"CREATE INDEX users__email__btree ON users (email) WHERE deleted = false;\n",
+		schema.Tables[0].Indexes[2].statement("users", false),
+	)
+}
+
+func TestDialectSchemaFromPackages_Unknown(t *testing.T) {
+	_, err := DialectSchemaFromPackages(unknownDialect{kallax.PostgreSQL})
+	require.EqualError(t, err, "kallax: migrations can not be generated for the mysql dialect")
+}
+
+type unknownDialect struct {
+	kallax.Dialect
+}
+
+func (unknownDialect) Name() string { return "mysql" }
+
+func TestMigrationDialectSupported(t *testing.T) {
+	cs := ChangeSet{
+		&AddColumn{Table: "foo", Column: mkCol("bar", TextColumn, false, false, nil)},
+		&SetNotNull{Table: "foo", Name: "bar"},
+	}
+
+	require.Equal(t, cs, migrationDialects[kallax.PostgreSQL.Name()].supported(cs))
+	require.Equal(t, ChangeSet{
+		cs[0],
+		&ManualChange{`the sqlite dialect can not alter columns, the table needs to be rebuilt: The column "bar" of table "foo" is no longer nullable`},
+	}, migrationDialects[kallax.SQLite.Name()].supported(cs))
+}
+
+func TestMigrationDialectSupported_Renames(t *testing.T) {
+	table := mkTable("foo",
+		mkCol("id", SerialColumn, true, true, nil),
+		mkCol("bar", TextColumn, false, false, nil),
+	)
+	cs := ChangeSet{
+		&RenameTable{Name: "foo", NewName: "baz", Table: table},
+		&RenameColumn{Table: "baz", Name: "bar", NewName: "qux"},
+		&RenameColumn{Table: "baz", Name: "qux", NewName: "bar", Unique: true},
+	}
+
+	require.Equal(t, ChangeSet{
+		cs[0],
+		cs[1],
+		&ManualChange{`the sqlite dialect can not rename constraints nor indexes, the table needs to be rebuilt: The column "qux" of table "baz" has been renamed to "bar"`},
+	}, migrationDialects[kallax.SQLite.Name()].supported(cs))
+
+	table.Indexes = []*IndexSchema{{Name: "foo__bar__idx", Columns: []string{"bar"}}}
+	require.Equal(t, &ManualChange{`the sqlite dialect can not rename constraints nor indexes, the table needs to be rebuilt: Table "foo" has been renamed to "baz"`},
+		migrationDialects[kallax.SQLite.Name()].supported(cs)[0])
+}
+
 func TestPackageTransformer(t *testing.T) {
 	suite.Run(t, new(PackageTransformerSuite))
 }
@@ -739,20 +1937,91 @@ func TestGraphResolve(t *testing.T) {
 	require.Error(err)
 }
 
+func TestSchemaDiff_Enums(t *testing.T) {
+	old := mkSchema()
+	old.Enums = []*EnumSchema{
+		{"removed", []string{"a"}},
+		{"added", []string{"b", "d"}},
+		{"reordered", []string{"a", "b"}},
+		{"same", []string{"a"}},
+	}
+
+	new := mkSchema()
+	new.Enums = []*EnumSchema{
+		{"added", []string{"a", "b", "c", "d", "e"}},
+		{"reordered", []string{"b", "a"}},
+		{"same", []string{"a"}},
+		{"created", []string{"a"}},
+	}
+
+	expected := ChangeSet{
+		&AddEnumValue{Enum: "added", Value: "a", Before: "b"},
+		&AddEnumValue{Enum: "added", Value: "c", After: "b"},
+		&AddEnumValue{Enum: "added", Value: "e", After: "d"},
+		&ManualChange{`values of the enum type "reordered" have been removed or reordered`},
+		&CreateEnum{new.Enums[3]},
+		&DropEnum{Name: "removed"},
+	}
+	require.Equal(t, expected, SchemaDiff(old, new))
+}
+
+func TestNewMigration_Enums(t *testing.T) {
+	require := require.New(t)
+	old := mkSchema(
+		mkTable("users", mkCol("status", TextColumn, false, true, nil)),
+		mkTable("posts", mkCol("state", ColumnType("post_state"), false, true, nil)),
+	)
+	old.Enums = []*EnumSchema{{"post_state", []string{"draft"}}}
+
+	new := mkSchema(
+		mkTable("users", mkCol("status", ColumnType("user_status"), false, true, nil)),
+		mkTable("posts", mkCol("state", TextColumn, false, true, nil)),
+	)
+	new.Enums = []*EnumSchema{{"user_status", []string{"active"}}}
+
+	m, err := NewMigration(old, new)
+	require.NoError(err)
+
+	require.Equal(ChangeSet{
+		&CreateEnum{new.Enums[0]},
+		&AlterColumnType{Table: "users", Name: "status", Type: "user_status", OldType: TextColumn},
+		&AlterColumnType{Table: "posts", Name: "state", Type: TextColumn, OldType: "post_state"},
+		&DropEnum{Name: "post_state"},
+	}, m.Up)
+
+	require.Equal(ChangeSet{
+		&CreateEnum{old.Enums[0]},
+		&AlterColumnType{Table: "posts", Name: "state", Type: "post_state", OldType: TextColumn},
+		&AlterColumnType{Table: "users", Name: "status", Type: TextColumn, OldType: "user_status"},
+		&DropEnum{Name: "user_status"},
+	}, m.Down)
+}
+
 func mkSchema(tables ...*TableSchema) *DBSchema {
-	return &DBSchema{tables}
+	return &DBSchema{Tables: tables}
 }
 
 func mkTable(name string, columns ...*ColumnSchema) *TableSchema {
-	return &TableSchema{name, columns}
+	return &TableSchema{Name: name, Columns: columns}
 }
 
 func mkCol(name string, typ ColumnType, pk, notNull bool, ref *Reference) *ColumnSchema {
-	return &ColumnSchema{name, typ, pk, ref, notNull, false}
+	return &ColumnSchema{name, typ, pk, ref, notNull, false, "", "", ""}
+}
+
+func mkColDefault(name string, typ ColumnType, def, check string) *ColumnSchema {
+	col := mkCol(name, typ, false, true, nil)
+	col.Default = def
+	col.Check = check
+	return col
 }
 
 func mkColUnique(name string, typ ColumnType, pk, notNull bool, ref *Reference) *ColumnSchema {
-	return &ColumnSchema{name, typ, pk, ref, notNull, true}
+	return &ColumnSchema{name, typ, pk, ref, notNull, true, "", "", ""}
+}
+
+func mkIndex(name, method, where string, columns ...string) *IndexSchema {
+	return &IndexSchema{Name: name, Method: method, Columns: columns, Where: where}
 }
 
 func mkRef(table, col string, inverse bool) *Reference {
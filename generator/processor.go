@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"go/ast"
 	"go/build"
+	"go/constant"
 	"go/parser"
 	"go/token"
 	"go/types"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 
 	parseutil "gopkg.in/src-d/go-parse-utils.v1"
@@ -201,7 +203,10 @@ func (p *Processor) processModel(name string, s *types.Struct, t *types.Named) (
 		return nil, nil
 	}
 
-	p.processBaseField(m, fields[base])
+	if err := p.processBaseField(m, fields[base]); err != nil {
+		return nil, err
+	}
+
 	if err := m.SetFields(fields); err != nil {
 		return nil, err
 	}
@@ -319,6 +324,9 @@ func (p *Processor) processField(field *Field, typ types.Type, done []*types.Str
 
 		p.processField(field, typ.Underlying(), done, root)
 		field.IsAlias = !field.IsJSON
+		if field.Kind == Basic && typ.Obj().Pkg() == p.Package {
+			field.enum = findEnum(typ)
+		}
 	case *types.Array:
 		var underlying Field
 		p.processField(&underlying, typ.Elem(), done, root)
@@ -372,6 +380,51 @@ func (p *Processor) processField(field *Field, typ types.Type, done []*types.Str
 	}
 }
 
+// findEnum returns the enum whose values are the constants of the given
+// named string or integer type declared in its package, if there are any.
+func findEnum(typ *types.Named) *Enum {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsString|types.IsInteger) == 0 {
+		return nil
+	}
+
+	var consts []*types.Const
+	scope := typ.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*types.Const); ok && types.Identical(c.Type(), typ) {
+			consts = append(consts, c)
+		}
+	}
+
+	if len(consts) == 0 {
+		return nil
+	}
+
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+
+	var enum = &Enum{
+		Name:     typ.Obj().Name(),
+		IsString: basic.Info()&types.IsString != 0,
+	}
+	var seen = make(map[string]bool)
+	for _, c := range consts {
+		var v string
+		if enum.IsString {
+			v = constant.StringVal(c.Val())
+		} else {
+			v = c.Val().ExactString()
+		}
+
+		if !seen[v] {
+			seen[v] = true
+			enum.Values = append(enum.Values, v)
+		}
+	}
+	return enum
+}
+
 func isSQLType(pkg *types.Package, typ types.Type) bool {
 	scan := getMethodSignature(pkg, typ, "Scan")
 	if !signatureMatches(scan, typeCheckers{isEmptyInterface}, typeCheckers{isBuiltinError}) {
@@ -458,11 +511,19 @@ func isModel(typ types.Type, dive bool) bool {
 	return false
 }
 
-func (p *Processor) processBaseField(m *Model, f *Field) {
+func (p *Processor) processBaseField(m *Model, f *Field) error {
 	m.Table = f.Tag.Get("table")
 	if m.Table == "" {
 		m.Table = toLowerSnakeCase(m.Name)
 	}
+	m.OldTable = f.Tag.Get("oldname")
+
+	var err error
+	m.Indexes, err = parseIndexes(f.Tag.Get("index"))
+	if err != nil {
+		return fmt.Errorf("%s. On model %s", err, m.Name)
+	}
+	return nil
 }
 
 func joinDirectory(directory string, files []string) []string {
@@ -314,6 +314,83 @@ func (s *ProcessorSuite) TestIsSQLType() {
 	s.False(isSQLType(p.Package, types.NewPointer(m.Fields[1].Node.Type())))
 }
 
+func (s *ProcessorSuite) TestEnum() {
+	fixtureSrc := `
+	package fixture
+
+	import (
+		"time"
+
+		"gopkg.in/src-d/go-kallax.v1"
+	)
+
+	type Status string
+
+	const (
+		Inactive Status = "inactive"
+		Active   Status = "active"
+	)
+
+	type Level uint8
+
+	const (
+		Low Level = iota + 1
+		High
+		Highest = High
+	)
+
+	type Empty string
+
+	type Foo struct {
+		kallax.Model
+		ID int64 ` + "`pk:\"autoincr\"`" + `
+		Status Status
+		Level *Level
+		Empty Empty
+		Disabled Status ` + "`enum:\"-\"`" + `
+		Tag string ` + "`enum:\"a,b\"`" + `
+		Month time.Month
+	}
+	`
+
+	m := findModel(s.processFixture(fixtureSrc), "Foo")
+	cases := []struct {
+		field    string
+		expected *Enum
+	}{
+		{"ID", nil},
+		{"Status", &Enum{"Status", []string{"inactive", "active"}, true}},
+		{"Level", &Enum{"Level", []string{"1", "2"}, false}},
+		{"Empty", nil},
+		{"Disabled", nil},
+		{"Tag", &Enum{"", []string{"a", "b"}, true}},
+		{"Month", nil},
+	}
+
+	for _, c := range cases {
+		enum, err := findField(m, c.field).Enum()
+		s.NoError(err, c.field)
+		s.Equal(c.expected, enum, c.field)
+	}
+}
+
+func (s *ProcessorSuite) TestEnum_InvalidTag() {
+	fixtureSrc := `
+	package fixture
+
+	import "gopkg.in/src-d/go-kallax.v1"
+
+	type Foo struct {
+		kallax.Model
+		ID int64 ` + "`pk:\"autoincr\"`" + `
+		Flag bool ` + "`enum:\"a,b\"`" + `
+	}
+	`
+
+	_, err := processFixture(fixtureSrc)
+	s.Error(err)
+}
+
 func (s *ProcessorSuite) processorFixture(source string) *Processor {
 	prc, err := processorFixture(source)
 	s.Require().NoError(err)
@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// MigrationReport is the report of the changes of a migration, meant to be
+// encoded as JSON and reviewed by tools.
+type MigrationReport struct {
+	// Changes are the changes of the migration.
+	Changes []*ChangeInfo `json:"changes"`
+	// LikelyRenames are the columns that look renamed, but will be dropped
+	// and added again.
+	LikelyRenames []*RenameInfo `json:"likely_renames"`
+	// Violations are the policies that the changes do not comply with.
+	Violations []string `json:"violations"`
+}
+
+// ChangeInfo is the description of a change of a migration.
+type ChangeInfo struct {
+	// Kind is the kind of change, which is the name of its type (e.g.
+	// `AddColumn`).
+	Kind string `json:"kind"`
+	// Table is the table changed, if any.
+	Table string `json:"table,omitempty"`
+	// Column is the column changed, if any.
+	Column string `json:"column,omitempty"`
+	// Description is the human-readable description of the change.
+	Description string `json:"description"`
+	// Destructive reports whether the change drops data.
+	Destructive bool `json:"destructive"`
+	// Manual reports whether the change needs to be made by hand.
+	Manual bool `json:"manual"`
+	// RewritesTable reports whether the change rewrites the whole table,
+	// which is locked against reads and writes until it is done.
+	RewritesTable bool `json:"rewrites_table"`
+}
+
+// RenameInfo is the description of a column that looks renamed.
+type RenameInfo struct {
+	Table string `json:"table"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// NewChangeInfo returns the description of the given change.
+func NewChangeInfo(c Change) *ChangeInfo {
+	info := &ChangeInfo{
+		Kind:          reflect.Indirect(reflect.ValueOf(c)).Type().Name(),
+		Description:   c.String(),
+		RewritesTable: rewritesTable(c),
+	}
+
+	switch c := c.(type) {
+	case *CreateTable:
+		info.Table = c.Name
+	case *DropTable:
+		info.Table = c.Name
+		info.Destructive = true
+	case *RenameTable:
+		info.Table = c.Name
+	case *AddColumn:
+		info.Table, info.Column = c.Table, c.Column.Name
+	case *DropColumn:
+		info.Table, info.Column = c.Table, c.Name
+		info.Destructive = true
+	case *RenameColumn:
+		info.Table, info.Column = c.Table, c.Name
+	case *CreateIndex:
+		info.Table = c.Table
+	case *DropIndex:
+		info.Table = c.Table
+	case *AlterColumnType:
+		info.Table, info.Column = c.Table, c.Name
+	case *SetNotNull:
+		info.Table, info.Column = c.Table, c.Name
+	case *DropNotNull:
+		info.Table, info.Column = c.Table, c.Name
+	case *AddForeignKey:
+		info.Table, info.Column = c.Table, c.Name
+	case *DropForeignKey:
+		info.Table, info.Column = c.Table, c.Name
+	case *AddUnique:
+		info.Table, info.Column = c.Table, c.Name
+	case *DropUnique:
+		info.Table, info.Column = c.Table, c.Name
+	case *AddPrimaryKey:
+		info.Table = c.Table
+	case *DropPrimaryKey:
+		info.Table = c.Table
+	case *AlterColumnDefault:
+		info.Table, info.Column = c.Table, c.Name
+	case *AddCheck:
+		info.Table, info.Column = c.Table, c.Name
+	case *DropCheck:
+		info.Table, info.Column = c.Table, c.Name
+	case *ManualChange:
+		info.Manual = true
+	}
+
+	return info
+}
+
+// migrationPolicies are the policies migrations can be checked against,
+// which report whether a change does not comply with them.
+var migrationPolicies = map[string]func(*ChangeInfo) bool{
+	"any":         func(*ChangeInfo) bool { return true },
+	"destructive": func(c *ChangeInfo) bool { return c.Destructive },
+	"manual":      func(c *ChangeInfo) bool { return c.Manual },
+	"rewrite":     func(c *ChangeInfo) bool { return c.RewritesTable },
+}
+
+// Report returns the report of the given migration, built by this generator,
+// with the given policies it does not comply with. The available policies
+// are `any`, which is violated by any change, `destructive`, violated by the
+// changes that drop data, `manual`, violated by the changes that need to be
+// made by hand, and `rewrite`, violated by the changes that rewrite a whole
+// table.
+func (g *MigrationGenerator) Report(migration *Migration, policies ...string) (*MigrationReport, error) {
+	report := &MigrationReport{
+		Changes:       []*ChangeInfo{},
+		LikelyRenames: []*RenameInfo{},
+		Violations:    []string{},
+	}
+
+	for _, c := range migration.Up {
+		report.Changes = append(report.Changes, NewChangeInfo(c))
+	}
+
+	for _, r := range g.likelyRenames {
+		report.LikelyRenames = append(report.LikelyRenames, &RenameInfo{r.Table, r.Old.Name, r.New.Name})
+	}
+
+	for _, p := range policies {
+		violates, ok := migrationPolicies[p]
+		if !ok {
+			return nil, fmt.Errorf("kallax: unknown migration policy %q", p)
+		}
+
+		for _, c := range report.Changes {
+			if violates(c) {
+				report.Violations = append(report.Violations, p)
+				break
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// ErrPolicyViolation is returned when a migration does not comply with the
+// policies it is checked against.
+var ErrPolicyViolation = errors.New("kallax: the migration does not comply with the policies")
+
+// Review reports the changes of the given migration, without writing it, as
+// text or, if the format is `json`, as its report encoded as JSON. If it
+// does not comply with the given policies, ErrPolicyViolation is returned.
+func (g *MigrationGenerator) Review(migration *Migration, format string, policies ...string) error {
+	report, err := g.Report(migration, policies...)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return fmt.Errorf("kallax: unable to encode the migration report: %s", err)
+		}
+	case "text":
+		g.printMigrationInfo(migration)
+		if len(report.Violations) > 0 {
+			color.New(color.FgRed, color.Bold).Printf("\nERROR: ")
+			fmt.Printf("the migration does not comply with the policies: %s.\n", strings.Join(report.Violations, ", "))
+		}
+	default:
+		return fmt.Errorf("kallax: unknown format %q, it must be text or json", format)
+	}
+
+	if len(report.Violations) > 0 {
+		return ErrPolicyViolation
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChangeInfo(t *testing.T) {
+	cases := []struct {
+		change   Change
+		expected ChangeInfo
+	}{
+		{
+			&CreateTable{mkTable("users")},
+			ChangeInfo{Kind: "CreateTable", Table: "users"},
+		},
+		{
+			&DropTable{Name: "users"},
+			ChangeInfo{Kind: "DropTable", Table: "users", Destructive: true},
+		},
+		{
+			&AddColumn{Table: "users", Column: mkCol("name", TextColumn, false, true, nil)},
+			ChangeInfo{Kind: "AddColumn", Table: "users", Column: "name"},
+		},
+		{
+			&DropColumn{Table: "users", Name: "name"},
+			ChangeInfo{Kind: "DropColumn", Table: "users", Column: "name", Destructive: true},
+		},
+		{
+			&AlterColumnType{Table: "users", Name: "age", Type: BigIntColumn, OldType: TextColumn},
+			ChangeInfo{Kind: "AlterColumnType", Table: "users", Column: "age", RewritesTable: true},
+		},
+		{
+			&ManualChange{Msg: "foo"},
+			ChangeInfo{Kind: "ManualChange", Manual: true},
+		},
+	}
+
+	for _, c := range cases {
+		c.expected.Description = c.change.String()
+		require.Equal(t, &c.expected, NewChangeInfo(c.change), c.expected.Kind)
+	}
+}
+
+func TestMigrationGeneratorReport(t *testing.T) {
+	require := require.New(t)
+	g := NewMigrationGenerator("migration", "")
+	g.likelyRenames = []*LikelyRename{{
+		Table: "users",
+		Old:   mkCol("name", TextColumn, false, true, nil),
+		New:   mkCol("full_name", TextColumn, false, true, nil),
+	}}
+
+	migration := &Migration{Up: ChangeSet{
+		&DropColumn{Table: "users", Name: "name"},
+		&AddColumn{Table: "users", Column: mkCol("full_name", TextColumn, false, true, nil)},
+	}}
+
+	report, err := g.Report(migration, "manual", "destructive", "any")
+	require.NoError(err)
+	require.Len(report.Changes, 2)
+	require.Equal([]*RenameInfo{{"users", "name", "full_name"}}, report.LikelyRenames)
+	require.Equal([]string{"destructive", "any"}, report.Violations)
+
+	report, err = g.Report(&Migration{}, "any")
+	require.NoError(err)
+	require.Len(report.Changes, 0)
+	require.Len(report.Violations, 0)
+
+	_, err = g.Report(migration, "foo")
+	require.Error(err)
+
+	require.Equal(ErrPolicyViolation, g.Review(migration, "json", "destructive"))
+	require.NoError(g.Review(migration, "json", "manual"))
+	require.Error(g.Review(migration, "xml"))
+}
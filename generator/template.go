@@ -109,7 +109,9 @@ func (td *TemplateData) genFieldsColumnAddresses(buf *bytes.Buffer, fields []*Fi
 					buf.WriteString(fmt.Sprintf(initNilPtrTpl, f.Name, f.Name, td.GenTypeName(f)))
 				}
 
-				if f.Kind == Basic && f.IsAlias {
+				if enum, _ := f.Enum(); enum != nil {
+					buf.WriteString(fmt.Sprintf("return types.Enum(&%s, %s), nil\n", f.fieldVarName(), enumArgs(enum)))
+				} else if f.Kind == Basic && f.IsAlias {
 					buf.WriteString(fmt.Sprintf("return (*%s)(%s), nil\n", f.Type, f.Address()))
 				} else {
 					buf.WriteString(fmt.Sprintf("return %s, nil\n", f.Address()))
@@ -191,6 +193,24 @@ func (td *TemplateData) genFieldsColumns(buf *bytes.Buffer, fields []*Field) {
 	}
 }
 
+// GenNotNullColumns generates the list of columns in the given model that
+// can not be null.
+func (td *TemplateData) GenNotNullColumns(model *Model) string {
+	var buf bytes.Buffer
+	td.genFieldsNotNullColumns(&buf, model.Fields)
+	return buf.String()
+}
+
+func (td *TemplateData) genFieldsNotNullColumns(buf *bytes.Buffer, fields []*Field) {
+	for _, f := range fields {
+		if f.Inline() {
+			td.genFieldsNotNullColumns(buf, f.Fields)
+		} else if f.Kind != Relationship && !f.IsPtr {
+			buf.WriteString(fmt.Sprintf("kallax.NewSchemaField(\"%s\"),\n", f.ColumnName()))
+		}
+	}
+}
+
 // GenModelSchema generates generates the fields of the struct definition
 // in the given model.
 func (td *TemplateData) GenModelSchema(model *Model) string {
@@ -429,6 +449,8 @@ func (td *TemplateData) genFieldsInit(buf *bytes.Buffer, parent string, fields [
 				buf.WriteString(fmt.Sprintf(`BaseSchemaField: kallax.NewSchemaField("%s").(*kallax.BaseSchemaField),`+"\n", schemaName))
 				td.genSubschemaFieldsInit(buf, parent+f.Name, f.Fields, "")
 				buf.WriteString("},")
+			} else if f.IsTSVector() {
+				buf.WriteString(fmt.Sprintf(`kallax.NewTSVectorSchemaField("%s"),`, schemaName))
 			} else {
 				buf.WriteString(fmt.Sprintf(`kallax.NewSchemaField("%s"),`, schemaName))
 			}
@@ -648,7 +670,7 @@ func findableTypeName(typ types.Type, pkg *types.Package) (string, bool) {
 }
 
 func isOneToOneRelationship(f *Field) bool {
-	return f.Kind == Relationship && !f.IsOneToManyRelationship()
+	return f.Kind == Relationship && !f.IsOneToManyRelationship() && !f.IsManyToManyRelationship()
 }
 
 // lookupValid returns the first valid type looking into the underlying types of
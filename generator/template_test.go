@@ -187,6 +187,56 @@ func (s *TemplateSuite) TestGenColumnValues() {
 	s.Equal(expectedValues, result)
 }
 
+const expectedEnumAddresses = `case "id":
+return (*kallax.NumericID)(&r.ID), nil
+case "status":
+return types.Enum(&r.Status, "active", "inactive"), nil
+case "prev":
+return types.Enum(&r.Prev, "active", "inactive"), nil
+case "size":
+return types.Enum(&r.Size, 1, 2), nil
+`
+
+const expectedEnumValues = `case "id":
+return r.ID, nil
+case "status":
+return types.Enum(&r.Status, "active", "inactive").Value()
+case "prev":
+if r.Prev == (*Status)(nil) {
+	return nil, nil
+}
+return types.Enum(&r.Prev, "active", "inactive").Value()
+case "size":
+return types.Enum(&r.Size, 1, 2).Value()
+`
+
+func (s *TemplateSuite) TestGenColumnEnums() {
+	s.processSource(`
+	package fixture
+
+	import "gopkg.in/src-d/go-kallax.v1"
+
+	type Status string
+
+	const (
+		Active Status = "active"
+		Inactive Status = "inactive"
+	)
+
+	type Foo struct {
+		kallax.Model
+		ID int64 ` + "`pk:\"autoincr\"`" + `
+		Status Status
+		Prev *Status
+		Size int ` + "`enum:\"1, 2\"`" + `
+	}
+	`)
+
+	m := findModel(s.td.Package, "Foo")
+	s.Equal(expectedEnumAddresses, s.td.GenColumnAddresses(m))
+	s.Equal(expectedEnumValues, s.td.GenColumnValues(m))
+}
+
 const expectedColumns = `kallax.NewSchemaField("id"),
 kallax.NewSchemaField("foo"),
 kallax.NewSchemaField("bar"),
@@ -208,6 +258,23 @@ func (s *TemplateSuite) TestGenModelColumns() {
 	s.Equal(expectedColumns, result)
 }
 
+const expectedNotNullColumns = `kallax.NewSchemaField("id"),
+kallax.NewSchemaField("foo"),
+kallax.NewSchemaField("baz"),
+kallax.NewSchemaField("arr"),
+kallax.NewSchemaField("arr_aliased"),
+kallax.NewSchemaField("urlarr"),
+kallax.NewSchemaField("json"),
+kallax.NewSchemaField("url_no_ptr"),
+kallax.NewSchemaField("basic_alias"),
+`
+
+func (s *TemplateSuite) TestGenNotNullColumns() {
+	s.processSource(baseTpl)
+	m := findModel(s.td.Package, "Foo")
+	s.Equal(expectedNotNullColumns, s.td.GenNotNullColumns(m))
+}
+
 const jsonBaseTpl = `
 	package fixture
 
@@ -157,7 +157,7 @@ func (p *Package) FindModel(name string) *Model {
 func (p *Package) addMissingRelationships() error {
 	for _, m := range p.Models {
 		for _, f := range m.Fields {
-			if f.Kind == Relationship && !f.IsInverse() {
+			if f.Kind == Relationship && !f.IsInverse() && !f.IsManyToManyRelationship() {
 				if err := p.trySetFK(f.TypeSchemaName(), f); err != nil {
 					return err
 				}
@@ -232,6 +232,10 @@ type Model struct {
 	// If one is not provided, it will be the model name transformed to lower
 	// snake case. A model with an empty table name is not valid.
 	Table string
+	// OldTable is the previous name of the table, extracted from the `oldname`
+	// struct tag of the kallax.Model field in the model. It is used to rename
+	// the table in migrations instead of dropping it and creating a new one.
+	OldTable string
 	// Type is the string representation of the type.
 	Type string
 	// Fields contains the list of fields in the model.
@@ -250,6 +254,89 @@ type Model struct {
 	CtorFunc *types.Func
 	// Package is a reference to the package where the model was defined.
 	Package *types.Package
+	// Indexes are the indexes declared in the `index` struct tag of the
+	// kallax.Model field in the model, separated by semicolons.
+	Indexes []*Index
+}
+
+// Index is an index declared with the `index` struct tag, either in a field,
+// to index its column, or in the kallax.Model field of a model.
+type Index struct {
+	// Method is the index method: btree, hash or gin.
+	Method string
+	// Columns are the indexed columns. Indexes declared in a field have no
+	// columns, because the column of the field is indexed.
+	Columns []string
+	// Where is the predicate of a partial index, if any.
+	Where string
+}
+
+var indexMethods = []string{BTreeIndex, HashIndex, GINIndex}
+
+// parseIndex parses an index declaration, which consists of an index method,
+// followed by the indexed columns between parentheses if columns is true and,
+// optionally, by a WHERE clause with the predicate of a partial index.
+//   btree(author_id, created_at) WHERE deleted_at IS NULL
+func parseIndex(decl string, columns bool) (*Index, error) {
+	var idx Index
+	decl = strings.TrimSpace(decl)
+	if i := strings.Index(strings.ToUpper(decl), " WHERE "); i >= 0 {
+		idx.Where = strings.TrimSpace(decl[i+len(" WHERE "):])
+		decl = strings.TrimSpace(decl[:i])
+	}
+
+	if columns {
+		open := strings.Index(decl, "(")
+		if open < 0 || !strings.HasSuffix(decl, ")") {
+			return nil, fmt.Errorf("kallax: the columns of index %q must be given between parentheses", decl)
+		}
+
+		for _, col := range strings.Split(decl[open+1:len(decl)-1], ",") {
+			col = strings.TrimSpace(col)
+			if col == "" {
+				return nil, fmt.Errorf("kallax: index %q has an empty column", decl)
+			}
+			idx.Columns = append(idx.Columns, col)
+		}
+		decl = strings.TrimSpace(decl[:open])
+	}
+
+	idx.Method = strings.ToLower(decl)
+	var valid bool
+	for _, m := range indexMethods {
+		if m == idx.Method {
+			valid = true
+		}
+	}
+
+	if !valid {
+		return nil, fmt.Errorf("kallax: unknown index method %q, it must be one of: %s", decl, strings.Join(indexMethods, ", "))
+	}
+
+	if idx.Method == HashIndex && len(idx.Columns) > 1 {
+		return nil, fmt.Errorf("kallax: hash indexes can only have one column")
+	}
+
+	return &idx, nil
+}
+
+// parseIndexes parses the declarations of indexes in the given struct tag
+// of the kallax.Model field, separated by semicolons.
+//   btree(author_id, created_at); gin(tags) WHERE published
+func parseIndexes(tag string) ([]*Index, error) {
+	var result []*Index
+	for _, decl := range strings.Split(tag, ";") {
+		if strings.TrimSpace(decl) == "" {
+			continue
+		}
+
+		idx, err := parseIndex(decl, true)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, idx)
+	}
+	return result, nil
 }
 
 // NewModel creates a new model with the given name.
@@ -351,6 +438,44 @@ func (m *Model) Validate() error {
 		return fmt.Errorf("kallax: model %s has no table", m.Name)
 	}
 
+	if versions := versionFields(m.Fields); len(versions) > 1 {
+		return fmt.Errorf("kallax: model %s has more than one version field: %s and %s", m.Name, versions[0].Name, versions[1].Name)
+	} else if len(versions) == 1 {
+		f := versions[0]
+		if f.IsPrimaryKey() || f.IsPtr || f.Kind != Basic || !isIntegerType(f.Type) {
+			return fmt.Errorf("kallax: version field %s of model %s must be a non-pointer integer that is not the primary key", f.Name, m.Name)
+		}
+	}
+
+	if deletes := softDeleteFields(m.Fields); len(deletes) > 1 {
+		return fmt.Errorf("kallax: model %s has more than one soft delete field: %s and %s", m.Name, deletes[0].Name, deletes[1].Name)
+	} else if len(deletes) == 1 {
+		f := deletes[0]
+		if !f.IsPtr || f.Type != "time.Time" {
+			return fmt.Errorf("kallax: soft delete field %s of model %s must be a *time.Time", f.Name, m.Name)
+		}
+	}
+
+	for _, f := range flattenFields(m.Fields) {
+		if _, err := f.Enum(); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range m.Relationships() {
+		if f.ThroughTable() == "" {
+			continue
+		}
+
+		if !f.IsManyToManyRelationship() || f.IsInverse() {
+			return fmt.Errorf("kallax: field %s of model %s has a join table, but only non-inverse slices of models can be many to many relationships", f.Name, m.Name)
+		}
+
+		if f.ForeignKey() == f.ThroughForeignKey() {
+			return fmt.Errorf("kallax: many to many relationship %s of model %s uses the same column %s for both sides of the join table %s", f.Name, m.Name, f.ForeignKey(), f.ThroughTable())
+		}
+	}
+
 	return nil
 }
 
@@ -554,6 +679,57 @@ func (m *Model) SetFields(fields []*Field) error {
 	return nil
 }
 
+// VersionField returns the field used as the version of the model for
+// optimistic locking, or nil if the model is not versioned.
+func (m *Model) VersionField() *Field {
+	if versions := versionFields(m.Fields); len(versions) > 0 {
+		return versions[0]
+	}
+	return nil
+}
+
+func versionFields(fields []*Field) []*Field {
+	var result []*Field
+	for _, f := range fields {
+		if f.Inline() {
+			result = append(result, versionFields(f.Fields)...)
+		} else if f.IsVersion() {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// SoftDeleteField returns the field used as the deletion time of the model
+// for soft deletes, or nil if the model has no soft deletes.
+func (m *Model) SoftDeleteField() *Field {
+	if deletes := softDeleteFields(m.Fields); len(deletes) > 0 {
+		return deletes[0]
+	}
+	return nil
+}
+
+func softDeleteFields(fields []*Field) []*Field {
+	var result []*Field
+	for _, f := range fields {
+		if f.Inline() {
+			result = append(result, softDeleteFields(f.Fields)...)
+		} else if f.IsSoftDelete() {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+func isIntegerType(typ string) bool {
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
 // Relationships returns the fields of a model that are relationships.
 func (m *Model) Relationships() []*Field {
 	return relationshipsOnFields(m.Fields)
@@ -571,10 +747,12 @@ func (m *Model) Inverses() []*Field {
 }
 
 // NonInverses returns the relationships of the model that are not inverses.
+// Many to many relationships are not included, as their records are not saved
+// along with the model.
 func (m *Model) NonInverses() []*Field {
 	var rels []*Field
 	for _, f := range relationshipsOnFields(m.Fields) {
-		if !f.IsInverse() {
+		if !f.IsInverse() && !f.IsManyToManyRelationship() {
 			rels = append(rels, f)
 		}
 	}
@@ -648,8 +826,24 @@ type Field struct {
 	primaryKey      string
 	isPrimaryKey    bool
 	isUnique        bool
+	isVersion       bool
+	isSoftDelete    bool
 	isAutoincrement bool
 	columnName      string
+	enum            *Enum
+}
+
+// Enum is the set of values a field can take, which are validated whenever
+// the field is stored or retrieved.
+type Enum struct {
+	// Name is the name of the Go type whose constants are the values of the
+	// enum, or empty if the values are declared with the `enum` struct tag.
+	Name string
+	// Values are the values of the enum, in the order they were declared.
+	Values []string
+	// IsString reports whether the values are strings. If not, they are
+	// integers.
+	IsString bool
 }
 
 // FieldKind is the kind of a field.
@@ -716,6 +910,8 @@ func NewField(n, t string, tag reflect.StructTag) *Field {
 		columnName:      columnName(n, tag),
 		isPrimaryKey:    isPrimaryKey,
 		isUnique:        isUnique(tag),
+		isVersion:       isVersion(tag),
+		isSoftDelete:    isSoftDelete(tag),
 		isAutoincrement: autoincr,
 	}
 }
@@ -724,6 +920,14 @@ func isUnique(tag reflect.StructTag) bool {
 	return tag.Get("unique") == "true"
 }
 
+func isVersion(tag reflect.StructTag) bool {
+	return tag.Get("version") == "true"
+}
+
+func isSoftDelete(tag reflect.StructTag) bool {
+	return tag.Get("softdelete") == "true"
+}
+
 // pkProperties returns the primary key properties from a struct tag.
 // Valid primary key definitions are the following:
 // - pk:"" -> non-autoincr primary key without a field name.
@@ -812,6 +1016,18 @@ func (f *Field) IsUnique() bool {
 	return f.isUnique
 }
 
+// IsVersion reports whether the field is the version of the model used for
+// optimistic locking.
+func (f *Field) IsVersion() bool {
+	return f.isVersion
+}
+
+// IsSoftDelete reports whether the field is the deletion time of the model
+// used for soft deletes.
+func (f *Field) IsSoftDelete() bool {
+	return f.isSoftDelete
+}
+
 // IsAutoIncrement reports whether the field is an autoincrementable primary key.
 func (f *Field) IsAutoIncrement() bool {
 	return f.isAutoincrement
@@ -835,7 +1051,41 @@ func (f *Field) IsInverse() bool {
 // IsOneToManyRelationship returns whether the field is a one to many
 // relationship.
 func (f *Field) IsOneToManyRelationship() bool {
-	return f.Kind == Relationship && strings.HasPrefix(f.Type, "[]")
+	return f.Kind == Relationship && strings.HasPrefix(f.Type, "[]") && f.ThroughTable() == ""
+}
+
+// IsManyToManyRelationship returns whether the field is a many to many
+// relationship, that is, a slice of models with a join table set in the struct
+// tag `through`.
+func (f *Field) IsManyToManyRelationship() bool {
+	return f.Kind == Relationship && strings.HasPrefix(f.Type, "[]") && f.ThroughTable() != ""
+}
+
+// ThroughTable returns the name of the join table of a many to many
+// relationship as specified in the struct tag `through`.
+func (f *Field) ThroughTable() string {
+	if f.Kind != Relationship {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.Split(f.Tag.Get("through"), ",")[0])
+}
+
+// ThroughForeignKey returns the name of the column of the join table that
+// references the related model of a many to many relationship. It can be
+// specified after the join table in the struct tag `through`, otherwise it is
+// the name of the related model type in lower snake case with "_id" appended.
+func (f *Field) ThroughForeignKey() string {
+	if f.ThroughTable() == "" {
+		return ""
+	}
+
+	parts := strings.Split(f.Tag.Get("through"), ",")
+	if len(parts) > 1 && strings.TrimSpace(parts[1]) != "" {
+		return strings.TrimSpace(parts[1])
+	}
+
+	return foreignKeyForModel(f.TypeSchemaName())
 }
 
 func foreignKeyForModel(model string) string {
@@ -949,6 +1199,10 @@ func (f *Field) Value() string {
 
 	switch f.Kind {
 	case Basic:
+		if enum, _ := f.Enum(); enum != nil {
+			return fmt.Sprintf("types.Enum(&%s, %s).Value()", name, enumArgs(enum))
+		}
+
 		if mapped, ok := mappings[f.Type]; ok {
 			name = fmt.Sprintf("(*%s)(%s)", mapped, f.fieldVarAddress())
 		}
@@ -980,6 +1234,101 @@ func (f *Field) SQLType() string {
 	return f.Tag.Get("sqltype")
 }
 
+// SQLDefault returns the SQL expression of the default value of the column
+// of the field, declared with the struct tag `default`, if any.
+func (f *Field) SQLDefault() string {
+	return f.Tag.Get("default")
+}
+
+// SQLCheck returns the SQL expression of the check constraint of the column
+// of the field, declared with the struct tag `check`, if any.
+func (f *Field) SQLCheck() string {
+	return f.Tag.Get("check")
+}
+
+// OldColumnName returns the previous name of the column of the field,
+// declared with the struct tag `oldname`, if any.
+func (f *Field) OldColumnName() string {
+	return f.Tag.Get("oldname")
+}
+
+// Index returns the index of the column of the field declared with the
+// struct tag `index`, if any.
+func (f *Field) Index() (*Index, error) {
+	decl := f.Tag.Get("index")
+	if decl == "" {
+		return nil, nil
+	}
+
+	idx, err := parseIndex(decl, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s. On field %s of model %s", err, f.Name, f.Model.Name)
+	}
+	return idx, nil
+}
+
+// Enum returns the enum of the field, if any. The values of the enum are
+// either declared with the struct tag `enum`, separated by commas, or they are
+// the constants of the named string or integer type of the field declared in
+// the package of the model. This detection can be disabled setting the struct
+// tag `enum` to "-". Primary keys are never enums.
+func (f *Field) Enum() (*Enum, error) {
+	tag, ok := f.Tag.Lookup("enum")
+	if tag == "-" || f.IsPrimaryKey() {
+		return nil, nil
+	}
+
+	if !ok {
+		return f.enum, nil
+	}
+
+	if f.Kind != Basic || f.IsJSON || (f.Type != "string" && !isIntegerType(f.Type)) {
+		return nil, fmt.Errorf("kallax: field %s of model %s has the struct tag `enum`, but only string and integer fields can be enums", f.Name, f.Model.Name)
+	}
+
+	var enum = &Enum{IsString: f.Type == "string"}
+	for _, v := range strings.Split(tag, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return nil, fmt.Errorf("kallax: the enum of field %s of model %s has an empty value", f.Name, f.Model.Name)
+		}
+
+		if _, err := strconv.ParseInt(v, 10, 64); !enum.IsString && err != nil {
+			return nil, fmt.Errorf("kallax: the enum of field %s of model %s has the value %q, which is not an integer", f.Name, f.Model.Name, v)
+		}
+		enum.Values = append(enum.Values, v)
+	}
+	return enum, nil
+}
+
+// enumArgs returns the code of the arguments of types.Enum with the values
+// of the given enum.
+func enumArgs(enum *Enum) string {
+	var args = make([]string, len(enum.Values))
+	for i, v := range enum.Values {
+		if enum.IsString {
+			v = strconv.Quote(v)
+		}
+		args[i] = v
+	}
+	return strings.Join(args, ", ")
+}
+
+// tsvectorType is the type of the fields stored as a tsvector.
+const tsvectorType = "gopkg.in/src-d/go-kallax.v1/types.TSVector"
+
+// IsTSVector reports whether the field is stored in a tsvector column, either
+// because it is a types.TSVector or because its SQL type is tsvector.
+func (f *Field) IsTSVector() bool {
+	if typ := f.SQLType(); typ != "" {
+		return typ == string(TSVectorColumn)
+	}
+
+	return f.Kind == Interface &&
+		f.Node != nil &&
+		removeTypePrefix(typeName(f.Node.Type())) == tsvectorType
+}
+
 var identifierTypes = map[string]string{
 	"gopkg.in/src-d/go-kallax.v1.UUID":      "kallax.UUID",
 	"gopkg.in/src-d/go-kallax.v1.ULID":      "kallax.ULID",
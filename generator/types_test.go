@@ -343,6 +343,62 @@ func (s *ModelSuite) TestModelValidate() {
 	m.ID = id
 	m.Table = ""
 	require.Error(m.Validate(), "should return error")
+
+	m.Table = "foo"
+	rel := withKind(mkField("Bars", "*foo.Bar", `through:"foo_bars"`), Relationship)
+	rel.Model = m
+	m.Fields = []*Field{mkField("ID", "", ""), rel}
+	require.Error(m.Validate(), "should return error")
+
+	rel.Type = "[]*foo.Bar"
+	rel.Tag = `through:"foo_bars,foo_id"`
+	require.Error(m.Validate(), "should return error")
+
+	rel.Tag = `through:"foo_bars"`
+	require.NoError(m.Validate(), "should not return error")
+
+	version := withKind(mkField("Version", "int64", `version:"true"`), Basic)
+	m.Fields = []*Field{mkField("ID", "", ""), version}
+	require.NoError(m.Validate(), "should not return error")
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		version,
+		withKind(mkField("Rev", "int32", `version:"true"`), Basic),
+	}
+	require.Error(m.Validate(), "should return error, more than one version")
+
+	m.Fields = []*Field{mkField("ID", "", ""), withPtr(version)}
+	require.Error(m.Validate(), "should return error, version is a pointer")
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		withKind(mkField("Version", "string", `version:"true"`), Basic),
+	}
+	require.Error(m.Validate(), "should return error, version is not an integer")
+
+	deletedAt := withPtr(mkField("DeletedAt", "time.Time", `softdelete:"true"`))
+	m.Fields = []*Field{mkField("ID", "", ""), deletedAt}
+	require.NoError(m.Validate(), "should not return error")
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		deletedAt,
+		withPtr(mkField("RemovedAt", "time.Time", `softdelete:"true"`)),
+	}
+	require.Error(m.Validate(), "should return error, more than one soft delete")
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		mkField("DeletedAt", "time.Time", `softdelete:"true"`),
+	}
+	require.Error(m.Validate(), "should return error, soft delete is not a pointer")
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		withPtr(mkField("DeletedAt", "string", `softdelete:"true"`)),
+	}
+	require.Error(m.Validate(), "should return error, soft delete is not a time")
 }
 
 func (s *ModelSuite) TestString() {
@@ -378,6 +434,77 @@ func TestFieldForeignKey(t *testing.T) {
 	}
 }
 
+func TestFieldThrough(t *testing.T) {
+	r := require.New(t)
+	m := &Model{Name: "Foo", Table: "foo", Type: "foo.Foo"}
+
+	cases := []struct {
+		tag        string
+		typ        string
+		manyToMany bool
+		table      string
+		throughKey string
+	}{
+		{``, "[]*foo.Bar", false, "", ""},
+		{`through:"foo_bars"`, "[]*foo.Bar", true, "foo_bars", "bar_id"},
+		{`through:"foo_bars,baz_id"`, "[]foo.Bar", true, "foo_bars", "baz_id"},
+		{`through:"foo_bars"`, "*foo.Bar", false, "foo_bars", "bar_id"},
+	}
+
+	for _, c := range cases {
+		f := NewField("", c.typ, reflect.StructTag(c.tag))
+		f.Kind = Relationship
+		f.Model = m
+
+		r.Equal(c.manyToMany, f.IsManyToManyRelationship(), "is many to many: %s", c.tag)
+		r.Equal(c.table, f.ThroughTable(), "through table: %s", c.tag)
+		r.Equal(c.throughKey, f.ThroughForeignKey(), "through foreign key: %s", c.tag)
+		if c.manyToMany {
+			r.False(f.IsOneToManyRelationship(), "is one to many: %s", c.tag)
+		}
+	}
+}
+
+func TestModelVersionField(t *testing.T) {
+	r := require.New(t)
+	m := &Model{Name: "Foo", Table: "foo"}
+
+	m.Fields = []*Field{mkField("ID", "", ""), mkField("Foo", "string", "")}
+	r.Nil(m.VersionField())
+
+	version := mkField("Version", "int64", `version:"true"`)
+	r.True(version.IsVersion())
+
+	m.Fields = []*Field{mkField("ID", "", ""), version}
+	r.Equal(version, m.VersionField())
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		inline(mkField("Versioned", "", "", version)),
+	}
+	r.Equal(version, m.VersionField())
+}
+
+func TestModelSoftDeleteField(t *testing.T) {
+	r := require.New(t)
+	m := &Model{Name: "Foo", Table: "foo"}
+
+	m.Fields = []*Field{mkField("ID", "", ""), mkField("Foo", "string", "")}
+	r.Nil(m.SoftDeleteField())
+
+	deletedAt := withPtr(mkField("DeletedAt", "time.Time", `softdelete:"true"`))
+	r.True(deletedAt.IsSoftDelete())
+
+	m.Fields = []*Field{mkField("ID", "", ""), deletedAt}
+	r.Equal(deletedAt, m.SoftDeleteField())
+
+	m.Fields = []*Field{
+		mkField("ID", "", ""),
+		inline(mkField("SoftDeletes", "", "", deletedAt)),
+	}
+	r.Equal(deletedAt, m.SoftDeleteField())
+}
+
 func TestModelSetFields(t *testing.T) {
 	r := require.New(t)
 	cases := []struct {
@@ -493,3 +620,50 @@ func TestIsUnique(t *testing.T) {
 		})
 	}
 }
+
+func TestParseIndex(t *testing.T) {
+	cases := []struct {
+		decl     string
+		columns  bool
+		expected *Index
+	}{
+		{"gin", false, &Index{Method: GINIndex}},
+		{"BTREE", false, &Index{Method: BTreeIndex}},
+		{"hash WHERE deleted = false", false, &Index{Method: HashIndex, Where: "deleted = false"}},
+		{"btree(a, b)", true, &Index{Method: BTreeIndex, Columns: []string{"a", "b"}}},
+		{" gin(c) where c IS NOT NULL ", true, &Index{Method: GINIndex, Columns: []string{"c"}, Where: "c IS NOT NULL"}},
+	}
+
+	for _, c := range cases {
+		idx, err := parseIndex(c.decl, c.columns)
+		require.NoError(t, err, c.decl)
+		require.Equal(t, c.expected, idx, c.decl)
+	}
+
+	errors := []struct {
+		decl    string
+		columns bool
+	}{
+		{"foo", false},
+		{"btree", true},
+		{"btree(a, )", true},
+		{"hash(a, b)", true},
+	}
+
+	for _, c := range errors {
+		_, err := parseIndex(c.decl, c.columns)
+		require.Error(t, err, c.decl)
+	}
+}
+
+func TestParseIndexes(t *testing.T) {
+	indexes, err := parseIndexes("btree(a, b); gin(c) WHERE c IS NOT NULL;")
+	require.NoError(t, err)
+	require.Equal(t, []*Index{
+		{Method: BTreeIndex, Columns: []string{"a", "b"}},
+		{Method: GINIndex, Columns: []string{"c"}, Where: "c IS NOT NULL"},
+	}, indexes)
+
+	_, err = parseIndexes("btree(a); foo(b)")
+	require.Error(t, err)
+}
@@ -0,0 +1,442 @@
+package kallax
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+var (
+	// ErrDirtyMigration is returned when migrations are run on a database
+	// whose last SQL migration failed halfway. The database needs to be
+	// fixed by hand and its version set to the last migration that was
+	// completely run.
+	ErrDirtyMigration = errors.New("kallax: the database is dirty, its last migration failed and needs to be fixed by hand")
+	// ErrNoDownMigration is returned when a migration that can not be
+	// reverted is run down.
+	ErrNoDownMigration = errors.New("kallax: the migration can not be reverted")
+)
+
+// MigrationFunc is a migration written in Go, or its reversal. It is run in
+// a transaction of the given store, so its changes are not made unless it
+// succeeds. Generated stores can use the store with their
+// `SetGenericStore` method.
+type MigrationFunc func(*Store) error
+
+var (
+	goMigrationsMu sync.Mutex
+	goMigrations   = make(map[uint]*Migration)
+)
+
+// RegisterMigration registers a migration written in Go with the given
+// version and name, which is run by Migrator along with the SQL migrations,
+// ordered by version. It is usually called in the `init` function of the
+// file of the migration. The down function can be nil if the migration can
+// not be reverted.
+// It panics if there is already a migration with the same version.
+func RegisterMigration(version uint, name string, up, down MigrationFunc) {
+	goMigrationsMu.Lock()
+	defer goMigrationsMu.Unlock()
+
+	if up == nil {
+		panic(fmt.Sprintf("kallax: migration %d_%s has no up function", version, name))
+	}
+
+	if _, ok := goMigrations[version]; ok {
+		panic(fmt.Sprintf("kallax: migration with version %d registered twice", version))
+	}
+
+	goMigrations[version] = &Migration{Version: version, Name: name, up: up, down: down}
+}
+
+// Migration is a migration run by Migrator, which is either an SQL migration
+// or a migration written in Go.
+type Migration struct {
+	// Version is the version of the database once the migration is run,
+	// which is the timestamp it was generated at.
+	Version uint
+	// Name is the descriptive name of the migration.
+	Name string
+
+	upFile, downFile string
+	// goFile is the file of the migration if it is written in Go and it is
+	// in the migrations directory.
+	goFile   string
+	up, down MigrationFunc
+}
+
+// IsGo reports whether the migration is written in Go.
+func (m *Migration) IsGo() bool {
+	return m.up != nil || m.goFile != ""
+}
+
+// UpSQL returns the SQL statements run by the migration.
+func (m *Migration) UpSQL() (string, error) {
+	return m.sql(m.upFile)
+}
+
+// DownSQL returns the SQL statements run to revert the migration.
+func (m *Migration) DownSQL() (string, error) {
+	if m.downFile == "" && m.down == nil {
+		return "", ErrNoDownMigration
+	}
+	return m.sql(m.downFile)
+}
+
+func (m *Migration) sql(file string) (string, error) {
+	if m.IsGo() {
+		return "", fmt.Errorf("kallax: migration %d_%s is written in Go", m.Version, m.Name)
+	}
+
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("kallax: unable to read migration %d_%s: %s", m.Version, m.Name, err)
+	}
+	return string(content), nil
+}
+
+// migrationsTable is the table where the version of the database is stored.
+const migrationsTable = "schema_migrations"
+
+var (
+	// sqlMigrationFile matches the names of the files of SQL migrations.
+	sqlMigrationFile = regexp.MustCompile(`^([0-9]+)_(.*)\.(up|down)\.sql$`)
+	// goMigrationFile matches the names of the files of migrations written
+	// in Go.
+	goMigrationFile = regexp.MustCompile(`^([0-9]+)_(.*)\.migration\.go$`)
+)
+
+// Migrator runs the SQL migrations in a directory, generated by
+// `kallax migrate`, and the migrations written in Go registered with
+// RegisterMigration, ordered by version.
+// The version of the database is stored in the `schema_migrations` table the
+// same way `kallax migrate up` and `kallax migrate down` do, so they can be
+// used on the same database, as long as the migrations they skip are not
+// written in Go.
+// SQL migrations are run as they are, while migrations written in Go are run
+// in a transaction that also updates the version of the database.
+type Migrator struct {
+	db         *sql.DB
+	dir        string
+	dialect    Dialect
+	migrations map[uint]*Migration
+}
+
+// NewMigrator returns a new migrator of the given database, which runs the
+// SQL migrations in the given directory and all the migrations written in Go
+// that are registered.
+func NewMigrator(db *sql.DB, dir string) *Migrator {
+	goMigrationsMu.Lock()
+	defer goMigrationsMu.Unlock()
+
+	var migrations = make(map[uint]*Migration, len(goMigrations))
+	for v, m := range goMigrations {
+		migrations[v] = m
+	}
+
+	return &Migrator{db: db, dir: dir, dialect: PostgreSQL, migrations: migrations}
+}
+
+// WithDialect returns a new migrator of a database with the given SQL
+// dialect, which is passed to the stores of the migrations written in Go.
+func (m *Migrator) WithDialect(dialect Dialect) *Migrator {
+	return &Migrator{db: m.db, dir: m.dir, dialect: dialect, migrations: m.migrations}
+}
+
+// Migrations returns all the migrations, ordered by version.
+func (m *Migrator) Migrations() ([]*Migration, error) {
+	files, err := ioutil.ReadDir(m.dir)
+	if err != nil {
+		return nil, fmt.Errorf("kallax: unable to read the migrations directory: %s", err)
+	}
+
+	var sqlMigrations = make(map[uint]*Migration)
+	var goFiles = make(map[uint]*Migration)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		if match := goMigrationFile.FindStringSubmatch(f.Name()); match != nil {
+			v, err := strconv.ParseUint(match[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("kallax: invalid version of migration %s: %s", f.Name(), err)
+			}
+			goFiles[uint(v)] = &Migration{Version: uint(v), Name: match[2], goFile: filepath.Join(m.dir, f.Name())}
+			continue
+		}
+
+		match := sqlMigrationFile.FindStringSubmatch(f.Name())
+		if match == nil {
+			continue
+		}
+
+		v, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("kallax: invalid version of migration %s: %s", f.Name(), err)
+		}
+
+		version := uint(v)
+		if _, ok := m.migrations[version]; ok {
+			return nil, fmt.Errorf("kallax: there is an SQL migration and a Go migration with version %d", version)
+		}
+
+		mig, ok := sqlMigrations[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: match[2]}
+			sqlMigrations[version] = mig
+		}
+
+		file := filepath.Join(m.dir, f.Name())
+		if match[3] == "up" {
+			mig.upFile = file
+		} else {
+			mig.downFile = file
+		}
+	}
+
+	var result []*Migration
+	for _, mig := range sqlMigrations {
+		if mig.upFile == "" {
+			return nil, fmt.Errorf("kallax: migration %d_%s has no up file", mig.Version, mig.Name)
+		}
+		result = append(result, mig)
+	}
+
+	for _, mig := range m.migrations {
+		result = append(result, mig)
+	}
+
+	// the migrations written in Go whose package is not imported are not
+	// registered, but they are listed anyway so they are not skipped
+	for v, mig := range goFiles {
+		if _, ok := sqlMigrations[v]; ok {
+			return nil, fmt.Errorf("kallax: there is an SQL migration and a Go migration with version %d", v)
+		}
+
+		if _, ok := m.migrations[v]; !ok {
+			result = append(result, mig)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
+
+// Version returns the version of the database, which is 0 if no migration
+// has been run, and whether the last migration failed halfway.
+func (m *Migrator) Version() (version uint, dirty bool, err error) {
+	var v int64
+	err = m.db.QueryRow(fmt.Sprintf("SELECT version, dirty FROM %s LIMIT 1", migrationsTable)).
+		Scan(&v, &dirty)
+	if m.dialect.IsUndefinedTable(err) {
+		return 0, false, nil
+	} else if err == sql.ErrNoRows {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("kallax: unable to read the version of the database: %s", err)
+	}
+
+	return uint(v), dirty, nil
+}
+
+// Up runs all the migrations that have not been run yet.
+func (m *Migrator) Up() error {
+	return m.run(func(migrations []*Migration, current int) int {
+		return len(migrations) - 1
+	})
+}
+
+// Down reverts all the migrations that have been run.
+func (m *Migrator) Down() error {
+	return m.run(func(migrations []*Migration, current int) int {
+		return -1
+	})
+}
+
+// Steps runs the given number of migrations up if n is positive, or reverts
+// them if it is negative.
+func (m *Migrator) Steps(n int) error {
+	return m.run(func(migrations []*Migration, current int) int {
+		target := current + n
+		if target < -1 {
+			target = -1
+		} else if target >= len(migrations) {
+			target = len(migrations) - 1
+		}
+		return target
+	})
+}
+
+// Migrate runs or reverts the migrations needed for the database to be at
+// the given version, which must be the version of a migration or 0.
+func (m *Migrator) Migrate(version uint) error {
+	migrations, err := m.Migrations()
+	if err != nil {
+		return err
+	}
+
+	target, err := MigrationIndex(migrations, version)
+	if err != nil {
+		return err
+	}
+
+	return m.run(func([]*Migration, int) int {
+		return target
+	})
+}
+
+// run runs the migrations from the current version of the database up or
+// down to the migration at the index returned by the target func, or -1 if
+// all of them must be reverted.
+func (m *Migrator) run(target func(migrations []*Migration, current int) int) error {
+	migrations, err := m.Migrations()
+	if err != nil {
+		return err
+	}
+
+	if err := m.ensureTable(); err != nil {
+		return err
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return ErrDirtyMigration
+	}
+
+	current, err := MigrationIndex(migrations, version)
+	if err != nil {
+		return err
+	}
+
+	to := target(migrations, current)
+	for i := current + 1; i <= to; i++ {
+		if err := m.runMigration(migrations[i], migrations[i].Version, migrations[i].upFile, migrations[i].up); err != nil {
+			return err
+		}
+	}
+
+	for i := current; i > to; i-- {
+		var version uint
+		if i > 0 {
+			version = migrations[i-1].Version
+		}
+
+		mig := migrations[i]
+		if mig.downFile == "" && mig.down == nil {
+			return ErrNoDownMigration
+		}
+
+		if err := m.runMigration(mig, version, mig.downFile, mig.down); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runMigration runs the given file or function of the migration and sets
+// the version of the database to the given one.
+func (m *Migrator) runMigration(mig *Migration, version uint, file string, fn MigrationFunc) error {
+	if mig.goFile != "" && mig.up == nil {
+		return fmt.Errorf("kallax: migration %d_%s is written in Go, but it is not registered. Is its package imported?", mig.Version, mig.Name)
+	}
+
+	store := NewStore(m.db).WithDialect(m.dialect)
+	if fn != nil {
+		err := store.Transaction(func(store *Store) error {
+			if err := fn(store); err != nil {
+				return err
+			}
+			return setMigrationVersion(store, version, false)
+		})
+		if err != nil {
+			return fmt.Errorf("kallax: unable to run migration %d_%s: %s", mig.Version, mig.Name, err)
+		}
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("kallax: unable to read migration %d_%s: %s", mig.Version, mig.Name, err)
+	}
+
+	if err := setMigrationVersion(store, version, true); err != nil {
+		return err
+	}
+
+	if _, err := m.db.Exec(string(content)); err != nil {
+		return fmt.Errorf("kallax: unable to run migration %d_%s: %s", mig.Version, mig.Name, err)
+	}
+
+	return setMigrationVersion(store, version, false)
+}
+
+func (m *Migrator) ensureTable() error {
+	_, err := m.db.Exec(fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)",
+		migrationsTable,
+	))
+	if err != nil {
+		return fmt.Errorf("kallax: unable to create the migrations table: %s", err)
+	}
+	return nil
+}
+
+// setMigrationVersion sets the version of the database, which has no
+// version if it is 0.
+func setMigrationVersion(store *Store, version uint, dirty bool) error {
+	return store.Transaction(func(store *Store) error {
+		if _, err := store.RawExec(fmt.Sprintf("DELETE FROM %s", migrationsTable)); err != nil {
+			return fmt.Errorf("kallax: unable to set the version of the database: %s", err)
+		}
+
+		if version == 0 {
+			return nil
+		}
+
+		_, err := store.RawExec(
+			fmt.Sprintf(
+				"INSERT INTO %s (version, dirty) VALUES (%s, %s)",
+				migrationsTable, store.dialect.Placeholder(1), store.dialect.Placeholder(2),
+			),
+			int64(version), dirty,
+		)
+		if err != nil {
+			return fmt.Errorf("kallax: unable to set the version of the database: %s", err)
+		}
+		return nil
+	})
+}
+
+// MigrationIndex returns the index of the migration with the given version
+// in the given migrations, as returned by the Migrations method of Migrator,
+// or -1 if the version is 0, which means no migration has been run. If the
+// version is between two migrations, it may have been squashed into the
+// later one, which the returned error tells.
+func MigrationIndex(migrations []*Migration, version uint) (int, error) {
+	if version == 0 {
+		return -1, nil
+	}
+
+	for i, m := range migrations {
+		if m.Version == version {
+			return i, nil
+		} else if m.Version > version {
+			return 0, fmt.Errorf("kallax: there is no migration with version %d. If it was squashed, the migrations removed by squashing them need to be run up to version %d first", version, m.Version)
+		}
+	}
+	return 0, fmt.Errorf("kallax: there is no migration with version %d", version)
+}
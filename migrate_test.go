@@ -0,0 +1,248 @@
+package kallax
+
+import (
+	"database/sql"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func writeMigrationFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "kallax-migrator")
+	require.NoError(t, err)
+
+	for name, content := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		require.NoError(t, err)
+	}
+	return dir
+}
+
+func TestMigratorMigrations(t *testing.T) {
+	require := require.New(t)
+	dir := writeMigrationFiles(t, map[string]string{
+		"1_create.up.sql":         "CREATE TABLE foo;",
+		"1_create.down.sql":       "DROP TABLE foo;",
+		"3_alter.up.sql":          "",
+		"4_backfill.migration.go": "package migrations",
+		"lock.json":               "{}",
+	})
+	defer os.RemoveAll(dir)
+
+	noop := func(*Store) error { return nil }
+	m := NewMigrator(nil, dir)
+	m.migrations = map[uint]*Migration{
+		2: {Version: 2, Name: "backfill", up: noop},
+	}
+
+	migrations, err := m.Migrations()
+	require.NoError(err)
+	require.Len(migrations, 4)
+
+	var versions []uint
+	var names []string
+	for _, mig := range migrations {
+		versions = append(versions, mig.Version)
+		names = append(names, mig.Name)
+	}
+	require.Equal([]uint{1, 2, 3, 4}, versions)
+	require.Equal([]string{"create", "backfill", "alter", "backfill"}, names)
+	require.False(migrations[0].IsGo())
+	require.True(migrations[1].IsGo())
+	require.True(migrations[3].IsGo())
+
+	stmts, err := migrations[0].UpSQL()
+	require.NoError(err)
+	require.Equal("CREATE TABLE foo;", stmts)
+	stmts, err = migrations[0].DownSQL()
+	require.NoError(err)
+	require.Equal("DROP TABLE foo;", stmts)
+
+	_, err = migrations[1].UpSQL()
+	require.Error(err)
+	_, err = migrations[2].DownSQL()
+	require.Equal(ErrNoDownMigration, err)
+
+	m.migrations[1] = &Migration{Version: 1, Name: "conflict", up: noop}
+	_, err = m.Migrations()
+	require.Error(err)
+}
+
+func TestMigrationIndex(t *testing.T) {
+	require := require.New(t)
+	migrations := []*Migration{{Version: 1}, {Version: 3}}
+
+	i, err := MigrationIndex(migrations, 0)
+	require.NoError(err)
+	require.Equal(-1, i)
+
+	i, err = MigrationIndex(migrations, 3)
+	require.NoError(err)
+	require.Equal(1, i)
+
+	_, err = MigrationIndex(migrations, 2)
+	require.Error(err)
+	require.Contains(err.Error(), "squashed")
+
+	_, err = MigrationIndex(migrations, 4)
+	require.Error(err)
+	require.NotContains(err.Error(), "squashed")
+}
+
+func TestRegisterMigration(t *testing.T) {
+	noop := func(*Store) error { return nil }
+	RegisterMigration(42, "noop", noop, nil)
+	defer delete(goMigrations, 42)
+
+	require.Panics(t, func() {
+		RegisterMigration(42, "other", noop, nil)
+	})
+
+	require.Panics(t, func() {
+		RegisterMigration(43, "no_up", nil, nil)
+	})
+
+	mig, ok := NewMigrator(nil, "").migrations[42]
+	require.True(t, ok)
+	require.Equal(t, "noop", mig.Name)
+}
+
+func TestMigrator(t *testing.T) {
+	suite.Run(t, new(MigratorSuite))
+}
+
+type MigratorSuite struct {
+	suite.Suite
+	db  *sql.DB
+	dir string
+}
+
+func (s *MigratorSuite) SetupTest() {
+	var err error
+	s.db, err = openTestDB()
+	s.Require().NoError(err)
+
+	s.dir = writeMigrationFiles(s.T(), map[string]string{
+		"1_create.up.sql":   "CREATE TABLE migrator (id serial PRIMARY KEY, name text NOT NULL);",
+		"1_create.down.sql": "DROP TABLE migrator;",
+		"3_alter.up.sql":    "ALTER TABLE migrator ADD COLUMN age integer;",
+		"3_alter.down.sql":  "ALTER TABLE migrator DROP COLUMN age;",
+	})
+}
+
+func (s *MigratorSuite) TearDownTest() {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS migrator; DROP TABLE IF EXISTS schema_migrations")
+	s.NoError(err)
+	s.NoError(s.db.Close())
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *MigratorSuite) migrator(up, down MigrationFunc) *Migrator {
+	m := NewMigrator(s.db, s.dir)
+	m.migrations = map[uint]*Migration{
+		2: {Version: 2, Name: "backfill", up: up, down: down},
+	}
+	return m
+}
+
+func (s *MigratorSuite) assertVersion(m *Migrator, expected uint) {
+	version, dirty, err := m.Version()
+	s.Require().NoError(err)
+	s.False(dirty)
+	s.Equal(expected, version)
+}
+
+func (s *MigratorSuite) count() int64 {
+	var count int64
+	s.Require().NoError(s.db.QueryRow("SELECT COUNT(*) FROM migrator").Scan(&count))
+	return count
+}
+
+func (s *MigratorSuite) TestUpAndDown() {
+	require := s.Require()
+	m := s.migrator(func(store *Store) error {
+		_, err := store.RawExec("INSERT INTO migrator (name) VALUES ('foo')")
+		return err
+	}, func(store *Store) error {
+		_, err := store.RawExec("DELETE FROM migrator")
+		return err
+	})
+
+	s.assertVersion(m, 0)
+	require.NoError(m.Up())
+	s.assertVersion(m, 3)
+	s.Equal(int64(1), s.count())
+
+	require.NoError(m.Steps(-1))
+	s.assertVersion(m, 2)
+
+	require.NoError(m.Steps(-1))
+	s.assertVersion(m, 1)
+	s.Equal(int64(0), s.count())
+
+	require.NoError(m.Migrate(3))
+	s.assertVersion(m, 3)
+	s.Equal(int64(1), s.count())
+
+	require.NoError(m.Down())
+	s.assertVersion(m, 0)
+
+	var table sql.NullString
+	require.NoError(s.db.QueryRow("SELECT to_regclass('migrator')::text").Scan(&table))
+	s.False(table.Valid)
+}
+
+func (s *MigratorSuite) TestGoMigrationFails() {
+	require := s.Require()
+	m := s.migrator(func(store *Store) error {
+		if _, err := store.RawExec("INSERT INTO migrator (name) VALUES ('foo')"); err != nil {
+			return err
+		}
+		return errors.New("backfill failed")
+	}, nil)
+
+	err := m.Up()
+	require.Error(err)
+	s.Contains(err.Error(), "backfill failed")
+	s.assertVersion(m, 1)
+	s.Equal(int64(0), s.count())
+}
+
+func (s *MigratorSuite) TestNoDownMigration() {
+	require := s.Require()
+	m := s.migrator(func(*Store) error { return nil }, nil)
+
+	require.NoError(m.Migrate(2))
+	s.Equal(ErrNoDownMigration, m.Steps(-1))
+	s.assertVersion(m, 2)
+}
+
+func (s *MigratorSuite) TestUnregisteredGoMigration() {
+	require := s.Require()
+	require.NoError(ioutil.WriteFile(filepath.Join(s.dir, "4_backfill.migration.go"), []byte("package migrations"), 0644))
+	m := s.migrator(func(*Store) error { return nil }, nil)
+
+	err := m.Up()
+	require.Error(err)
+	s.Contains(err.Error(), "not registered")
+	s.assertVersion(m, 3)
+}
+
+func (s *MigratorSuite) TestDirty() {
+	require := s.Require()
+	require.NoError(ioutil.WriteFile(filepath.Join(s.dir, "4_fail.up.sql"), []byte("SELECT * FROM notexists;"), 0644))
+	m := s.migrator(func(*Store) error { return nil }, nil)
+
+	require.Error(m.Up())
+	version, dirty, err := m.Version()
+	require.NoError(err)
+	s.True(dirty)
+	s.Equal(uint(4), version)
+
+	s.Equal(ErrDirtyMigration, m.Up())
+}
@@ -1,7 +1,6 @@
 package kallax
 
 import (
-	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strings"
@@ -9,6 +8,7 @@ import (
 	"gopkg.in/src-d/go-kallax.v1/types"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lann/builder"
 )
 
 // ScalarCond returns a kallax.Condition that compares a property with the passed
@@ -26,9 +26,14 @@ type ToSqler interface {
 // You can put `:col:` wherever you want your column name to be on the format and
 // `?` for the value, which will be automatically escaped.
 // Example: `:col: % :arg:`.
+// If the value is a ColumnRef, `:arg:` is replaced by the referenced column.
 func NewOperator(format string) func(SchemaField, interface{}) Condition {
 	return func(col SchemaField, value interface{}) Condition {
 		return func(schema Schema) ToSqler {
+			if ref, ok := value.(*ColumnRef); ok {
+				format := strings.Replace(format, ":arg:", ref.QualifiedName(schema), -1)
+				return newCustomOp(format, col.QualifiedName(schema), nil, true)
+			}
 			return newCustomOp(format, col.QualifiedName(schema), []interface{}{value}, false)
 		}
 	}
@@ -80,9 +85,57 @@ func (op *customOp) ToSql() (string, []interface{}, error) {
 // Condition represents a condition of filtering in a query.
 type Condition func(Schema) ToSqler
 
+// ColumnRef is a reference to a column that can be passed as the value of the
+// scalar operators, NewOperator and JSONContains and JSONContainedBy to
+// compare a column with another column instead of with a value. The column is
+// qualified by the alias of the schema the condition is used with or, if it
+// was created with RelCol, by the alias of the joined relationship.
+// ColumnRef is also a SchemaField, so a column of a joined relationship can be
+// used on the left side of an operator as well.
+//   Gt(Schema.Post.UpdatedAt, Col(Schema.Post.CreatedAt))
+//   // ... __post.updated_at > __post.created_at
+type ColumnRef struct {
+	col    SchemaField
+	schema Schema
+}
+
+// Col returns a reference to the given column, qualified by the alias of the
+// schema the condition is used with.
+func Col(col SchemaField) *ColumnRef {
+	return &ColumnRef{col: col}
+}
+
+// RelCol returns a reference to the given column of the relationship in the
+// given field, qualified by the alias of the table joined for it. The given
+// schema is the schema of the related records.
+//   Eq(Schema.Post.Title, RelCol(Schema.User.BaseSchema, "Author", Schema.User.Name))
+//   // ... __post.title = __user_Author.name
+func RelCol(schema Schema, field string, col SchemaField) *ColumnRef {
+	return &ColumnRef{col, schema.WithAlias(field)}
+}
+
+func (*ColumnRef) isSchemaField() {}
+
+func (r *ColumnRef) String() string {
+	return r.col.String()
+}
+
+// QualifiedName returns the name of the referenced column qualified by the
+// alias of the relationship it was created for or, if none, by the alias of
+// the given schema.
+func (r *ColumnRef) QualifiedName(schema Schema) string {
+	if r.schema != nil {
+		return r.col.QualifiedName(r.schema)
+	}
+	return r.col.QualifiedName(schema)
+}
+
 // Eq returns a condition that will be true when `col` is equal to `value`.
 func Eq(col SchemaField, value interface{}) Condition {
 	return func(schema Schema) ToSqler {
+		if ref, ok := value.(*ColumnRef); ok {
+			return &colsOp{col.QualifiedName(schema), "=", ref.QualifiedName(schema)}
+		}
 		return squirrel.Eq{col.QualifiedName(schema): value}
 	}
 }
@@ -90,6 +143,9 @@ func Eq(col SchemaField, value interface{}) Condition {
 // Lt returns a condition that will be true when `col` is lower than `value`.
 func Lt(col SchemaField, value interface{}) Condition {
 	return func(schema Schema) ToSqler {
+		if ref, ok := value.(*ColumnRef); ok {
+			return &colsOp{col.QualifiedName(schema), "<", ref.QualifiedName(schema)}
+		}
 		return squirrel.Lt{col.QualifiedName(schema): value}
 	}
 }
@@ -97,6 +153,9 @@ func Lt(col SchemaField, value interface{}) Condition {
 // Gt returns a condition that will be true when `col` is greater than `value`.
 func Gt(col SchemaField, value interface{}) Condition {
 	return func(schema Schema) ToSqler {
+		if ref, ok := value.(*ColumnRef); ok {
+			return &colsOp{col.QualifiedName(schema), ">", ref.QualifiedName(schema)}
+		}
 		return squirrel.Gt{col.QualifiedName(schema): value}
 	}
 }
@@ -105,6 +164,9 @@ func Gt(col SchemaField, value interface{}) Condition {
 // `value` or equal.
 func LtOrEq(col SchemaField, value interface{}) Condition {
 	return func(schema Schema) ToSqler {
+		if ref, ok := value.(*ColumnRef); ok {
+			return &colsOp{col.QualifiedName(schema), "<=", ref.QualifiedName(schema)}
+		}
 		return squirrel.LtOrEq{col.QualifiedName(schema): value}
 	}
 }
@@ -113,6 +175,9 @@ func LtOrEq(col SchemaField, value interface{}) Condition {
 // `value` or equal.
 func GtOrEq(col SchemaField, value interface{}) Condition {
 	return func(schema Schema) ToSqler {
+		if ref, ok := value.(*ColumnRef); ok {
+			return &colsOp{col.QualifiedName(schema), ">=", ref.QualifiedName(schema)}
+		}
 		return squirrel.GtOrEq{col.QualifiedName(schema): value}
 	}
 }
@@ -120,6 +185,9 @@ func GtOrEq(col SchemaField, value interface{}) Condition {
 // Neq returns a condition that will be true when `col` is not `value`.
 func Neq(col SchemaField, value interface{}) Condition {
 	return func(schema Schema) ToSqler {
+		if ref, ok := value.(*ColumnRef); ok {
+			return &colsOp{col.QualifiedName(schema), "<>", ref.QualifiedName(schema)}
+		}
 		return squirrel.NotEq{col.QualifiedName(schema): value}
 	}
 }
@@ -138,7 +206,7 @@ func Like(col SchemaField, value string) Condition {
 // See https://www.postgresql.org/docs/9.6/static/functions-matching.html.
 func Ilike(col SchemaField, value string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "ILIKE", value}
+		return dialectOp(schema, "Ilike", col.QualifiedName(schema), "?", value)
 	}
 }
 
@@ -147,7 +215,7 @@ func Ilike(col SchemaField, value string) Condition {
 // See https://www.postgresql.org/docs/9.6/static/functions-matching.html.
 func SimilarTo(col SchemaField, value string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "SIMILAR TO", value}
+		return dialectOp(schema, "SimilarTo", col.QualifiedName(schema), "?", value)
 	}
 }
 
@@ -156,7 +224,7 @@ func SimilarTo(col SchemaField, value string) Condition {
 // See https://www.postgresql.org/docs/9.6/static/functions-matching.html.
 func NotSimilarTo(col SchemaField, value string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "NOT SIMILAR TO", value}
+		return dialectOp(schema, "NotSimilarTo", col.QualifiedName(schema), "?", value)
 	}
 }
 
@@ -201,7 +269,7 @@ func NotIn(col SchemaField, values ...interface{}) Condition {
 // array with the given elements.
 func ArrayEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "=", types.Slice(values)}
+		return dialectOp(schema, "ArrayEq", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -209,7 +277,7 @@ func ArrayEq(col SchemaField, values ...interface{}) Condition {
 // an array with the given elements.
 func ArrayNotEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<>", types.Slice(values)}
+		return dialectOp(schema, "ArrayNotEq", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -220,7 +288,7 @@ func ArrayNotEq(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayLt(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<", types.Slice(values)}
+		return dialectOp(schema, "ArrayLt", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -231,7 +299,7 @@ func ArrayLt(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayGt(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), ">", types.Slice(values)}
+		return dialectOp(schema, "ArrayGt", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -241,7 +309,7 @@ func ArrayGt(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayLtOrEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<=", types.Slice(values)}
+		return dialectOp(schema, "ArrayLtOrEq", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -251,7 +319,7 @@ func ArrayLtOrEq(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayGtOrEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), ">=", types.Slice(values)}
+		return dialectOp(schema, "ArrayGtOrEq", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -259,7 +327,7 @@ func ArrayGtOrEq(col SchemaField, values ...interface{}) Condition {
 // given values.
 func ArrayContains(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "@>", types.Slice(values)}
+		return dialectOp(schema, "ArrayContains", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -267,7 +335,7 @@ func ArrayContains(col SchemaField, values ...interface{}) Condition {
 // its elements present in the given values.
 func ArrayContainedBy(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<@", types.Slice(values)}
+		return dialectOp(schema, "ArrayContainedBy", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -275,7 +343,7 @@ func ArrayContainedBy(col SchemaField, values ...interface{}) Condition {
 // in common with an array formed by the given values.
 func ArrayOverlap(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "&&", types.Slice(values)}
+		return dialectOp(schema, "ArrayOverlap", col.QualifiedName(schema), "?", types.Slice(values))
 	}
 }
 
@@ -283,7 +351,7 @@ func ArrayOverlap(col SchemaField, values ...interface{}) Condition {
 // object.
 func JSONIsObject(col SchemaField) Condition {
 	return func(schema Schema) ToSqler {
-		return &colUnaryOp{col.QualifiedName(schema), " @> '{}'"}
+		return dialectOp(schema, "JSONIsObject", col.QualifiedName(schema), "")
 	}
 }
 
@@ -291,7 +359,7 @@ func JSONIsObject(col SchemaField) Condition {
 // array.
 func JSONIsArray(col SchemaField) Condition {
 	return func(schema Schema) ToSqler {
-		return &colUnaryOp{col.QualifiedName(schema), " @> '[]'"}
+		return dialectOp(schema, "JSONIsArray", col.QualifiedName(schema), "")
 	}
 }
 
@@ -299,7 +367,10 @@ func JSONIsArray(col SchemaField) Condition {
 // the given element converted to JSON.
 func JSONContains(col SchemaField, elem interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "@>", types.JSON(elem)}
+		if ref, ok := elem.(*ColumnRef); ok {
+			return dialectOp(schema, "JSONContains", col.QualifiedName(schema), ref.QualifiedName(schema))
+		}
+		return dialectOp(schema, "JSONContains", col.QualifiedName(schema), "?", types.JSON(elem))
 	}
 }
 
@@ -315,7 +386,13 @@ func JSONContainsAny(col SchemaField, elems ...interface{}) Condition {
 		if len(elems) == 0 {
 			return &errOp{"can't check if json contains 0 elements"}
 		}
-		return &containsAny{col.QualifiedName(schema), elems}
+		var placeholders = make([]string, len(elems))
+		var args = make([]interface{}, len(elems))
+		for i, elem := range elems {
+			args[i] = types.JSON(elem)
+			placeholders[i] = "?"
+		}
+		return dialectOp(schema, "JSONContainsAny", col.QualifiedName(schema), strings.Join(placeholders, ", "), args...)
 	}
 }
 
@@ -323,7 +400,10 @@ func JSONContainsAny(col SchemaField, elems ...interface{}) Condition {
 // contained by the given element converted to JSON.
 func JSONContainedBy(col SchemaField, elem interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<@", types.JSON(elem)}
+		if ref, ok := elem.(*ColumnRef); ok {
+			return dialectOp(schema, "JSONContainedBy", col.QualifiedName(schema), ref.QualifiedName(schema))
+		}
+		return dialectOp(schema, "JSONContainedBy", col.QualifiedName(schema), "?", types.JSON(elem))
 	}
 }
 
@@ -331,7 +411,7 @@ func JSONContainedBy(col SchemaField, elem interface{}) Condition {
 // any of the given keys. Will also match elements if the column is an array.
 func JSONContainsAnyKey(col SchemaField, keys ...string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "??|", types.Slice(keys)}
+		return dialectOp(schema, "JSONContainsAnyKey", col.QualifiedName(schema), "?", types.Slice(keys))
 	}
 }
 
@@ -340,7 +420,7 @@ func JSONContainsAnyKey(col SchemaField, keys ...string) Condition {
 // array.
 func JSONContainsAllKeys(col SchemaField, keys ...string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "??&", types.Slice(keys)}
+		return dialectOp(schema, "JSONContainsAllKeys", col.QualifiedName(schema), "?", types.Slice(keys))
 	}
 }
 
@@ -348,7 +428,7 @@ func JSONContainsAllKeys(col SchemaField, keys ...string) Condition {
 // the given POSIX regex. Match is case sensitive.
 func MatchRegexCase(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "~", driver.Value(pattern)}
+		return dialectOp(schema, "MatchRegexCase", col.QualifiedName(schema), "?", pattern)
 	}
 }
 
@@ -356,7 +436,7 @@ func MatchRegexCase(col SchemaField, pattern string) Condition {
 // the given POSIX regex. Match is case insensitive.
 func MatchRegex(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "~*", driver.Value(pattern)}
+		return dialectOp(schema, "MatchRegex", col.QualifiedName(schema), "?", pattern)
 	}
 }
 
@@ -364,7 +444,7 @@ func MatchRegex(col SchemaField, pattern string) Condition {
 // match the given POSIX regex. Match is case sensitive.
 func NotMatchRegexCase(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "!~", driver.Value(pattern)}
+		return dialectOp(schema, "NotMatchRegexCase", col.QualifiedName(schema), "?", pattern)
 	}
 }
 
@@ -372,8 +452,190 @@ func NotMatchRegexCase(col SchemaField, pattern string) Condition {
 // match the given POSIX regex. Match is case insensitive.
 func NotMatchRegex(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "!~*", driver.Value(pattern)}
+		return dialectOp(schema, "NotMatchRegex", col.QualifiedName(schema), "?", pattern)
+	}
+}
+
+// TextQuery is a full-text search query, which can be used to filter records
+// with Matches and to order them with ByRank.
+type TextQuery struct {
+	parser string
+	text   string
+}
+
+// PlainQuery returns a full-text search query that matches the documents
+// containing all the words in the given text, which is parsed with
+// plainto_tsquery. Punctuation and operators in the text are ignored.
+func PlainQuery(text string) TextQuery {
+	return TextQuery{"plainto_tsquery", text}
+}
+
+// WebSearchQuery returns a full-text search query parsed with
+// websearch_to_tsquery from the given text, which can contain quoted phrases,
+// "or" between words and "-" to exclude words, like the queries of web search
+// engines. It requires PostgreSQL 11 or later.
+func WebSearchQuery(text string) TextQuery {
+	return TextQuery{"websearch_to_tsquery", text}
+}
+
+// Matches returns a condition that will be true when `col` matches the given
+// full-text search query, using the given text search configuration, such as
+// "english", or the default one of the database if it is empty.
+// The column is converted with to_tsvector unless it is a tsvector column.
+//   Matches(Schema.Post.Body, PlainQuery("fat rats"), "english")
+//   // ... to_tsvector('english', __post.body) @@ plainto_tsquery('english', $1)
+func Matches(col SchemaField, query TextQuery, config string) Condition {
+	return func(schema Schema) ToSqler {
+		return dialectOp(schema, "Matches", tsvector(col, schema, config), query.sql(config), query.text)
+	}
+}
+
+// sql returns the SQL expression of the query with the given text search
+// configuration, with a placeholder for its text.
+func (q TextQuery) sql(config string) string {
+	return fmt.Sprintf("%s(%s?)", q.parser, tsconfig(config))
+}
+
+// tsvector returns the SQL expression of the document of the given column to
+// be used in full-text search.
+func tsvector(col SchemaField, schema Schema, config string) string {
+	var field = col
+	if ref, ok := col.(*ColumnRef); ok {
+		field = ref.col
+	}
+
+	if _, ok := field.(*TSVectorSchemaField); ok {
+		return col.QualifiedName(schema)
+	}
+	return fmt.Sprintf("to_tsvector(%s%s)", tsconfig(config), col.QualifiedName(schema))
+}
+
+// tsconfig returns the given text search configuration as the first argument
+// of a full-text search function, or nothing if it is empty.
+func tsconfig(config string) string {
+	if config == "" {
+		return ""
+	}
+	return fmt.Sprintf("'%s', ", strings.Replace(config, "'", "''", -1))
+}
+
+// InQuery returns a condition that will be true when `col` is in the results
+// of the given query, which must select exactly one column.
+//   q := NewBaseQuery(PostSchema)
+//   q.Select(PostSchema.AuthorFK)
+//   q.Where(Eq(PostSchema.Status, "published"))
+//   InQuery(UserSchema.ID, q)
+//   // ... id IN (SELECT __post.author_id FROM post __post WHERE __post.status = $1)
+func InQuery(col SchemaField, q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return inQuery{col.QualifiedName(schema), q, dialectOf(schema)}
+	}
+}
+
+// Exists returns a condition that will be true when the given query returns
+// any row.
+func Exists(q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return exists{q, dialectOf(schema), false}
+	}
+}
+
+// NotExists returns a condition that will be true when the given query does
+// not return any row.
+func NotExists(q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return exists{q, dialectOf(schema), true}
+	}
+}
+
+// Has returns a condition that will be true when the relationship in the
+// given field has any record matching the given condition, which can be nil.
+// The given schema is the schema of the related records, and the condition
+// is applied to them. The foreign key of the field in the schema the
+// condition is used with is used to correlate both tables.
+//   Has(PostSchema, "Posts", Eq(PostSchema.Status, "published"))
+//   // ... EXISTS (SELECT 1 FROM post __post_Posts WHERE __post_Posts.user_id = __user.id AND __post_Posts.status = $1)
+func Has(schema Schema, field string, cond Condition) Condition {
+	return func(parent Schema) ToSqler {
+		fk, ok := parent.ForeignKey(field)
+		if !ok {
+			return errOp{fmt.Sprintf(
+				"kallax: cannot find foreign key of field %s in table %s",
+				field, parent.Table(),
+			)}
+		}
+
+		schema := schema.WithAlias(field)
+		q := NewBaseQuery(schema)
+		switch {
+		case fk.Through != "":
+			alias := schema.Alias() + "_through"
+			q.builder = q.builder.Join(fmt.Sprintf(
+				"%s %s ON (%s.%s = %s)",
+				fk.Through,
+				alias,
+				alias,
+				fk.ThroughKey,
+				schema.ID().QualifiedName(schema),
+			))
+			q.Where(colsEq(alias+"."+fk.String(), parent.ID().QualifiedName(parent)))
+		case fk.Inverse:
+			q.Where(colsEq(schema.ID().QualifiedName(schema), fk.QualifiedName(parent)))
+		default:
+			q.Where(colsEq(fk.QualifiedName(schema), parent.ID().QualifiedName(parent)))
+		}
+
+		if cond != nil {
+			q.Where(cond)
+		}
+
+		return exists{q, dialectOf(parent), false}
+	}
+}
+
+// colsEq returns a condition that will be true when the two given qualified
+// columns are equal.
+func colsEq(a, b string) Condition {
+	return func(Schema) ToSqler {
+		return squirrel.Expr(fmt.Sprintf("%s = %s", a, b))
+	}
+}
+
+// dialectOp returns the operator with the given name in the dialect of the
+// given schema, with `:col:` replaced by the given column and `:arg:` by the
+// given argument, or an error if the dialect does not support it.
+func dialectOp(schema Schema, name, col, arg string, args ...interface{}) ToSqler {
+	d := dialectOf(schema)
+	format, ok := d.Operator(name)
+	if !ok {
+		return errOp{fmt.Sprintf("kallax: operator %s is not supported by the %s dialect", name, d.Name())}
 	}
+
+	return squirrel.Expr(
+		strings.Replace(strings.Replace(format, ":col:", col, -1), ":arg:", arg, -1),
+		args...,
+	)
+}
+
+// subquery returns the SQL and arguments of the given query, compiled for
+// the given dialect, to be used as a subquery selecting the given columns,
+// or the selected ones of the query if none is given.
+func subquery(q Query, dialect Dialect, columns ...string) (string, []interface{}, error) {
+	_, b := q.compile(dialect)
+	if len(columns) > 0 {
+		b = builder.Set(b, "Columns", nil).(squirrel.SelectBuilder).Columns(columns...)
+	}
+
+	if offset := q.GetOffset(); offset > 0 {
+		b = b.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		b = b.Limit(limit)
+	}
+
+	// placeholders are replaced by the outer query
+	return b.PlaceholderFormat(squirrel.Question).ToSql()
 }
 
 type (
@@ -381,25 +643,33 @@ type (
 		cond ToSqler
 	}
 
+	inQuery struct {
+		col     string
+		q       Query
+		dialect Dialect
+	}
+
+	exists struct {
+		q       Query
+		dialect Dialect
+		not     bool
+	}
+
 	colOp struct {
 		col   string
 		op    string
 		value interface{}
 	}
 
-	colUnaryOp struct {
-		col string
-		op  string
+	colsOp struct {
+		left  string
+		op    string
+		right string
 	}
 
 	errOp struct {
 		msg string
 	}
-
-	containsAny struct {
-		col    string
-		values []interface{}
-	}
 )
 
 func (n not) ToSql() (string, []interface{}, error) {
@@ -411,32 +681,44 @@ func (n not) ToSql() (string, []interface{}, error) {
 	return fmt.Sprintf("NOT (%s)", sql), args, err
 }
 
+func (o inQuery) ToSql() (string, []interface{}, error) {
+	cols, _ := o.q.compile(o.dialect)
+	if len(cols) != 1 {
+		return "", nil, fmt.Errorf("kallax: the query of InQuery must select exactly one column, it selects %d", len(cols))
+	}
+
+	sql, args, err := subquery(o.q, o.dialect, o.q.Schema().Alias()+"."+cols[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("%s IN (%s)", o.col, sql), args, nil
+}
+
+func (o exists) ToSql() (string, []interface{}, error) {
+	sql, args, err := subquery(o.q, o.dialect, "1")
+	if err != nil {
+		return "", nil, err
+	}
+
+	if o.not {
+		return fmt.Sprintf("NOT EXISTS (%s)", sql), args, nil
+	}
+	return fmt.Sprintf("EXISTS (%s)", sql), args, nil
+}
+
 func (o colOp) ToSql() (string, []interface{}, error) {
 	return fmt.Sprintf("%s %s ?", o.col, o.op), []interface{}{o.value}, nil
 }
 
-func (o colUnaryOp) ToSql() (string, []interface{}, error) {
-	return fmt.Sprintf("%s %s", o.col, o.op), nil, nil
+func (o colsOp) ToSql() (string, []interface{}, error) {
+	return fmt.Sprintf("%s %s %s", o.left, o.op, o.right), nil, nil
 }
 
 func (o errOp) ToSql() (string, []interface{}, error) {
 	return "", nil, errors.New(o.msg)
 }
 
-func (o containsAny) ToSql() (string, []interface{}, error) {
-	var placeholders = make([]string, len(o.values))
-	var args = make([]interface{}, len(o.values))
-	for i, el := range o.values {
-		args[i] = types.JSON(el)
-		placeholders[i] = "?"
-	}
-	return fmt.Sprintf(
-		"%s @> ANY (ARRAY [%s]::jsonb[])",
-		o.col,
-		strings.Join(placeholders, ", "),
-	), args, nil
-}
-
 func condsToSqlizers(conds []Condition, schema Schema) []squirrel.Sqlizer {
 	var result = make([]squirrel.Sqlizer, len(conds))
 	for i, v := range conds {
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gopkg.in/src-d/go-kallax.v1/types"
 )
@@ -89,6 +90,42 @@ func (s *OpsSuite) TestOperators() {
 	}
 }
 
+func (s *OpsSuite) TestColumnOperators() {
+	s.create(`CREATE TABLE model (
+		id serial PRIMARY KEY,
+		name varchar(255) not null,
+		email varchar(255) not null,
+		age int not null
+	)`)
+	defer s.remove("model")
+
+	customGt := NewOperator(":col: > :arg:")
+
+	cases := []struct {
+		name  string
+		cond  Condition
+		count int64
+	}{
+		{"Eq", Eq(f("email"), Col(f("name"))), 1},
+		{"Neq", Neq(f("email"), Col(f("name"))), 2},
+		{"Eq int", Eq(f("age"), Col(f("id"))), 1},
+		{"Gt", Gt(f("age"), Col(f("id"))), 1},
+		{"LtOrEq", LtOrEq(f("age"), Col(f("id"))), 2},
+		{"customGt", customGt(f("age"), Col(f("id"))), 1},
+	}
+
+	s.Nil(s.store.Insert(ModelSchema, newModel("Joe", "Joe", 1)))
+	s.Nil(s.store.Insert(ModelSchema, newModel("Jane", "jane@example.com", 5)))
+	s.Nil(s.store.Insert(ModelSchema, newModel("Anna", "anna@example.com", 0)))
+
+	for _, c := range cases {
+		q := NewBaseQuery(ModelSchema)
+		q.Where(c.cond)
+
+		s.Equal(c.count, s.store.Debug().MustCount(q), c.name)
+	}
+}
+
 func (s *OpsSuite) TestArrayOperators() {
 	s.create(`CREATE TABLE slices (
 		id uuid PRIMARY KEY,
@@ -206,6 +243,57 @@ func (s *OpsSuite) TestJSONOperators() {
 	}
 }
 
+func (s *OpsSuite) TestSubqueryOperators() {
+	s.create(`CREATE TABLE model (
+		id serial PRIMARY KEY,
+		name varchar(255) not null,
+		email varchar(255) not null,
+		age int not null
+	)`)
+	defer s.remove("model")
+	s.create(`CREATE TABLE rel (
+		id serial PRIMARY KEY,
+		model_id integer,
+		foo text
+	)`)
+	defer s.remove("rel")
+
+	joe := newModel("Joe", "", 1)
+	jane := newModel("Jane", "", 2)
+	s.Nil(s.store.Insert(ModelSchema, joe))
+	s.Nil(s.store.Insert(ModelSchema, jane))
+	s.Nil(s.store.Insert(ModelSchema, newModel("Anna", "", 2)))
+	s.Nil(s.store.Insert(RelSchema, newRel(joe.GetID(), "a")))
+	s.Nil(s.store.Insert(RelSchema, newRel(joe.GetID(), "b")))
+	s.Nil(s.store.Insert(RelSchema, newRel(jane.GetID(), "b")))
+
+	sub := NewBaseQuery(RelSchema)
+	sub.Select(f("model_id"))
+	sub.Where(Eq(f("foo"), "a"))
+
+	cases := []struct {
+		name  string
+		cond  Condition
+		count int64
+	}{
+		{"InQuery", InQuery(f("id"), sub), 1},
+		{"Not InQuery", Not(InQuery(f("id"), sub)), 2},
+		{"Exists", Exists(sub), 3},
+		{"NotExists", NotExists(sub), 0},
+		{"Has", Has(RelSchema, "rels", nil), 2},
+		{"Has with condition", Has(RelSchema, "rels", Eq(f("foo"), "b")), 2},
+		{"Not Has", Not(Has(RelSchema, "rels", Eq(f("foo"), "a"))), 2},
+		{"Has composed", And(Has(RelSchema, "rels", nil), Eq(f("age"), 2)), 1},
+	}
+
+	for _, c := range cases {
+		q := NewBaseQuery(ModelSchema)
+		q.Where(c.cond)
+
+		s.Equal(c.count, s.store.Debug().MustCount(q), c.name)
+	}
+}
+
 func TestOperators(t *testing.T) {
 	suite.Run(t, new(OpsSuite))
 }
@@ -229,3 +317,171 @@ var JsonsSchema = &BaseSchema{
 		f("elem"),
 	},
 }
+
+func TestSubqueryOperatorsSQL(t *testing.T) {
+	r := require.New(t)
+
+	sub := NewBaseQuery(RelSchema)
+	sub.Select(f("model_id"))
+	sub.Where(Eq(f("foo"), "a"))
+
+	cases := []struct {
+		name string
+		cond Condition
+		sql  string
+		args []interface{}
+	}{
+		{
+			"InQuery",
+			InQuery(f("id"), sub),
+			"__model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = $1)",
+			[]interface{}{"a"},
+		},
+		{
+			"Exists",
+			Exists(sub),
+			"EXISTS (SELECT 1 FROM rel __rel WHERE __rel.foo = $1)",
+			[]interface{}{"a"},
+		},
+		{
+			"NotExists",
+			NotExists(sub),
+			"NOT EXISTS (SELECT 1 FROM rel __rel WHERE __rel.foo = $1)",
+			[]interface{}{"a"},
+		},
+		{
+			"Has",
+			Has(RelSchema, "rels", Eq(f("foo"), "b")),
+			"EXISTS (SELECT 1 FROM rel __rel_rels WHERE __rel_rels.model_id = __model.id AND __rel_rels.foo = $1)",
+			[]interface{}{"b"},
+		},
+		{
+			"Has inverse",
+			Has(RelSchema, "rel_inv", nil),
+			"EXISTS (SELECT 1 FROM rel __rel_rel_inv WHERE __rel_rel_inv.id = __model.model_id)",
+			nil,
+		},
+		{
+			"Has through",
+			Has(RelSchema, "rels_through", Eq(f("foo"), "b")),
+			"EXISTS (SELECT 1 FROM rel __rel_rels_through JOIN model_rel __rel_rels_through_through ON (__rel_rels_through_through.rel_id = __rel_rels_through.id) WHERE __rel_rels_through_through.model_id = __model.id AND __rel_rels_through.foo = $1)",
+			[]interface{}{"b"},
+		},
+		{
+			"composed",
+			Or(Not(Has(RelSchema, "rels", Eq(f("foo"), "b"))), And(Eq(f("name"), "c"), InQuery(f("id"), sub))),
+			"(NOT (EXISTS (SELECT 1 FROM rel __rel_rels WHERE __rel_rels.model_id = __model.id AND __rel_rels.foo = $1)) OR (__model.name = $2 AND __model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = $3)))",
+			[]interface{}{"b", "c", "a"},
+		},
+	}
+
+	for _, c := range cases {
+		q := NewBaseQuery(ModelSchema)
+		q.Select(f("id"))
+		q.Where(c.cond)
+		sql, args, err := q.ToSql()
+		r.NoError(err, c.name)
+		r.Equal("SELECT __model.id FROM model __model WHERE "+c.sql, sql, c.name)
+		r.Equal(c.args, args, c.name)
+	}
+}
+
+func TestColumnRefSQL(t *testing.T) {
+	r := require.New(t)
+
+	customGt := NewOperator(":col: > :arg:")
+	key := NewJSONSchemaKey(JSONInt, "props", "a", "b")
+
+	cases := []struct {
+		name string
+		cond Condition
+		sql  string
+	}{
+		{"Eq", Eq(f("name"), Col(f("email"))), "__model.name = __model.email"},
+		{"Neq", Neq(f("name"), Col(f("email"))), "__model.name <> __model.email"},
+		{"Lt", Lt(f("age"), Col(f("id"))), "__model.age < __model.id"},
+		{"Gt", Gt(f("age"), Col(f("id"))), "__model.age > __model.id"},
+		{"LtOrEq", LtOrEq(f("age"), Col(f("id"))), "__model.age <= __model.id"},
+		{"GtOrEq", GtOrEq(f("age"), Col(f("id"))), "__model.age >= __model.id"},
+		{"custom", customGt(f("age"), Col(f("id"))), "__model.age > __model.id"},
+		{"JSONContains", JSONContains(f("props"), Col(f("other"))), "__model.props @> __model.other"},
+		{"JSONContainedBy", JSONContainedBy(f("props"), Col(f("other"))), "__model.props <@ __model.other"},
+		{"JSON key", Gt(key, Col(f("age"))), "CAST(__model.props #>>'{a,b}' as bigint) > __model.age"},
+		{"JSON key ref", Eq(f("age"), Col(key)), "__model.age = CAST(__model.props #>>'{a,b}' as bigint)"},
+		{"relationship", Eq(f("name"), RelCol(RelSchema, "rel", f("foo"))), "__model.name = __rel_rel.foo"},
+		{"relationship left", Lt(RelCol(RelSchema, "rel", f("id")), Col(f("age"))), "__rel_rel.id < __model.age"},
+	}
+
+	for _, c := range cases {
+		q := NewBaseQuery(ModelSchema)
+		q.Select(f("id"))
+		r.NoError(q.AddRelation(RelSchema, "rel", OneToOne, nil))
+		q.Where(c.cond)
+		sql, args, err := q.ToSql()
+		r.NoError(err, c.name)
+		r.Equal("SELECT __model.id, __rel_rel.id, __rel_rel.model_id, __rel_rel.foo FROM model __model LEFT JOIN rel __rel_rel ON (__rel_rel.model_id = __model.id) WHERE "+c.sql, sql, c.name)
+		r.Len(args, 0, c.name)
+	}
+}
+
+func TestFullTextSQL(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		name string
+		cond Condition
+		sql  string
+	}{
+		{
+			"plain",
+			Matches(f("name"), PlainQuery("fat rats"), "english"),
+			"to_tsvector('english', __model.name) @@ plainto_tsquery('english', ?)",
+		},
+		{
+			"web search",
+			Matches(f("name"), WebSearchQuery(`"fat rats" -cat`), "english"),
+			"to_tsvector('english', __model.name) @@ websearch_to_tsquery('english', ?)",
+		},
+		{
+			"default config",
+			Matches(f("name"), PlainQuery("fat rats"), ""),
+			"to_tsvector(__model.name) @@ plainto_tsquery(?)",
+		},
+		{
+			"quoted config",
+			Matches(f("name"), PlainQuery("fat rats"), "it's"),
+			"to_tsvector('it''s', __model.name) @@ plainto_tsquery('it''s', ?)",
+		},
+		{
+			"tsvector",
+			Matches(NewTSVectorSchemaField("document"), PlainQuery("fat rats"), "english"),
+			"__model.document @@ plainto_tsquery('english', ?)",
+		},
+		{
+			"tsvector of relationship",
+			Matches(RelCol(RelSchema, "rel", NewTSVectorSchemaField("document")), PlainQuery("fat rats"), "english"),
+			"__rel_rel.document @@ plainto_tsquery('english', ?)",
+		},
+	}
+
+	for _, c := range cases {
+		sql, args, err := c.cond(ModelSchema).ToSql()
+		r.NoError(err, c.name)
+		r.Equal(c.sql, sql, c.name)
+		r.Len(args, 1, c.name)
+	}
+}
+
+func TestSubqueryOperatorsSQL_Errors(t *testing.T) {
+	r := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(InQuery(f("id"), NewBaseQuery(RelSchema)))
+	_, _, err := q.ToSql()
+	r.Error(err)
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Has(RelSchema, "foo", nil))
+	_, _, err = q.ToSql()
+	r.Error(err)
+}
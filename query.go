@@ -9,17 +9,26 @@ import (
 
 var (
 	// ErrManyToManyNotSupported is returned when a many to many relationship
-	// is added to a query.
-	ErrManyToManyNotSupported = errors.New("kallax: many to many relationships are not supported")
+	// is added to a query for a field with no join table.
+	ErrManyToManyNotSupported = errors.New("kallax: many to many relationships are not supported without a join table")
+	// ErrLockNotSupported is returned when a query that locks the selected
+	// rows is performed with one to many or many to many relationships, whose
+	// rows are retrieved in batches by several queries.
+	ErrLockNotSupported = errors.New("kallax: row locking can not be used in queries with one to many or many to many relationships")
 )
 
 // Query is the common interface all queries must satisfy. The basic abilities
 // of a query are compiling themselves to something executable and return
 // some query settings.
 type Query interface {
-	compile() ([]string, squirrel.SelectBuilder)
+	compile(Dialect) ([]string, squirrel.SelectBuilder)
 	getRelationships() []Relationship
+	getGroupBy() []SchemaField
+	excludesDeleted() bool
 	isReadOnly() bool
+	isLocking() bool
+	getOrder() []ColumnOrder
+	validate() error
 	// Schema returns the schema of the query model.
 	Schema() Schema
 	// GetOffset returns the number of skipped rows in the query.
@@ -67,6 +76,10 @@ func (cs *columnSet) remove(cols ...SchemaField) {
 }
 
 func (cs columnSet) copy() []SchemaField {
+	if cs == nil {
+		return nil
+	}
+
 	var result = make(columnSet, len(cs))
 	for i, col := range cs {
 		result[i] = col
@@ -74,6 +87,19 @@ func (cs columnSet) copy() []SchemaField {
 	return result
 }
 
+// deletedMode defines which rows are returned by a query regarding whether
+// they were soft deleted or not.
+type deletedMode int
+
+const (
+	// withoutDeleted excludes the soft deleted rows.
+	withoutDeleted deletedMode = iota
+	// withDeleted includes the soft deleted rows.
+	withDeleted
+	// onlyDeleted returns only the soft deleted rows.
+	onlyDeleted
+)
+
 // BaseQuery is a generic query builder to build queries programmatically.
 type BaseQuery struct {
 	schema          Schema
@@ -83,6 +109,15 @@ type BaseQuery struct {
 	// relationships
 	relationColumns []string
 	relationships   []Relationship
+	groupBy         columnSet
+	where           []Condition
+	having          []Condition
+	deleted         deletedMode
+	lock            string
+	lockWait        string
+	order           []ColumnOrder
+	cursor          *Cursor
+	cursorBefore    bool
 	builder         squirrel.SelectBuilder
 
 	selectChanged bool
@@ -95,7 +130,6 @@ type BaseQuery struct {
 func NewBaseQuery(schema Schema) *BaseQuery {
 	return &BaseQuery{
 		builder: squirrel.StatementBuilder.
-			PlaceholderFormat(squirrel.Dollar).
 			Select().
 			From(schema.Table() + " " + schema.Alias()),
 		columns:   columnSet(schema.Columns()),
@@ -138,6 +172,15 @@ func (q *BaseQuery) Copy() *BaseQuery {
 		excludedColumns: q.excludedColumns.copy(),
 		relationColumns: q.relationColumns[:],
 		relationships:   q.relationships[:],
+		groupBy:         q.groupBy.copy(),
+		where:           append([]Condition(nil), q.where...),
+		having:          append([]Condition(nil), q.having...),
+		deleted:         q.deleted,
+		lock:            q.lock,
+		lockWait:        q.lockWait,
+		order:           append([]ColumnOrder(nil), q.order...),
+		cursor:          q.cursor,
+		cursorBefore:    q.cursorBefore,
 		selectChanged:   q.selectChanged,
 		batchSize:       q.GetBatchSize(),
 		limit:           q.GetLimit(),
@@ -150,6 +193,14 @@ func (q *BaseQuery) getRelationships() []Relationship {
 	return q.relationships
 }
 
+func (q *BaseQuery) getGroupBy() []SchemaField {
+	return q.groupBy
+}
+
+func (q *BaseQuery) excludesDeleted() bool {
+	return q.deleted == withoutDeleted
+}
+
 func (q *BaseQuery) selectedColumns() []SchemaField {
 	var result = make([]SchemaField, 0, len(q.columns))
 	for _, col := range q.columns {
@@ -162,12 +213,8 @@ func (q *BaseQuery) selectedColumns() []SchemaField {
 
 // AddRelation adds a relationship if the given to the query, which is present
 // in the given field of the query base schema. A condition to filter can also
-// be passed in the case of one to many relationships.
+// be passed in the case of one to many and many to many relationships.
 func (q *BaseQuery) AddRelation(schema Schema, field string, typ RelationshipType, filter Condition) error {
-	if typ == ManyToMany {
-		return ErrManyToManyNotSupported
-	}
-
 	fk, ok := q.schema.ForeignKey(field)
 	if !ok {
 		return fmt.Errorf(
@@ -175,6 +222,10 @@ func (q *BaseQuery) AddRelation(schema Schema, field string, typ RelationshipTyp
 			q.schema.Table(), schema.Table(),
 		)
 	}
+
+	if typ == ManyToMany && fk.Through == "" {
+		return ErrManyToManyNotSupported
+	}
 	schema = schema.WithAlias(field)
 
 	if typ == OneToOne {
@@ -212,11 +263,49 @@ func (q *BaseQuery) join(schema Schema, fk *ForeignKey) {
 // Order adds the given order clauses to the list of columns to order the
 // results by.
 func (q *BaseQuery) Order(cols ...ColumnOrder) {
-	var c = make([]string, len(cols))
-	for i, v := range cols {
-		c[i] = v.ToSql(q.schema)
+	q.order = append(q.order, cols...)
+}
+
+func (q *BaseQuery) getOrder() []ColumnOrder {
+	return q.order
+}
+
+// After makes the query return only the rows after the given cursor, which
+// must have been retrieved from a query with the same order. The cursor needs
+// a value for each one of the columns the query is ordered by, and these
+// columns should not be nullable. For the pagination to be stable, the order
+// needs to be unique, e.g. ending with the primary key.
+//   q.Order(Desc(CreatedAtColumn), Asc(IDColumn))
+//   q.After(cursor)
+//   // ... WHERE (created_at < $1 OR (created_at = $1 AND id > $2))
+func (q *BaseQuery) After(cursor *Cursor) {
+	q.cursor = cursor
+	q.cursorBefore = false
+}
+
+// Before makes the query return only the rows before the given cursor. The
+// rows are still returned in the order of the query, so, to retrieve the
+// rows right before the cursor, the query needs to be in reverse order. The
+// same restrictions of After apply.
+func (q *BaseQuery) Before(cursor *Cursor) {
+	q.cursor = cursor
+	q.cursorBefore = true
+}
+
+// validate returns an error if the query can not be performed.
+func (q *BaseQuery) validate() error {
+	if q.cursor == nil {
+		return nil
 	}
-	q.builder = q.builder.OrderBy(c...)
+
+	if !isCursorOrder(q.order) {
+		return ErrRankCursor
+	}
+
+	if len(q.cursor.values) != len(q.order) {
+		return ErrCursorMismatch
+	}
+	return nil
 }
 
 // BatchSize sets the batch size.
@@ -256,11 +345,112 @@ func (q *BaseQuery) GetOffset() uint64 {
 //   q.Where(Gt(AgeColumn, 18))
 //   // ... WHERE name = "foo" AND age > 18
 func (q *BaseQuery) Where(cond Condition) {
-	q.builder = q.builder.Where(cond(q.schema))
+	q.where = append(q.where, cond)
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Grouped queries are meant to be used with aggregates, using the
+// FindAggregate method of the store, which selects the grouped columns along
+// with the aggregates.
+//   q.GroupBy(AgeColumn)
+//   // ... GROUP BY age
+func (q *BaseQuery) GroupBy(cols ...SchemaField) {
+	for _, col := range cols {
+		if q.groupBy.contains(col) {
+			continue
+		}
+
+		q.groupBy.addCol(col)
+		q.builder = q.builder.GroupBy(col.QualifiedName(q.schema))
+	}
+}
+
+// Having adds a new condition to filter the groups of the query. All
+// conditions added are concatenated with "and".
+//   q.Having(Gt(CountAll(), 10))
+//   // ... HAVING COUNT(*) > 10
+func (q *BaseQuery) Having(cond Condition) {
+	q.having = append(q.having, cond)
+}
+
+// WithDeleted makes the query also return the soft deleted rows. It has no
+// effect if the schema of the query has no soft delete column.
+func (q *BaseQuery) WithDeleted() {
+	q.deleted = withDeleted
+}
+
+// OnlyDeleted makes the query return only the soft deleted rows. It has no
+// effect if the schema of the query has no soft delete column.
+func (q *BaseQuery) OnlyDeleted() {
+	q.deleted = onlyDeleted
+}
+
+// ForUpdate locks the rows retrieved by the query, as if they were going to
+// be updated, until the end of the current transaction.
+// If the query has one to one relationships, only the rows of the query
+// schema are locked. Locking can not be used along with one to many or many
+// to many relationships.
+//   q.ForUpdate()
+//   // ... FOR UPDATE
+func (q *BaseQuery) ForUpdate() {
+	q.lock = "ForUpdate"
+}
+
+// ForShare locks the rows retrieved by the query with a shared lock until
+// the end of the current transaction, which prevents other transactions from
+// updating or deleting them, but not from reading them.
+// The same restrictions of ForUpdate apply.
+//   q.ForShare()
+//   // ... FOR SHARE
+func (q *BaseQuery) ForShare() {
+	q.lock = "ForShare"
 }
 
-// compile returns the selected column names and the select builder.
-func (q *BaseQuery) compile() ([]string, squirrel.SelectBuilder) {
+// NoWait makes the query fail instead of waiting if any of the rows to lock
+// is already locked. It has no effect if ForUpdate or ForShare are not used.
+//   q.ForUpdate()
+//   q.NoWait()
+//   // ... FOR UPDATE NOWAIT
+func (q *BaseQuery) NoWait() {
+	q.lockWait = "NOWAIT"
+}
+
+// SkipLocked makes the query skip the rows that are already locked instead of
+// waiting for them. It has no effect if ForUpdate or ForShare are not used.
+//   q.ForUpdate()
+//   q.SkipLocked()
+//   // ... FOR UPDATE SKIP LOCKED
+func (q *BaseQuery) SkipLocked() {
+	q.lockWait = "SKIP LOCKED"
+}
+
+func (q *BaseQuery) isLocking() bool {
+	return q.lock != ""
+}
+
+// lockClause returns the locking clause of the query in the dialect of the
+// given schema, or nil if the query does not lock any rows.
+func (q *BaseQuery) lockClause(schema Schema) ToSqler {
+	if q.lock == "" {
+		return nil
+	}
+
+	parts := []interface{}{dialectOp(schema, q.lock, "", "")}
+	if len(q.relationColumns) > 0 {
+		// rows in the nullable side of a left join can not be locked
+		parts = append(parts, " OF "+q.schema.Alias())
+	}
+
+	if q.lockWait != "" {
+		parts = append(parts, " "+q.lockWait)
+	}
+
+	return squirrel.ConcatExpr(parts...)
+}
+
+// compile returns the selected column names and the select builder, whose
+// conditions are compiled for the given dialect.
+func (q *BaseQuery) compile(dialect Dialect) ([]string, squirrel.SelectBuilder) {
 	columns := q.selectedColumns()
 	var (
 		qualifiedColumns = make([]string, len(columns))
@@ -271,23 +461,57 @@ func (q *BaseQuery) compile() ([]string, squirrel.SelectBuilder) {
 		qualifiedColumns[i] = columns[i].QualifiedName(q.schema)
 		columnNames[i] = columns[i].String()
 	}
-	return columnNames, q.builder.Columns(
+
+	schema := withDialect(q.schema, dialect)
+	builder := q.builder.PlaceholderFormat(dialect.PlaceholderFormat())
+	for _, cond := range q.where {
+		builder = builder.Where(cond(schema))
+	}
+
+	for _, cond := range q.having {
+		builder = builder.Having(cond(schema))
+	}
+
+	if col := q.schema.softDeleteColumn(); col != nil {
+		switch q.deleted {
+		case withoutDeleted:
+			builder = builder.Where(Eq(col, nil)(schema))
+		case onlyDeleted:
+			builder = builder.Where(Not(Eq(col, nil))(schema))
+		}
+	}
+
+	if q.cursor != nil && len(q.cursor.values) == len(q.order) {
+		cond := keysetCondition(q.order, q.cursor.values, q.cursorBefore)
+		builder = builder.Where(cond(schema))
+	}
+
+	for _, o := range q.order {
+		builder = builder.OrderByClause(o.clause(schema))
+	}
+
+	if lock := q.lockClause(schema); lock != nil {
+		builder = builder.SuffixExpr(lock)
+	}
+
+	return columnNames, builder.Columns(
 		append(qualifiedColumns, q.relationColumns...)...,
 	)
 }
 
-// String returns the SQL generated by the query. If the query is malformed,
-// it will return an empty string, as errors compiling the SQL are ignored.
+// String returns the SQL generated by the query for PostgreSQL. If the query
+// is malformed, it will return an empty string, as errors compiling the SQL
+// are ignored.
 func (q *BaseQuery) String() string {
-	_, builder := q.compile()
+	_, builder := q.compile(PostgreSQL)
 	sql, _, _ := builder.ToSql()
 	return sql
 }
 
-// ToSql returns the SQL generated by the query, the query arguments, and
-// any error returned during the compile process.
+// ToSql returns the SQL generated by the query for PostgreSQL, the query
+// arguments, and any error returned during the compile process.
 func (q *BaseQuery) ToSql() (string, []interface{}, error) {
-	_, builder := q.compile()
+	_, builder := q.compile(PostgreSQL)
 	return builder.ToSql()
 }
 
@@ -296,6 +520,9 @@ type ColumnOrder interface {
 	// ToSql returns the SQL representation of the column with its order.
 	ToSql(Schema) string
 	isColumnOrder()
+	clause(Schema) ToSqler
+	column() SchemaField
+	isDescending() bool
 }
 
 type colOrder struct {
@@ -307,7 +534,10 @@ type colOrder struct {
 func (o *colOrder) ToSql(schema Schema) string {
 	return fmt.Sprintf("%s %s", o.col.QualifiedName(schema), o.order)
 }
-func (colOrder) isColumnOrder() {}
+func (colOrder) isColumnOrder()                 {}
+func (o *colOrder) clause(schema Schema) ToSqler { return squirrel.Expr(o.ToSql(schema)) }
+func (o *colOrder) column() SchemaField         { return o.col }
+func (o *colOrder) isDescending() bool          { return o.order == desc }
 
 const (
 	asc  = "ASC"
@@ -323,3 +553,45 @@ func Asc(col SchemaField) ColumnOrder {
 func Desc(col SchemaField) ColumnOrder {
 	return &colOrder{desc, col}
 }
+
+type rankOrder struct {
+	col    SchemaField
+	query  TextQuery
+	config string
+}
+
+// ToSql returns the SQL representation of the rank with its order, with a
+// placeholder for the text of the query.
+func (o *rankOrder) ToSql(schema Schema) string {
+	return fmt.Sprintf(
+		"ts_rank(%s, %s) %s",
+		tsvector(o.col, schema, o.config),
+		o.query.sql(o.config),
+		desc,
+	)
+}
+func (rankOrder) isColumnOrder() {}
+
+// clause returns the rank with its order in the dialect of the given schema,
+// or an error if the dialect does not support full-text search.
+func (o *rankOrder) clause(schema Schema) ToSqler {
+	rank := dialectOp(
+		schema, "ByRank",
+		tsvector(o.col, schema, o.config), o.query.sql(o.config),
+		o.query.text,
+	)
+	return squirrel.ConcatExpr(rank, " "+desc)
+}
+
+func (o *rankOrder) column() SchemaField { return o.col }
+func (o *rankOrder) isDescending() bool  { return true }
+
+// ByRank returns an order by the rank of `col` for the given full-text search
+// query, using the given text search configuration, so that the most relevant
+// records come first. It is meant to be used along with the Matches operator
+// with the same arguments. Queries ordered by rank can not use cursors.
+//   q.Where(Matches(Schema.Post.Body, PlainQuery("fat rats"), "english"))
+//   q.Order(ByRank(Schema.Post.Body, PlainQuery("fat rats"), "english"))
+func ByRank(col SchemaField, query TextQuery, config string) ColumnOrder {
+	return &rankOrder{col, query, config}
+}
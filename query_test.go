@@ -92,6 +92,101 @@ func (s *QuerySuite) TestToSql() {
 	s.Equal(err, nil)
 }
 
+func (s *QuerySuite) TestGroupByHaving() {
+	s.q.Select(f("age"))
+	s.q.GroupBy(f("age"))
+	s.q.GroupBy(f("age"))
+	s.q.Having(Gt(CountAll(), 1))
+	s.q.Having(Lt(Avg(f("id")), 10))
+	s.q.Order(Desc(Sum(f("id"))))
+	sql, args, err := s.q.ToSql()
+	s.NoError(err)
+	s.Equal("SELECT __model.age FROM model __model GROUP BY __model.age HAVING COUNT(*) > $1 AND AVG(__model.id) < $2 ORDER BY SUM(__model.id) DESC", sql)
+	s.Equal([]interface{}{1, 10}, args)
+	s.Equal([]SchemaField{f("age")}, s.q.getGroupBy())
+	s.Equal([]SchemaField{f("age")}, s.q.Copy().getGroupBy())
+}
+
+func (s *QuerySuite) TestSoftDeletes() {
+	q := NewBaseQuery(softDeleteModelSchema)
+	q.Where(Eq(f("name"), "foo"))
+	s.Equal("SELECT __model.id, __model.name, __model.deleted_at FROM model __model WHERE __model.name = $1 AND __model.deleted_at IS NULL", q.String())
+
+	q.OnlyDeleted()
+	s.Equal("SELECT __model.id, __model.name, __model.deleted_at FROM model __model WHERE __model.name = $1 AND NOT (__model.deleted_at IS NULL)", q.String())
+	s.Equal(q.String(), q.Copy().String())
+
+	q.WithDeleted()
+	s.Equal("SELECT __model.id, __model.name, __model.deleted_at FROM model __model WHERE __model.name = $1", q.String())
+
+	s.q.OnlyDeleted()
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model", s.q.String())
+}
+
+func (s *QuerySuite) TestLocking() {
+	s.False(s.q.isLocking())
+
+	s.q.SkipLocked()
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model", s.q.String())
+
+	s.q.ForUpdate()
+	s.True(s.q.isLocking())
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model FOR UPDATE SKIP LOCKED", s.q.String())
+
+	s.q.ForShare()
+	s.q.NoWait()
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model FOR SHARE NOWAIT", s.q.String())
+	s.Equal(s.q.String(), s.q.Copy().String())
+
+	q := NewBaseQuery(ModelSchema)
+	q.ForUpdate()
+	s.Nil(q.AddRelation(RelSchema, "rel", OneToOne, nil))
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age, __rel_rel.id, __rel_rel.model_id, __rel_rel.foo FROM model __model LEFT JOIN rel __rel_rel ON (__rel_rel.model_id = __model.id) FOR UPDATE OF __model", q.String())
+}
+
+func (s *QuerySuite) TestAfterBefore() {
+	cursor, err := NewCursor("foo", 2)
+	s.NoError(err)
+
+	s.q.Order(Asc(f("name")))
+	s.q.After(cursor)
+	s.Equal(ErrCursorMismatch, s.q.validate())
+
+	s.q.Order(Desc(f("id")))
+	s.NoError(s.q.validate())
+	s.Equal([]ColumnOrder{Asc(f("name")), Desc(f("id"))}, s.q.getOrder())
+
+	sql, args, err := s.q.ToSql()
+	s.NoError(err)
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model WHERE (__model.name > $1 OR (__model.name = $2 AND __model.id < $3)) ORDER BY __model.name ASC, __model.id DESC", sql)
+	s.Equal([]interface{}{"foo", "foo", int64(2)}, args)
+	s.Equal(sql, s.q.Copy().String())
+
+	s.q.Before(cursor)
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model WHERE (__model.name < $1 OR (__model.name = $2 AND __model.id > $3)) ORDER BY __model.name ASC, __model.id DESC", s.q.String())
+}
+
+func (s *QuerySuite) TestFullTextSearch() {
+	query := PlainQuery("fat rats")
+	s.q.Where(Matches(f("name"), query, "english"))
+	s.q.Order(ByRank(f("name"), query, "english"), Asc(f("id")))
+
+	sql, args, err := s.q.ToSql()
+	s.NoError(err)
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model WHERE to_tsvector('english', __model.name) @@ plainto_tsquery('english', $1) ORDER BY ts_rank(to_tsvector('english', __model.name), plainto_tsquery('english', $2)) DESC, __model.id ASC", sql)
+	s.Equal([]interface{}{"fat rats", "fat rats"}, args)
+	s.Equal(sql, s.q.Copy().String())
+
+	cursor, err := NewCursor(1, 2)
+	s.NoError(err)
+	s.q.After(cursor)
+	s.Equal(ErrRankCursor, s.q.validate())
+
+	_, err = newRecordCursor(newModel("foo", "bar", 1), s.q.getOrder())
+	s.Equal(ErrRankCursor, err)
+	s.False(isKeysetOrder(ModelSchema, s.q.getOrder()))
+}
+
 func (s *QuerySuite) TestAddRelation() {
 	s.Nil(s.q.AddRelation(RelSchema, "rel", OneToOne, nil))
 	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age, __rel_rel.id, __rel_rel.model_id, __rel_rel.foo FROM model __model LEFT JOIN rel __rel_rel ON (__rel_rel.model_id = __model.id)", s.q.String())
@@ -107,12 +202,18 @@ func (s *QuerySuite) TestAddRelation_ManyToMany() {
 	s.Equal(ErrManyToManyNotSupported, err)
 }
 
+func (s *QuerySuite) TestAddRelation_ManyToManyThrough() {
+	s.Nil(s.q.AddRelation(RelSchema, "rels_through", ManyToMany, nil))
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age FROM model __model", s.q.String())
+	s.Len(s.q.getRelationships(), 1)
+}
+
 func (s *QuerySuite) TestAddRelation_FKNotFound() {
 	s.Error(s.q.AddRelation(RelSchema, "fooo", OneToOne, nil))
 }
 
 func (s *QuerySuite) assertSql(sql string) {
-	_, builder := s.q.compile()
+	_, builder := s.q.compile(PostgreSQL)
 	result, _, err := builder.ToSql()
 	s.Nil(err)
 	s.Equal(sql, result)
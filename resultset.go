@@ -17,6 +17,9 @@ type ResultSet interface {
 	Next() bool
 	// Get returns the next record of the given schema.
 	Get(Schema) (Record, error)
+	// Cursor returns the cursor of the last record retrieved, which can be
+	// used to retrieve the records after or before it.
+	Cursor() (*Cursor, error)
 	io.Closer
 }
 
@@ -34,6 +37,8 @@ type BaseResultSet struct {
 	relationships []Relationship
 	columns       []string
 	readOnly      bool
+	order         []ColumnOrder
+	last          Record
 	*sql.Rows
 }
 
@@ -42,10 +47,10 @@ type BaseResultSet struct {
 // equal to the ones in the query that produced the rows.
 func NewResultSet(rows *sql.Rows, readOnly bool, relationships []Relationship, columns ...string) *BaseResultSet {
 	return &BaseResultSet{
-		relationships,
-		columns,
-		readOnly,
-		rows,
+		relationships: relationships,
+		columns:       columns,
+		readOnly:      readOnly,
+		Rows:          rows,
 	}
 }
 
@@ -110,9 +115,16 @@ func (rs *BaseResultSet) Scan(record Record) error {
 
 	record.setWritable(!rs.readOnly)
 	record.setPersisted()
+	rs.last = record
 	return nil
 }
 
+// Cursor returns the cursor of the last scanned record. It is only available
+// for result sets of queries with an order.
+func (rs *BaseResultSet) Cursor() (*Cursor, error) {
+	return newRecordCursor(rs.last, rs.order)
+}
+
 // RowScan copies the columns in the current row into the values pointed at by
 // dest. The number of values in dest must be the same as the number of columns
 // selected in the query.
@@ -140,6 +152,8 @@ type BatchingResultSet struct {
 	runner  *batchQueryRunner
 	last    Record
 	lastErr error
+	// cursor is the last record retrieved.
+	cursor Record
 }
 
 // Next advances the internal index of the fetched records in one.
@@ -151,6 +165,10 @@ func (rs *BatchingResultSet) Next() bool {
 		return false
 	}
 
+	if rs.last != nil {
+		rs.cursor = rs.last
+	}
+
 	return true
 }
 
@@ -168,6 +186,12 @@ func (rs *BatchingResultSet) Close() error {
 	return nil
 }
 
+// Cursor returns the cursor of the last record retrieved. It is only
+// available for result sets of queries with an order.
+func (rs *BatchingResultSet) Cursor() (*Cursor, error) {
+	return newRecordCursor(rs.cursor, rs.runner.q.getOrder())
+}
+
 // RawScan will always throw an error, as this is not a supported operation of
 // a batching result set.
 func (rs *BatchingResultSet) RawScan(_ ...interface{}) error {
@@ -26,6 +26,9 @@ type Schema interface {
 	// New creates a new record with the given schema.
 	New() Record
 	isPrimaryKeyAutoIncrementable() bool
+	versionColumn() SchemaField
+	softDeleteColumn() SchemaField
+	isNotNull(SchemaField) bool
 }
 
 // BaseSchema is the basic implementation of Schema.
@@ -37,6 +40,9 @@ type BaseSchema struct {
 	columns     []SchemaField
 	constructor RecordConstructor
 	autoIncr    bool
+	version     SchemaField
+	softDelete  SchemaField
+	notNull     []SchemaField
 }
 
 // RecordConstructor is a function that creates a record.
@@ -71,6 +77,48 @@ func (s *BaseSchema) New() Record {
 	return s.constructor()
 }
 func (s *BaseSchema) isPrimaryKeyAutoIncrementable() bool { return s.autoIncr }
+func (s *BaseSchema) versionColumn() SchemaField          { return s.version }
+func (s *BaseSchema) softDeleteColumn() SchemaField       { return s.softDelete }
+func (s *BaseSchema) isNotNull(col SchemaField) bool {
+	if col.String() == s.id.String() {
+		return true
+	}
+
+	for _, c := range s.notNull {
+		if c.String() == col.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// WithVersion sets the given column as the version of the records of this
+// schema and returns the schema. When a version column is set, updates will
+// only succeed if the version of the record matches the one in the database.
+// The column must be an integer.
+func (s *BaseSchema) WithVersion(col SchemaField) *BaseSchema {
+	s.version = col
+	return s
+}
+
+// WithNotNull sets the given columns as the ones that can not be null and
+// returns the schema. Queries with one to many or many to many relationships
+// are only retrieved in batches using the values of the columns they are
+// ordered by if none of them can be null. The primary key is never null.
+func (s *BaseSchema) WithNotNull(cols ...SchemaField) *BaseSchema {
+	s.notNull = append(s.notNull, cols...)
+	return s
+}
+
+// WithSoftDelete sets the given column as the deletion time of the records of
+// this schema and returns the schema. When a soft delete column is set,
+// deleting a record sets its deletion time instead of removing the row, and
+// queries exclude the deleted rows unless told otherwise.
+// The column must be a nullable timestamp.
+func (s *BaseSchema) WithSoftDelete(col SchemaField) *BaseSchema {
+	s.softDelete = col
+	return s
+}
 
 type aliasSchema struct {
 	*BaseSchema
@@ -118,16 +166,47 @@ func (f *BaseSchemaField) QualifiedName(schema Schema) string {
 	return f.name
 }
 
+// TSVectorSchemaField is a schema field of a tsvector column, which holds a
+// document already preprocessed for full-text search.
+type TSVectorSchemaField struct {
+	*BaseSchemaField
+}
+
+// NewTSVectorSchemaField creates a new schema field of a tsvector column with
+// the given name.
+func NewTSVectorSchemaField(name string) SchemaField {
+	return &TSVectorSchemaField{&BaseSchemaField{name}}
+}
+
 // ForeignKey contains the schema field of the foreign key and if it is an
 // inverse foreign key or not.
+// Foreign keys of many to many relationships also contain the join table
+// between both sides of the relationship. In that case, the foreign key is the
+// column of the join table referencing the record that holds the relationship.
 type ForeignKey struct {
 	*BaseSchemaField
 	Inverse bool
+	// Through is the join table of a many to many relationship.
+	Through string
+	// ThroughKey is the column of the join table referencing the related
+	// records of a many to many relationship.
+	ThroughKey string
 }
 
 // NewForeignKey creates a new Foreign key with the given name.
 func NewForeignKey(name string, inverse bool) *ForeignKey {
-	return &ForeignKey{&BaseSchemaField{name}, inverse}
+	return &ForeignKey{BaseSchemaField: &BaseSchemaField{name}, Inverse: inverse}
+}
+
+// NewThroughForeignKey creates a new foreign key for a many to many
+// relationship with the given name, join table and the column of the join table
+// that references the related records.
+func NewThroughForeignKey(name, through, throughKey string) *ForeignKey {
+	return &ForeignKey{
+		BaseSchemaField: &BaseSchemaField{name},
+		Through:         through,
+		ThroughKey:      throughKey,
+	}
 }
 
 // JSONSchemaKey is a SchemaField that represents a key in a JSON object.
@@ -240,8 +319,7 @@ const (
 	// in another table.
 	OneToMany
 	// ManyToMany is a relationship between many records on both sides of the
-	// relationship.
-	// NOTE: It is not supported yet.
+	// relationship through a join table.
 	ManyToMany
 )
 
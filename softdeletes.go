@@ -0,0 +1,27 @@
+package kallax
+
+import "time"
+
+// SoftDeletes contains the date in which the model was deleted, if it was.
+// Models with soft deletes are not removed from the database when they are
+// deleted, their deletion time is set instead, and queries exclude them by
+// default. It is intended to be embedded in the model.
+//
+//	type MyModel struct {
+//		kallax.Model
+//		kallax.SoftDeletes
+//		Foo string
+//	}
+//
+// Any other *time.Time field of the model can be used as the deletion time
+// using the `softdelete:"true"` struct tag instead.
+type SoftDeletes struct {
+	// DeletedAt is the time where the object was deleted, or nil if it was
+	// not deleted.
+	DeletedAt *time.Time `softdelete:"true"`
+}
+
+// IsDeleted reports whether the model has been soft deleted.
+func (s *SoftDeletes) IsDeleted() bool {
+	return s.DeletedAt != nil
+}
@@ -2,14 +2,21 @@ package kallax
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/lann/builder"
+	"github.com/lib/pq"
+	"gopkg.in/src-d/go-kallax.v1/types"
 )
 
 var (
@@ -22,6 +29,13 @@ var (
 	// ErrNoRowUpdate is returned when an update operation does not affect any
 	// rows, meaning the model being updated does not exist.
 	ErrNoRowUpdate = errors.New("kallax: update affected no rows")
+	// ErrStaleRecord is returned when a versioned record is updated, but its
+	// version does not match the one in the database, meaning the record was
+	// updated or deleted by someone else since it was retrieved.
+	ErrStaleRecord = errors.New("kallax: record is stale, it was modified since it was retrieved")
+	// ErrNoSoftDelete is returned when a record whose schema has no soft
+	// delete column is restored.
+	ErrNoSoftDelete = errors.New("kallax: schema has no soft delete column")
 	// ErrNotWritable is returned when a record is not writable.
 	ErrNotWritable = errors.New("kallax: record is not writable")
 	// ErrStop can be returned inside a ForEach callback to stop iteration.
@@ -37,6 +51,18 @@ var (
 	// ErrNoColumns is an error returned when the user tries to insert a model
 	// with no other columns than the autoincrementable primary key.
 	ErrNoColumns = errors.New("kallax: your model does not have any column besides its autoincrementable primary key and cannot be inserted")
+	// ErrNoConflictColumns is returned when an upsert is performed without
+	// any conflict column.
+	ErrNoConflictColumns = errors.New("kallax: at least one conflict column is required to upsert a record")
+	// ErrNoValues is returned when an update is performed without any value
+	// to set.
+	ErrNoValues = errors.New("kallax: no values to update were given")
+	// ErrLimitNotSupported is returned when a query with limit or offset is
+	// used to update or delete rows.
+	ErrLimitNotSupported = errors.New("kallax: limit and offset can not be used to update or delete rows")
+	// ErrRelationshipsNotSupported is returned when a query with
+	// relationships is used to update or delete rows.
+	ErrRelationshipsNotSupported = errors.New("kallax: queries with relationships can not be used to update or delete rows")
 )
 
 // GenericStorer is a type that contains a generic store and has methods to
@@ -65,43 +91,66 @@ func defaultLogger(message string, args ...interface{}) {
 	log.Printf("%s, args: %v", message, args)
 }
 
+// dbProxy is a squirrel.DBProxyContext that can also execute queries with a
+// context.
+type dbProxy interface {
+	squirrel.DBProxyContext
+	squirrel.ExecerContext
+	squirrel.QueryerContext
+	squirrel.QueryRowerContext
+}
+
 // runnerLogger is a database runner that logs all SQL statements executed.
 type proxyLogger struct {
-	squirrel.DBProxyContext
+	dbProxy
 	logger LoggerFunc
 }
 
 func (p *proxyLogger) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return p.ExecContext(context.Background(), query, args...)
+}
+
+func (p *proxyLogger) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
 	start := time.Now()
-	result, err := p.DBProxyContext.Exec(query, args...)
+	result, err := p.dbProxy.ExecContext(ctx, query, args...)
 	p.logger(fmt.Sprintf("kallax: Exec: (%v) %s", time.Since(start), query), args...)
 	return result, err
 }
 
 func (p *proxyLogger) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return p.QueryContext(context.Background(), query, args...)
+}
+
+func (p *proxyLogger) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	start := time.Now()
-	rows, err := p.DBProxyContext.Query(query, args...)
+	rows, err := p.dbProxy.QueryContext(ctx, query, args...)
 	p.logger(fmt.Sprintf("kallax: Query: (%v) %s", time.Since(start), query), args...)
 	return rows, err
 }
 
 func (p *proxyLogger) QueryRow(query string, args ...interface{}) squirrel.RowScanner {
+	return p.QueryRowContext(context.Background(), query, args...)
+}
+
+func (p *proxyLogger) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
 	start := time.Now()
-	rowScanner := p.DBProxyContext.QueryRow(query, args...)
+	rowScanner := p.dbProxy.QueryRowContext(ctx, query, args...)
 	p.logger(fmt.Sprintf("kallax: QueryRow: (%v) %s", time.Since(start), query), args...)
 	return rowScanner
 }
 
 func (p *proxyLogger) Prepare(query string) (*sql.Stmt, error) {
+	return p.PrepareContext(context.Background(), query)
+}
+
+func (p *proxyLogger) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
 	//If chained runner is a proxy, run Prepare(). Otherwise, noop.
 	start := time.Now()
-	statement, err := p.DBProxyContext.Prepare(query)
+	statement, err := p.dbProxy.PrepareContext(ctx, query)
 	p.logger(fmt.Sprintf("kallax: Prepare: (%v) %s", time.Since(start), query))
 	return statement, err
 }
 
-// PrepareContext will not be logged
-
 // dbRunner is a copypaste from squirrel.dbRunner, used to make sql.DB implement squirrel.QueryRower.
 // squirrel will silently fail and return nil if BaseRunner(s) supplied to RunWith don't implement QueryRower, so
 // it has been copied there to avoid that.
@@ -114,6 +163,10 @@ func (r *dbRunner) QueryRow(query string, args ...interface{}) squirrel.RowScann
 	return r.DB.QueryRow(query, args...)
 }
 
+func (r *dbRunner) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	return r.DB.QueryRowContext(ctx, query, args...)
+}
+
 // txRunner does the analogous for sql.Tx
 type txRunner struct {
 	*sql.Tx
@@ -123,20 +176,30 @@ func (r *txRunner) QueryRow(query string, args ...interface{}) squirrel.RowScann
 	return r.Tx.QueryRow(query, args...)
 }
 
+func (r *txRunner) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	return r.Tx.QueryRowContext(ctx, query, args...)
+}
+
 // Store is a structure capable of retrieving records from a concrete table in
 // the database.
 type Store struct {
-	db        squirrel.DBProxyContext
-	runner    squirrel.DBProxyContext
+	db        dbProxy
+	runner    dbProxy
 	useCacher bool
 	logger    LoggerFunc
+	// canCopy reports whether the database driver supports COPY FROM STDIN.
+	canCopy bool
+	dialect Dialect
 }
 
-// NewStore returns a new Store instance.
+// NewStore returns a new Store instance, which uses the PostgreSQL dialect.
 func NewStore(db *sql.DB) *Store {
+	_, canCopy := db.Driver().(*pq.Driver)
 	return (&Store{
 		db:        &dbRunner{db},
 		useCacher: true,
+		canCopy:   canCopy,
+		dialect:   PostgreSQL,
 	}).init()
 }
 
@@ -145,11 +208,11 @@ func (s *Store) init() *Store {
 	s.runner = s.db
 
 	if s.useCacher {
-		s.runner = squirrel.NewStmtCacher(s.db)
+		s.runner = squirrel.NewStmtCache(s.db)
 	}
 
 	if s.logger != nil {
-		s.runner = &proxyLogger{logger: s.logger, DBProxyContext: s.runner}
+		s.runner = &proxyLogger{logger: s.logger, dbProxy: s.runner}
 	}
 
 	return s
@@ -168,6 +231,8 @@ func (s *Store) DebugWith(logger LoggerFunc) *Store {
 		db:        s.db,
 		useCacher: s.useCacher,
 		logger:    logger,
+		canCopy:   s.canCopy,
+		dialect:   s.dialect,
 	}).init()
 }
 
@@ -177,12 +242,33 @@ func (s *Store) DisableCacher() *Store {
 		db:        s.db,
 		logger:    s.logger,
 		useCacher: false,
+		canCopy:   s.canCopy,
+		dialect:   s.dialect,
+	}).init()
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+//   store := kallax.NewStore(db).WithDialect(kallax.SQLite)
+func (s *Store) WithDialect(dialect Dialect) *Store {
+	return (&Store{
+		db:        s.db,
+		logger:    s.logger,
+		useCacher: s.useCacher,
+		canCopy:   s.canCopy,
+		dialect:   dialect,
 	}).init()
 }
 
 // Insert insert the given record in the table, returns error if no-new
 // record is given. The record id is set if it's empty.
 func (s *Store) Insert(schema Schema, record Record) error {
+	return s.InsertContext(context.Background(), schema, record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the query.
+func (s *Store) InsertContext(ctx context.Context, schema Schema, record Record) error {
 	if record.IsPersisted() {
 		return ErrNonNewDocument
 	}
@@ -217,7 +303,7 @@ func (s *Store) Insert(schema Schema, record Record) error {
 			valBuf.WriteRune(',')
 		}
 		colBuf.WriteString(col)
-		valBuf.WriteString(fmt.Sprintf("$%d", i+1))
+		valBuf.WriteString(s.dialect.Placeholder(i + 1))
 	}
 
 	var query bytes.Buffer
@@ -238,7 +324,7 @@ func (s *Store) Insert(schema Schema, record Record) error {
 
 		query.WriteString(fmt.Sprintf(" RETURNING %s", schema.ID().String()))
 		//err = s.runner.QueryRow(query.String(), values...).Scan(pk)
-		rows, err := s.runner.Query(query.String(), values...)
+		rows, err := s.runner.QueryContext(ctx, query.String(), values...)
 		if err != nil {
 			return err
 		}
@@ -250,7 +336,7 @@ func (s *Store) Insert(schema Schema, record Record) error {
 			}
 		}
 	} else {
-		_, err = s.runner.Exec(query.String(), values...)
+		_, err = s.runner.ExecContext(ctx, query.String(), values...)
 	}
 
 	if err != nil {
@@ -262,11 +348,344 @@ func (s *Store) Insert(schema Schema, record Record) error {
 	return nil
 }
 
+// maxInsertParams is the maximum number of parameters PostgreSQL accepts in
+// a single statement.
+const maxInsertParams = 65535
+
+// InsertMany inserts all the given records in the table using as few queries
+// as possible. Records are inserted in batches of multi-row INSERT statements
+// or, when the driver supports it, using COPY FROM STDIN. The values of
+// autoincrementable primary keys are reserved from their sequence before
+// inserting the records or, if the dialect or the column do not support it,
+// records are inserted one by one. All records are required to be new. The
+// insert and save events of the records are run, and the whole operation
+// happens inside a transaction, so either all records are inserted or none of
+// them are.
+func (s *Store) InsertMany(schema Schema, records ...Record) error {
+	return s.InsertManyContext(context.Background(), schema, records...)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *Store) InsertManyContext(ctx context.Context, schema Schema, records ...Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	for _, r := range records {
+		if r.IsPersisted() {
+			return ErrNonNewDocument
+		}
+	}
+
+	cols := ColumnNames(schema.Columns())
+	if schema.isPrimaryKeyAutoIncrementable() {
+		// ID is always the first field, so it's safe to slice here
+		cols = cols[1:]
+	}
+
+	if len(cols) == 0 {
+		return ErrNoColumns
+	}
+
+	return s.TransactionContext(ctx, nil, func(s *Store) error {
+		rows := make([]insertRow, len(records))
+		for i, r := range records {
+			if err := ApplyBeforeEvents(r); err != nil {
+				return err
+			}
+
+			values, rowCols, err := RecordValues(r, cols...)
+			if err != nil {
+				return err
+			}
+
+			virtualCols, virtualColValues := virtualColumns(r, rowCols)
+			rows[i] = insertRow{
+				record: r,
+				cols:   append(rowCols, virtualCols...),
+				values: append(values, virtualColValues...),
+			}
+		}
+
+		// the reserved IDs are added to the rows before inserting them
+		var idCols int
+		if schema.isPrimaryKeyAutoIncrementable() {
+			idCols = 1
+		}
+
+		for _, batch := range insertBatches(rows, idCols) {
+			if err := s.insertRows(ctx, schema, batch); err != nil {
+				return err
+			}
+		}
+
+		for _, r := range records {
+			r.setWritable(true)
+			r.setPersisted()
+			if err := ApplyAfterEvents(r, false); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// insertRow is a record to be inserted along with its columns and values.
+type insertRow struct {
+	record Record
+	cols   []string
+	values []interface{}
+}
+
+// insertBatches splits the given rows in batches of consecutive rows with the
+// same columns that fit in a single statement, counting the given number of
+// columns that are added to every row before inserting it.
+func insertBatches(rows []insertRow, extraCols int) [][]insertRow {
+	var (
+		batches [][]insertRow
+		start   int
+	)
+	for i := 1; i <= len(rows); i++ {
+		if i < len(rows) &&
+			equalStrings(rows[start].cols, rows[i].cols) &&
+			(i-start+1)*(len(rows[i].cols)+extraCols) <= maxInsertParams {
+			continue
+		}
+
+		batches = append(batches, rows[start:i])
+		start = i
+	}
+	return batches
+}
+
+// insertRows inserts the given rows, which must all have the same columns,
+// with a single statement.
+func (s *Store) insertRows(ctx context.Context, schema Schema, rows []insertRow) error {
+	if schema.isPrimaryKeyAutoIncrementable() {
+		reserved, err := s.reserveIDs(ctx, schema, rows)
+		if err != nil {
+			return err
+		}
+
+		if !reserved {
+			return s.insertEach(ctx, schema, rows)
+		}
+	}
+
+	if s.canCopy {
+		if values, ok := copyValues(rows); ok {
+			return s.copyRows(ctx, schema, rows[0].cols, values)
+		}
+	}
+
+	cols := rows[0].cols
+	values := make([]interface{}, 0, len(rows)*len(cols))
+
+	var query bytes.Buffer
+	query.WriteString("INSERT INTO ")
+	query.WriteString(schema.Table())
+	query.WriteString(" (")
+	query.WriteString(strings.Join(cols, ","))
+	query.WriteString(") VALUES ")
+	for i, row := range rows {
+		if i != 0 {
+			query.WriteRune(',')
+		}
+
+		query.WriteRune('(')
+		for j := range cols {
+			if j != 0 {
+				query.WriteRune(',')
+			}
+			query.WriteString(s.dialect.Placeholder(len(values) + j + 1))
+		}
+		query.WriteRune(')')
+		values = append(values, row.values...)
+	}
+
+	result, err := s.runner.ExecContext(ctx, query.String(), values...)
+	if err != nil {
+		return err
+	}
+
+	return checkInserted(result, len(rows))
+}
+
+// checkInserted returns an error if the number of rows inserted by a
+// statement is not the expected one.
+func checkInserted(result sql.Result, expected int) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n != int64(expected) {
+		return fmt.Errorf("kallax: %d rows were inserted instead of %d", n, expected)
+	}
+	return nil
+}
+
+// reserveIDs sets the autoincrementable primary keys of the records of the
+// given rows to values reserved from their sequence, and adds them to the
+// columns of the rows, so each record gets the ID it is inserted with
+// instead of relying on the order of the rows returned by the database.
+// Returns false if the dialect or the column do not support it.
+func (s *Store) reserveIDs(ctx context.Context, schema Schema, rows []insertRow) (bool, error) {
+	query, ok := s.dialect.ReserveIDs()
+	if !ok {
+		return false, nil
+	}
+
+	id := schema.ID().String()
+	result, err := s.runner.QueryContext(ctx, query, schema.Table(), id, len(rows))
+	if err != nil {
+		return false, err
+	}
+	defer result.Close()
+
+	var n int
+	for ; result.Next(); n++ {
+		if n >= len(rows) {
+			return false, fmt.Errorf("kallax: %d ids were reserved instead of %d", n+1, len(rows))
+		}
+
+		pk, err := rows[n].record.ColumnAddress(id)
+		if err != nil {
+			return false, err
+		}
+
+		if err := result.Scan(pk); err != nil {
+			return false, err
+		}
+	}
+
+	if err := result.Err(); err != nil {
+		return false, err
+	}
+
+	if n == 0 {
+		return false, nil
+	} else if n != len(rows) {
+		return false, fmt.Errorf("kallax: %d ids were reserved instead of %d", n, len(rows))
+	}
+
+	for i, row := range rows {
+		v, err := row.record.Value(id)
+		if err != nil {
+			return false, err
+		}
+
+		rows[i].cols = append([]string{id}, row.cols...)
+		rows[i].values = append([]interface{}{v}, row.values...)
+	}
+
+	return true, nil
+}
+
+// insertEach inserts the given rows one by one, setting the autoincrementable
+// primary key of each record to the one returned for its row.
+func (s *Store) insertEach(ctx context.Context, schema Schema, rows []insertRow) error {
+	id := schema.ID().String()
+	for _, row := range rows {
+		var placeholders = make([]string, len(row.cols))
+		for i := range row.cols {
+			placeholders[i] = s.dialect.Placeholder(i + 1)
+		}
+
+		query := fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES (%s) RETURNING %s",
+			schema.Table(), strings.Join(row.cols, ","), strings.Join(placeholders, ","), id,
+		)
+
+		pk, err := row.record.ColumnAddress(id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.runner.QueryRowContext(ctx, query, row.values...).Scan(pk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyRows inserts the given values in the table using COPY FROM STDIN. The
+// store is required to be inside a transaction.
+func (s *Store) copyRows(ctx context.Context, schema Schema, cols []string, values [][]interface{}) error {
+	tx, ok := s.db.(*txRunner)
+	if !ok {
+		return fmt.Errorf("kallax: COPY can only be done inside a transaction")
+	}
+
+	var stmt string
+	if parts := strings.SplitN(schema.Table(), ".", 2); len(parts) == 2 {
+		stmt = pq.CopyInSchema(parts[0], parts[1], cols...)
+	} else {
+		stmt = pq.CopyIn(schema.Table(), cols...)
+	}
+
+	// COPY statements can not be reused, so they are never cached
+	var db dbProxy = tx
+	if s.logger != nil {
+		db = &proxyLogger{logger: s.logger, dbProxy: db}
+	}
+
+	copyStmt, err := db.PrepareContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	defer copyStmt.Close()
+
+	for _, v := range values {
+		if _, err := copyStmt.ExecContext(ctx, v...); err != nil {
+			return err
+		}
+	}
+
+	_, err = copyStmt.ExecContext(ctx)
+	return err
+}
+
+// copyValues converts the values of the given rows to values that can be
+// sent using COPY. Binary values are encoded as bytea by the driver, which is
+// wrong for columns such as JSON, so COPY can not be used if there is any.
+func copyValues(rows []insertRow) ([][]interface{}, bool) {
+	result := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		result[i] = make([]interface{}, len(row.values))
+		for j, v := range row.values {
+			v, err := driver.DefaultParameterConverter.ConvertValue(v)
+			if err != nil {
+				return nil, false
+			}
+
+			if _, ok := v.([]byte); ok {
+				return nil, false
+			}
+			result[i][j] = v
+		}
+	}
+
+	return result, true
+}
+
 // Update updates the given fields of a record in the table. All fields are
 // updated if no fields are provided. For an update to take place, the record is
 // required to have a non-empty ID and not to be a new record.
+// If the schema has a version column, the record is only updated if its
+// version matches the one in the database, and the version is incremented.
+// Otherwise, ErrStaleRecord is returned.
 // Returns the number of updated rows and an error, if any.
 func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64, error) {
+	return s.UpdateContext(context.Background(), schema, record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the query.
+func (s *Store) UpdateContext(ctx context.Context, schema Schema, record Record, cols ...SchemaField) (int64, error) {
 	if !record.IsWritable() {
 		return 0, ErrNotWritable
 	}
@@ -285,6 +704,26 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 
 	// remove the ID from there
 	columnNames := ColumnNames(cols)
+
+	versionCol := schema.versionColumn()
+	var version *recordVersion
+	if versionCol != nil {
+		var err error
+		version, err = newRecordVersion(record, versionCol)
+		if err != nil {
+			return 0, err
+		}
+
+		// the version is always updated, and only once
+		var names = make([]string, 0, len(columnNames))
+		for _, name := range columnNames {
+			if name != versionCol.String() {
+				names = append(names, name)
+			}
+		}
+		columnNames = names
+	}
+
 	values, columnNames, err := RecordValues(record, columnNames...)
 	if err != nil {
 		return 0, err
@@ -294,6 +733,11 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 	columnNames = append(columnNames, virtualCols...)
 	values = append(values, virtualColValues...)
 
+	if version != nil {
+		columnNames = append(columnNames, versionCol.String())
+		values = append(values, version.current+1)
+	}
+
 	var query bytes.Buffer
 	query.WriteString("UPDATE ")
 	query.WriteString(schema.Table())
@@ -304,14 +748,23 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 		}
 		query.WriteString(col)
 		query.WriteRune('=')
-		query.WriteString(fmt.Sprintf("$%d", i+1))
+		query.WriteString(s.dialect.Placeholder(i + 1))
 	}
 	query.WriteString(" WHERE ")
 	query.WriteString(schema.ID().String())
 	query.WriteRune('=')
-	query.WriteString(fmt.Sprintf("$%d", len(columnNames)+1))
+	query.WriteString(s.dialect.Placeholder(len(columnNames) + 1))
+	values = append(values, record.GetID())
 
-	result, err := s.runner.Exec(query.String(), append(values, record.GetID())...)
+	if version != nil {
+		query.WriteString(" AND ")
+		query.WriteString(versionCol.String())
+		query.WriteRune('=')
+		query.WriteString(s.dialect.Placeholder(len(values) + 1))
+		values = append(values, version.current)
+	}
+
+	result, err := s.runner.ExecContext(ctx, query.String(), values...)
 	if err != nil {
 		return 0, err
 	}
@@ -322,19 +775,32 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 	}
 
 	if cnt == 0 {
+		if version != nil {
+			return 0, ErrStaleRecord
+		}
 		return 0, ErrNoRowUpdate
 	}
 
+	if version != nil {
+		version.set(version.current + 1)
+	}
+
 	return cnt, nil
 }
 
 // Save inserts or updates the given record in the table.
 func (s *Store) Save(schema Schema, record Record) (updated bool, err error) {
+	return s.SaveContext(context.Background(), schema, record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// query.
+func (s *Store) SaveContext(ctx context.Context, schema Schema, record Record) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(schema, record)
+		return false, s.InsertContext(ctx, schema, record)
 	}
 
-	rowsUpdated, err := s.Update(schema, record)
+	rowsUpdated, err := s.UpdateContext(ctx, schema, record)
 	if err != nil {
 		return false, err
 	}
@@ -342,81 +808,622 @@ func (s *Store) Save(schema Schema, record Record) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
-// Delete removes the record from the table. A non-new record with non-empty
-// ID is required.
-func (s *Store) Delete(schema Schema, record Record) error {
-	if record.GetID().IsEmpty() {
-		return ErrEmptyID
-	}
-
-	var query bytes.Buffer
-	query.WriteString("DELETE FROM ")
-	query.WriteString(schema.Table())
-	query.WriteString(" WHERE ")
-	query.WriteString(schema.ID().String())
-	query.WriteString("=$1")
-
-	_, err := s.runner.Exec(query.String(), record.GetID())
-	return err
+// Upsert inserts the given record in the table or, if there is a conflict on
+// the given conflict columns, which need to have a unique constraint or index,
+// updates the given update columns of the existing row instead. If no update
+// columns are given, the existing row is left as is. In both cases, the record
+// is filled with the row stored in the database after the operation and
+// marked as persisted.
+func (s *Store) Upsert(schema Schema, record Record, conflictCols []SchemaField, updateCols ...SchemaField) error {
+	return s.UpsertContext(context.Background(), schema, record, conflictCols, updateCols...)
 }
 
-// RawQuery performs a raw SQL query with the given parameters and returns a
-// result set with the results.
-// WARNING: A result set created from a raw query can only be scanned using the
-// RawScan method of ResultSet, instead of Scan.
-func (s *Store) RawQuery(sql string, params ...interface{}) (ResultSet, error) {
-	rows, err := s.runner.Query(sql, params...)
-	if err != nil {
-		return nil, err
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *Store) UpsertContext(ctx context.Context, schema Schema, record Record, conflictCols []SchemaField, updateCols ...SchemaField) error {
+	if len(conflictCols) == 0 {
+		return ErrNoConflictColumns
 	}
 
-	return NewResultSet(rows, true, nil), nil
-}
-
-// RawExec executes a raw SQL query with the given parameters and returns
-// the number of affected rows.
-func (s *Store) RawExec(sql string, params ...interface{}) (int64, error) {
-	result, err := s.runner.Exec(sql, params...)
-	if err != nil {
-		return 0, err
+	cols := ColumnNames(schema.Columns())
+	if schema.isPrimaryKeyAutoIncrementable() && record.GetID().IsEmpty() {
+		// ID is always the first field, so it's safe to slice here
+		cols = cols[1:]
 	}
 
-	return result.RowsAffected()
-}
-
-// Find performs a query and returns a result set with the results.
-func (s *Store) Find(q Query) (ResultSet, error) {
-	rels := q.getRelationships()
-	if containsRelationshipOfType(rels, OneToMany) {
-		return NewBatchingResultSet(newBatchQueryRunner(q.Schema(), s.runner, q)), nil
+	if len(cols) == 0 {
+		return ErrNoColumns
 	}
 
-	columns, builder := q.compile()
-	if offset := q.GetOffset(); offset > 0 {
-		builder = builder.Offset(offset)
+	values, cols, err := RecordValues(record, cols...)
+	if err != nil {
+		return err
 	}
 
-	if limit := q.GetLimit(); limit > 0 {
-		builder = builder.Limit(limit)
-	}
+	virtualCols, virtualColValues := virtualColumns(record, cols)
+	cols = append(cols, virtualCols...)
+	values = append(values, virtualColValues...)
 
-	rows, err := builder.RunWith(s.runner).Query()
-	if err != nil {
-		return nil, err
+	var valBuf bytes.Buffer
+	for i := range cols {
+		if i != 0 {
+			valBuf.WriteRune(',')
+		}
+		valBuf.WriteString(s.dialect.Placeholder(i + 1))
 	}
 
-	return NewResultSet(
+	var query bytes.Buffer
+	query.WriteString("INSERT INTO ")
+	query.WriteString(schema.Table())
+	query.WriteString(" (")
+	query.WriteString(strings.Join(cols, ","))
+	query.WriteString(") VALUES (")
+	query.WriteString(valBuf.String())
+	query.WriteString(") ON CONFLICT (")
+	query.WriteString(strings.Join(ColumnNames(conflictCols), ","))
+	query.WriteString(")")
+
+	if len(updateCols) == 0 {
+		query.WriteString(" DO NOTHING")
+	} else {
+		versionCol := schema.versionColumn()
+		var sets []string
+		for _, col := range ColumnNames(updateCols) {
+			// the version is always incremented, and only once
+			if versionCol == nil || col != versionCol.String() {
+				sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", col, col))
+			}
+		}
+
+		if versionCol != nil {
+			sets = append(sets, fmt.Sprintf("%s = %s.%s + 1", versionCol, schema.Table(), versionCol))
+		}
+
+		query.WriteString(" DO UPDATE SET ")
+		query.WriteString(strings.Join(sets, ","))
+	}
+
+	columns := ColumnNames(schema.Columns())
+	query.WriteString(" RETURNING ")
+	query.WriteString(strings.Join(columns, ","))
+
+	rows, err := s.runner.QueryContext(ctx, query.String(), values...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	rs := NewResultSet(rows, false, nil, columns...)
+	if rs.Next() {
+		if err := rs.Scan(record); err != nil {
+			return err
+		}
+	} else if len(updateCols) == 0 {
+		// nothing is returned when a conflicting row is left as is, so it
+		// needs to be retrieved using the conflict columns
+		if err := s.reloadBy(ctx, schema, record, conflictCols); err != nil {
+			return err
+		}
+	} else {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return ErrNotFound
+	}
+
+	record.setWritable(true)
+	record.setPersisted()
+	return nil
+}
+
+// reloadBy refreshes the record with the row whose given columns have the
+// same values as the record.
+func (s *Store) reloadBy(ctx context.Context, schema Schema, record Record, cols []SchemaField) error {
+	q := NewBaseQuery(schema)
+	q.WithDeleted()
+	for _, col := range cols {
+		v, err := record.Value(col.String())
+		if err != nil {
+			return err
+		}
+		q.Where(Eq(col, v))
+	}
+	q.Limit(1)
+	columns, builder := q.compile(s.dialect)
+
+	rows, err := builder.RunWith(s.runner).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	rs := NewResultSet(rows, false, nil, columns...)
+	if !rs.Next() {
+		return ErrNotFound
+	}
+
+	return rs.Scan(record)
+}
+
+// Delete removes the record from the table. A non-new record with non-empty
+// ID is required. If the schema has a soft delete column, the row is not
+// removed, the deletion time is set instead, both in the database and in the
+// record. Deleting a record that was already soft deleted keeps its original
+// deletion time.
+func (s *Store) Delete(schema Schema, record Record) error {
+	return s.DeleteContext(context.Background(), schema, record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the query.
+func (s *Store) DeleteContext(ctx context.Context, schema Schema, record Record) error {
+	if schema.softDeleteColumn() != nil {
+		now := time.Now()
+		return s.setDeletedAt(ctx, schema, record, &now)
+	}
+
+	return s.ForceDeleteContext(ctx, schema, record)
+}
+
+// ForceDelete removes the record from the table, even if the schema has a soft
+// delete column. A non-new record with non-empty ID is required.
+func (s *Store) ForceDelete(schema Schema, record Record) error {
+	return s.ForceDeleteContext(context.Background(), schema, record)
+}
+
+// ForceDeleteContext is the same as ForceDelete, but the given context is
+// used to run the query.
+func (s *Store) ForceDeleteContext(ctx context.Context, schema Schema, record Record) error {
+	if record.GetID().IsEmpty() {
+		return ErrEmptyID
+	}
+
+	var query bytes.Buffer
+	query.WriteString("DELETE FROM ")
+	query.WriteString(schema.Table())
+	query.WriteString(" WHERE ")
+	query.WriteString(schema.ID().String())
+	query.WriteRune('=')
+	query.WriteString(s.dialect.Placeholder(1))
+
+	_, err := s.runner.ExecContext(ctx, query.String(), record.GetID())
+	return err
+}
+
+// Restore undoes the soft deletion of the record, both in the database and in
+// the record. The schema must have a soft delete column.
+func (s *Store) Restore(schema Schema, record Record) error {
+	return s.RestoreContext(context.Background(), schema, record)
+}
+
+// RestoreContext is the same as Restore, but the given context is used to run
+// the query.
+func (s *Store) RestoreContext(ctx context.Context, schema Schema, record Record) error {
+	if schema.softDeleteColumn() == nil {
+		return ErrNoSoftDelete
+	}
+
+	return s.setDeletedAt(ctx, schema, record, nil)
+}
+
+// setDeletedAt sets the deletion time of the record with the given schema, or
+// unsets it if it is nil. When setting it, only records that are not deleted
+// already are updated.
+func (s *Store) setDeletedAt(ctx context.Context, schema Schema, record Record, deletedAt *time.Time) error {
+	if record.GetID().IsEmpty() {
+		return ErrEmptyID
+	}
+
+	col := schema.softDeleteColumn()
+	addr, err := record.ColumnAddress(col.String())
+	if err != nil {
+		return err
+	}
+
+	scanner, ok := addr.(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("kallax: soft delete column %s must be a nullable timestamp, it is %T", col, addr)
+	}
+
+	var value interface{}
+	if deletedAt != nil {
+		value = *deletedAt
+	}
+
+	b := squirrel.StatementBuilder.
+		PlaceholderFormat(s.dialect.PlaceholderFormat()).
+		Update(schema.Table()).
+		Set(col.String(), value).
+		Where(squirrel.Eq{schema.ID().String(): record.GetID()})
+	if deletedAt != nil {
+		b = b.Where(squirrel.Eq{col.String(): nil})
+	}
+
+	result, err := b.RunWith(s.runner).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	cnt, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if cnt == 0 {
+		return nil
+	}
+
+	return scanner.Scan(value)
+}
+
+// UpdateWhere sets the given values to all the rows matching the conditions of
+// the given query. Returns the number of updated rows. Only the conditions
+// of the query are used, which can not have a limit or offset.
+// No events are run for the updated rows.
+func (s *Store) UpdateWhere(q Query, values map[SchemaField]interface{}) (int64, error) {
+	return s.UpdateWhereContext(context.Background(), q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is used
+// to run the query.
+func (s *Store) UpdateWhereContext(ctx context.Context, q Query, values map[SchemaField]interface{}) (int64, error) {
+	query, args, err := updateWhereSQL(s.dialect, q, values, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.execWhere(ctx, query, args)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns a result set
+// with the updated rows instead of the number of rows.
+func (s *Store) UpdateWhereReturning(q Query, values map[SchemaField]interface{}) (ResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *Store) UpdateWhereReturningContext(ctx context.Context, q Query, values map[SchemaField]interface{}) (ResultSet, error) {
+	columns := ColumnNames(q.Schema().Columns())
+	query, args, err := updateWhereSQL(s.dialect, q, values, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.runner.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResultSet(rows, false, nil, columns...), nil
+}
+
+// DeleteWhere removes all the rows matching the conditions of the given query.
+// Returns the number of removed rows. Only the conditions of the query are
+// used, which can not have a limit or offset. If the schema has a soft delete
+// column, the rows are not removed, their deletion time is set instead, like
+// Delete does.
+// No events are run for the removed rows.
+func (s *Store) DeleteWhere(q Query) (int64, error) {
+	return s.DeleteWhereContext(context.Background(), q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is used
+// to run the query.
+func (s *Store) DeleteWhereContext(ctx context.Context, q Query) (int64, error) {
+	query, args, err := deleteWhereSQL(s.dialect, q, time.Now(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.execWhere(ctx, query, args)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns a result set
+// with the removed rows instead of the number of rows.
+func (s *Store) DeleteWhereReturning(q Query) (ResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *Store) DeleteWhereReturningContext(ctx context.Context, q Query) (ResultSet, error) {
+	columns := ColumnNames(q.Schema().Columns())
+	query, args, err := deleteWhereSQL(s.dialect, q, time.Now(), columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.queryWhere(ctx, query, args, columns)
+}
+
+// ForceDeleteWhere removes all the rows matching the conditions of the given
+// query from the table, even if the schema has a soft delete column. Returns
+// the number of removed rows. Only the conditions of the query are used,
+// which can not have a limit or offset.
+// No events are run for the removed rows.
+func (s *Store) ForceDeleteWhere(q Query) (int64, error) {
+	return s.ForceDeleteWhereContext(context.Background(), q)
+}
+
+// ForceDeleteWhereContext is the same as ForceDeleteWhere, but the given
+// context is used to run the query.
+func (s *Store) ForceDeleteWhereContext(ctx context.Context, q Query) (int64, error) {
+	query, args, err := forceDeleteWhereSQL(s.dialect, q, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.execWhere(ctx, query, args)
+}
+
+// ForceDeleteWhereReturning is the same as ForceDeleteWhere, but it returns a
+// result set with the removed rows instead of the number of rows.
+func (s *Store) ForceDeleteWhereReturning(q Query) (ResultSet, error) {
+	return s.ForceDeleteWhereReturningContext(context.Background(), q)
+}
+
+// ForceDeleteWhereReturningContext is the same as ForceDeleteWhereReturning,
+// but the given context is used to run the query.
+func (s *Store) ForceDeleteWhereReturningContext(ctx context.Context, q Query) (ResultSet, error) {
+	columns := ColumnNames(q.Schema().Columns())
+	query, args, err := forceDeleteWhereSQL(s.dialect, q, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.queryWhere(ctx, query, args, columns)
+}
+
+// execWhere runs the given statement affecting the rows of a query and
+// returns the number of affected rows.
+func (s *Store) execWhere(ctx context.Context, query string, args []interface{}) (int64, error) {
+	result, err := s.runner.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// queryWhere runs the given statement removing the rows of a query and
+// returns a read-only result set with the removed rows.
+func (s *Store) queryWhere(ctx context.Context, query string, args []interface{}, columns []string) (ResultSet, error) {
+	rows, err := s.runner.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResultSet(rows, true, nil, columns...), nil
+}
+
+// updateWhereSQL returns the SQL and arguments, in the given dialect, of an
+// UPDATE statement setting the given values to the rows matching the query
+// conditions, returning the given columns, if any.
+func updateWhereSQL(dialect Dialect, q Query, values map[SchemaField]interface{}, returning []string) (string, []interface{}, error) {
+	if len(values) == 0 {
+		return "", nil, ErrNoValues
+	}
+
+	where, err := whereParts(dialect, q)
+	if err != nil {
+		return "", nil, err
+	}
+
+	schema := q.Schema()
+	versionCol := schema.versionColumn()
+
+	// columns are sorted so the same update always generates the same SQL
+	var cols = make([]string, 0, len(values))
+	var vals = make(map[string]interface{}, len(values))
+	for col, v := range values {
+		// the version is always incremented, and only once
+		if versionCol != nil && col.String() == versionCol.String() {
+			continue
+		}
+		cols = append(cols, col.String())
+		vals[col.String()] = v
+	}
+	sort.Strings(cols)
+
+	b := squirrel.StatementBuilder.
+		PlaceholderFormat(dialect.PlaceholderFormat()).
+		Update(schema.Table() + " AS " + schema.Alias())
+	for _, col := range cols {
+		b = b.Set(col, vals[col])
+	}
+
+	if versionCol != nil {
+		b = b.Set(versionCol.String(), squirrel.Expr(versionCol.String()+" + 1"))
+	}
+
+	for _, part := range where {
+		b = b.Where(part)
+	}
+
+	if len(returning) > 0 {
+		b = b.Suffix("RETURNING " + strings.Join(returning, ","))
+	}
+
+	return b.ToSql()
+}
+
+// deleteWhereSQL returns the SQL and arguments, in the given dialect, of a
+// statement deleting the rows matching the query conditions, returning the
+// given columns, if any. If the schema has a soft delete column, it is an
+// UPDATE setting the given deletion time to the rows that are not deleted
+// yet. Otherwise, it is the same as forceDeleteWhereSQL.
+func deleteWhereSQL(dialect Dialect, q Query, deletedAt time.Time, returning []string) (string, []interface{}, error) {
+	schema := q.Schema()
+	col := schema.softDeleteColumn()
+	if col == nil {
+		return forceDeleteWhereSQL(dialect, q, returning)
+	}
+
+	where, err := whereParts(dialect, q)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// already deleted rows keep their original deletion time
+	if !q.excludesDeleted() {
+		where = append(where, Eq(col, nil)(withDialect(schema, dialect)))
+	}
+
+	b := squirrel.StatementBuilder.
+		PlaceholderFormat(dialect.PlaceholderFormat()).
+		Update(schema.Table()+" AS "+schema.Alias()).
+		Set(col.String(), deletedAt)
+	for _, part := range where {
+		b = b.Where(part)
+	}
+
+	if len(returning) > 0 {
+		b = b.Suffix("RETURNING " + strings.Join(returning, ","))
+	}
+
+	return b.ToSql()
+}
+
+// forceDeleteWhereSQL returns the SQL and arguments, in the given dialect, of
+// a DELETE statement removing the rows matching the query conditions,
+// returning the given columns, if any.
+func forceDeleteWhereSQL(dialect Dialect, q Query, returning []string) (string, []interface{}, error) {
+	where, err := whereParts(dialect, q)
+	if err != nil {
+		return "", nil, err
+	}
+
+	schema := q.Schema()
+	b := squirrel.StatementBuilder.
+		PlaceholderFormat(dialect.PlaceholderFormat()).
+		Delete(schema.Table() + " AS " + schema.Alias())
+	for _, part := range where {
+		b = b.Where(part)
+	}
+
+	if len(returning) > 0 {
+		b = b.Suffix("RETURNING " + strings.Join(returning, ","))
+	}
+
+	return b.ToSql()
+}
+
+// whereParts returns the conditions of the given query, compiled for the
+// given dialect. The joins of the query would be lost, so queries with
+// relationships are not allowed.
+func whereParts(dialect Dialect, q Query) ([]squirrel.Sqlizer, error) {
+	if q.GetLimit() > 0 || q.GetOffset() > 0 {
+		return nil, ErrLimitNotSupported
+	}
+
+	if len(q.getRelationships()) > 0 {
+		return nil, ErrRelationshipsNotSupported
+	}
+
+	_, queryBuilder := q.compile(dialect)
+	parts, ok := builder.Get(queryBuilder, "WhereParts")
+	if !ok {
+		return nil, nil
+	}
+
+	return parts.([]squirrel.Sqlizer), nil
+}
+
+// RawQuery performs a raw SQL query with the given parameters and returns a
+// result set with the results.
+// WARNING: A result set created from a raw query can only be scanned using the
+// RawScan method of ResultSet, instead of Scan.
+func (s *Store) RawQuery(sql string, params ...interface{}) (ResultSet, error) {
+	return s.RawQueryContext(context.Background(), sql, params...)
+}
+
+// RawQueryContext is the same as RawQuery, but the given context is used to
+// run the query.
+func (s *Store) RawQueryContext(ctx context.Context, sql string, params ...interface{}) (ResultSet, error) {
+	rows, err := s.runner.QueryContext(ctx, sql, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResultSet(rows, true, nil), nil
+}
+
+// RawExec executes a raw SQL query with the given parameters and returns
+// the number of affected rows.
+func (s *Store) RawExec(sql string, params ...interface{}) (int64, error) {
+	return s.RawExecContext(context.Background(), sql, params...)
+}
+
+// RawExecContext is the same as RawExec, but the given context is used to run
+// the query.
+func (s *Store) RawExecContext(ctx context.Context, sql string, params ...interface{}) (int64, error) {
+	result, err := s.runner.ExecContext(ctx, sql, params...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// Find performs a query and returns a result set with the results.
+// Queries with one to many or many to many relationships are run in batches,
+// so they can not lock the selected rows.
+func (s *Store) Find(q Query) (ResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query. If the query needs to be run in batches, the context will be used for
+// all the batches.
+func (s *Store) FindContext(ctx context.Context, q Query) (ResultSet, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	rels := q.getRelationships()
+	if containsRelationshipOfType(rels, OneToMany) || containsRelationshipOfType(rels, ManyToMany) {
+		if q.isLocking() {
+			return nil, ErrLockNotSupported
+		}
+
+		return NewBatchingResultSet(newBatchQueryRunner(ctx, q.Schema(), s.runner, s.dialect, q)), nil
+	}
+
+	columns, builder := q.compile(s.dialect)
+	if offset := q.GetOffset(); offset > 0 {
+		builder = builder.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	rows, err := builder.RunWith(s.runner).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := NewResultSet(
 		rows,
 		q.isReadOnly(),
 		q.getRelationships(),
 		columns...,
-	), nil
+	)
+	rs.order = q.getOrder()
+	return rs, nil
 }
 
 // MustFind performs a query and returns a result set with the results.
 // It panics if the query fails.
 func (s *Store) MustFind(q Query) ResultSet {
-	rs, err := s.Find(q)
+	return s.MustFindContext(context.Background(), q)
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *Store) MustFindContext(ctx context.Context, q Query) ResultSet {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -426,16 +1433,23 @@ func (s *Store) MustFind(q Query) ResultSet {
 // Reload refreshes the record with the data in the database and makes the
 // record writable.
 func (s *Store) Reload(schema Schema, record Record) error {
+	return s.ReloadContext(context.Background(), schema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *Store) ReloadContext(ctx context.Context, schema Schema, record Record) error {
 	if record.GetID().IsEmpty() {
 		return ErrEmptyID
 	}
 
 	q := NewBaseQuery(schema)
+	q.WithDeleted()
 	q.Where(Eq(schema.ID(), record.GetID()))
 	q.Limit(1)
-	columns, builder := q.compile()
+	columns, builder := q.compile(s.dialect)
 
-	rows, err := builder.RunWith(s.runner).Query()
+	rows, err := builder.RunWith(s.runner).QueryContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -451,11 +1465,20 @@ func (s *Store) Reload(schema Schema, record Record) error {
 
 // Count returns the number of rows selected by the given query.
 func (s *Store) Count(q Query) (count int64, err error) {
-	_, queryBuilder := q.compile()
-	builder := builder.Set(queryBuilder, "Columns", nil).(squirrel.SelectBuilder)
+	return s.CountContext(context.Background(), q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *Store) CountContext(ctx context.Context, q Query) (count int64, err error) {
+	builder, err := totalAggregateBuilder(s.dialect, q)
+	if err != nil {
+		return 0, err
+	}
+
 	err = builder.Column("COUNT(*)").
 		RunWith(s.runner).
-		QueryRow().
+		QueryRowContext(ctx).
 		Scan(&count)
 	return
 }
@@ -463,7 +1486,13 @@ func (s *Store) Count(q Query) (count int64, err error) {
 // MustCount returns the number of rows selected by the given query. It panics
 // if the query fails.
 func (s *Store) MustCount(q Query) int64 {
-	cnt, err := s.Count(q)
+	return s.MustCountContext(context.Background(), q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *Store) MustCountContext(ctx context.Context, q Query) int64 {
+	cnt, err := s.CountContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -471,6 +1500,295 @@ func (s *Store) MustCount(q Query) int64 {
 	return cnt
 }
 
+// Sum returns the sum of the given column for the rows selected by the given
+// query, as a float64. If there are no rows, zero is returned.
+func (s *Store) Sum(q Query, col SchemaField) (sum float64, err error) {
+	return s.SumContext(context.Background(), q, col)
+}
+
+// SumContext is the same as Sum, but the given context is used to run the
+// query.
+func (s *Store) SumContext(ctx context.Context, q Query, col SchemaField) (sum float64, err error) {
+	err = s.aggregate(ctx, q, Sum(col), &sum)
+	return
+}
+
+// Avg returns the average of the given column for the rows selected by the
+// given query. If there are no rows, zero is returned.
+func (s *Store) Avg(q Query, col SchemaField) (avg float64, err error) {
+	return s.AvgContext(context.Background(), q, col)
+}
+
+// AvgContext is the same as Avg, but the given context is used to run the
+// query.
+func (s *Store) AvgContext(ctx context.Context, q Query, col SchemaField) (avg float64, err error) {
+	err = s.aggregate(ctx, q, Avg(col), &avg)
+	return
+}
+
+// Min copies into the value pointed by dest the minimum value of the given
+// column for the rows selected by the given query. If there are no rows, dest
+// is left untouched.
+func (s *Store) Min(q Query, col SchemaField, dest interface{}) error {
+	return s.MinContext(context.Background(), q, col, dest)
+}
+
+// MinContext is the same as Min, but the given context is used to run the
+// query.
+func (s *Store) MinContext(ctx context.Context, q Query, col SchemaField, dest interface{}) error {
+	return s.aggregate(ctx, q, Min(col), dest)
+}
+
+// Max copies into the value pointed by dest the maximum value of the given
+// column for the rows selected by the given query. If there are no rows, dest
+// is left untouched.
+func (s *Store) Max(q Query, col SchemaField, dest interface{}) error {
+	return s.MaxContext(context.Background(), q, col, dest)
+}
+
+// MaxContext is the same as Max, but the given context is used to run the
+// query.
+func (s *Store) MaxContext(ctx context.Context, q Query, col SchemaField, dest interface{}) error {
+	return s.aggregate(ctx, q, Max(col), dest)
+}
+
+// CountDistinct returns the number of distinct values of the given column for
+// the rows selected by the given query.
+func (s *Store) CountDistinct(q Query, col SchemaField) (count int64, err error) {
+	return s.CountDistinctContext(context.Background(), q, col)
+}
+
+// CountDistinctContext is the same as CountDistinct, but the given context is
+// used to run the query.
+func (s *Store) CountDistinctContext(ctx context.Context, q Query, col SchemaField) (count int64, err error) {
+	err = s.aggregate(ctx, q, CountDistinct(col), &count)
+	return
+}
+
+// aggregate selects only the given aggregate for the given query and scans
+// its value into dest.
+func (s *Store) aggregate(ctx context.Context, q Query, agg SchemaField, dest interface{}) error {
+	scanner := types.Nullable(dest)
+	if scanner == nil {
+		return fmt.Errorf("kallax: cannot scan aggregate value into %T", dest)
+	}
+
+	builder, err := totalAggregateBuilder(s.dialect, q)
+	if err != nil {
+		return err
+	}
+
+	return builder.Column(agg.QualifiedName(q.Schema())).
+		RunWith(s.runner).
+		QueryRowContext(ctx).
+		Scan(scanner)
+}
+
+// aggregateBuilder returns the select builder of the given query with no
+// columns selected and no row locking, which is not allowed with aggregates.
+func aggregateBuilder(dialect Dialect, q Query) squirrel.SelectBuilder {
+	_, queryBuilder := q.compile(dialect)
+	queryBuilder = builder.Set(queryBuilder, "Columns", nil).(squirrel.SelectBuilder)
+	return builder.Delete(queryBuilder, "Suffixes").(squirrel.SelectBuilder)
+}
+
+// totalAggregateBuilder returns the select builder of the given query with no
+// columns selected and no order to compute an aggregate over all its rows.
+// Grouped queries are rejected, as they would yield one value per group.
+func totalAggregateBuilder(dialect Dialect, q Query) (squirrel.SelectBuilder, error) {
+	if err := q.validate(); err != nil {
+		return squirrel.SelectBuilder{}, err
+	}
+
+	if len(q.getGroupBy()) > 0 {
+		return squirrel.SelectBuilder{}, ErrGroupedAggregate
+	}
+
+	queryBuilder := aggregateBuilder(dialect, q)
+	return builder.Delete(queryBuilder, "OrderByParts").(squirrel.SelectBuilder), nil
+}
+
+// FindAggregate performs the given query selecting its grouped columns, if
+// any, and the given aggregates, and returns a result set with the results.
+//   q := NewBaseQuery(schema)
+//   q.GroupBy(AgeColumn)
+//   rs, err := store.FindAggregate(q, CountAll())
+//   // SELECT age, COUNT(*) ... GROUP BY age
+func (s *Store) FindAggregate(q Query, aggregates ...SchemaField) (*AggregateResultSet, error) {
+	return s.FindAggregateContext(context.Background(), q, aggregates...)
+}
+
+// FindAggregateContext is the same as FindAggregate, but the given context is
+// used to run the query.
+func (s *Store) FindAggregateContext(ctx context.Context, q Query, aggregates ...SchemaField) (*AggregateResultSet, error) {
+	columns := append(append([]SchemaField{}, q.getGroupBy()...), aggregates...)
+	if len(columns) == 0 {
+		return nil, ErrNoAggregates
+	}
+
+	var qualified = make([]string, len(columns))
+	for i, col := range columns {
+		qualified[i] = col.QualifiedName(q.Schema())
+	}
+
+	builder := aggregateBuilder(s.dialect, q).Columns(qualified...)
+	if offset := q.GetOffset(); offset > 0 {
+		builder = builder.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	rows, err := builder.RunWith(s.runner).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAggregateResultSet(rows, columns...), nil
+}
+
+// Link adds the given related records to the many to many relationship in the
+// given field of the record, inserting the rows in the join table. Records that
+// were already related are ignored. Both the record and the related records are
+// required to have a non-empty ID.
+func (s *Store) Link(schema Schema, field string, record Record, related ...Record) error {
+	return s.LinkContext(context.Background(), schema, field, record, related...)
+}
+
+// LinkContext is the same as Link, but the given context is used to run the
+// queries.
+func (s *Store) LinkContext(ctx context.Context, schema Schema, field string, record Record, related ...Record) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	fk, err := throughForeignKey(schema, field)
+	if err != nil {
+		return err
+	}
+
+	if record.GetID().IsEmpty() {
+		return ErrEmptyID
+	}
+
+	var unique = make([]Record, 0, len(related))
+	for _, r := range related {
+		if r.GetID().IsEmpty() {
+			return ErrEmptyID
+		}
+
+		if !containsRecord(unique, r) {
+			unique = append(unique, r)
+		}
+	}
+	related = unique
+
+	var valBuf bytes.Buffer
+	var values = []interface{}{record.GetID()}
+	for i, r := range related {
+		if i != 0 {
+			valBuf.WriteRune(',')
+		}
+		valBuf.WriteString(fmt.Sprintf("(%s,%s)", s.dialect.Placeholder(1), s.dialect.Placeholder(i+2)))
+		values = append(values, r.GetID())
+	}
+
+	var query bytes.Buffer
+	query.WriteString("INSERT INTO ")
+	query.WriteString(fk.Through)
+	query.WriteString(" (")
+	query.WriteString(fk.String())
+	query.WriteRune(',')
+	query.WriteString(fk.ThroughKey)
+	query.WriteString(") VALUES ")
+	query.WriteString(valBuf.String())
+
+	return s.TransactionContext(ctx, nil, func(s *Store) error {
+		if err := s.UnlinkContext(ctx, schema, field, record, related...); err != nil {
+			return err
+		}
+
+		_, err := s.runner.ExecContext(ctx, query.String(), values...)
+		return err
+	})
+}
+
+// containsRecord reports whether the given records contain one with the same
+// ID as the given record.
+func containsRecord(records []Record, record Record) bool {
+	for _, r := range records {
+		if r.GetID().Equals(record.GetID()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unlink removes the given related records from the many to many relationship
+// in the given field of the record, deleting the rows in the join table. If no
+// related records are given, all the records related to it are removed from
+// the relationship. The related records themselves are not deleted.
+func (s *Store) Unlink(schema Schema, field string, record Record, related ...Record) error {
+	return s.UnlinkContext(context.Background(), schema, field, record, related...)
+}
+
+// UnlinkContext is the same as Unlink, but the given context is used to run
+// the query.
+func (s *Store) UnlinkContext(ctx context.Context, schema Schema, field string, record Record, related ...Record) error {
+	fk, err := throughForeignKey(schema, field)
+	if err != nil {
+		return err
+	}
+
+	if record.GetID().IsEmpty() {
+		return ErrEmptyID
+	}
+
+	var query bytes.Buffer
+	query.WriteString("DELETE FROM ")
+	query.WriteString(fk.Through)
+	query.WriteString(" WHERE ")
+	query.WriteString(fk.String())
+	query.WriteRune('=')
+	query.WriteString(s.dialect.Placeholder(1))
+
+	var values = []interface{}{record.GetID()}
+	if len(related) > 0 {
+		query.WriteString(" AND ")
+		query.WriteString(fk.ThroughKey)
+		query.WriteString(" IN (")
+		for i, r := range related {
+			if r.GetID().IsEmpty() {
+				return ErrEmptyID
+			}
+
+			if i != 0 {
+				query.WriteRune(',')
+			}
+			query.WriteString(s.dialect.Placeholder(i + 2))
+			values = append(values, r.GetID())
+		}
+		query.WriteRune(')')
+	}
+
+	_, err = s.runner.ExecContext(ctx, query.String(), values...)
+	return err
+}
+
+func throughForeignKey(schema Schema, field string) (*ForeignKey, error) {
+	fk, ok := schema.ForeignKey(field)
+	if !ok {
+		return nil, fmt.Errorf("kallax: cannot find foreign key on field %s for table %s", field, schema.Table())
+	}
+
+	if fk.Through == "" {
+		return nil, ErrManyToManyNotSupported
+	}
+
+	return fk, nil
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
@@ -478,11 +1796,19 @@ func (s *Store) MustCount(q Query) int64 {
 // If a transaction is already opened in this store, instead of opening a new
 // one, the other will be reused.
 func (s *Store) Transaction(callback func(*Store) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options. If the context is cancelled
+// before the transaction is committed, it will be rolled back.
+// The options are ignored if a transaction is already opened in this store.
+func (s *Store) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*Store) error) error {
 	var tx *sql.Tx
 	var err error
 	if db, ok := s.db.(*dbRunner); ok {
 		// db is *sql.DB, not *sql.Tx
-		tx, err = db.Begin()
+		tx, err = db.BeginTx(ctx, opts)
 		if err != nil {
 			return fmt.Errorf("kallax: can't open transaction: %s", err)
 		}
@@ -495,6 +1821,8 @@ func (s *Store) Transaction(callback func(*Store) error) error {
 		db:        &txRunner{tx},
 		logger:    s.logger,
 		useCacher: s.useCacher,
+		canCopy:   s.canCopy,
+		dialect:   s.dialect,
 	}).init()
 
 	if err := callback(txStore); err != nil {
@@ -519,6 +1847,45 @@ type RecordWithSchema struct {
 	Record Record
 }
 
+// recordVersion is the version of a versioned record.
+type recordVersion struct {
+	current int64
+	v       reflect.Value
+}
+
+// newRecordVersion returns the version of the record stored in the given
+// column, which must be an integer.
+func newRecordVersion(record Record, col SchemaField) (*recordVersion, error) {
+	addr, err := record.ColumnAddress(col.String())
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(addr)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &recordVersion{v.Int(), v}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &recordVersion{int64(v.Uint()), v}, nil
+	}
+
+	return nil, fmt.Errorf("kallax: version column %s must be an integer, it is %T", col, addr)
+}
+
+// set changes the version of the record.
+func (r *recordVersion) set(version int64) {
+	if r.v.Kind() >= reflect.Uint && r.v.Kind() <= reflect.Uint64 {
+		r.v.SetUint(uint64(version))
+	} else {
+		r.v.SetInt(version)
+	}
+	r.current = version
+}
+
 func virtualColumns(r Record, columns []string) (cols []string, vals []interface{}) {
 	c, ok := r.(VirtualColumnContainer)
 	if !ok {
@@ -526,16 +1893,35 @@ func virtualColumns(r Record, columns []string) (cols []string, vals []interface
 	}
 
 	vcols := c.getVirtualColumns()
-	for col, val := range vcols {
+	for col := range vcols {
 		if !containsString(columns, col) {
 			cols = append(cols, col)
-			vals = append(vals, val)
 		}
 	}
 
+	// columns are sorted so records with the same virtual columns always
+	// produce them in the same order
+	sort.Strings(cols)
+	for _, col := range cols {
+		vals = append(vals, vcols[col])
+	}
+
 	return
 }
 
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func containsString(strs []string, str string) bool {
 	for _, s := range strs {
 		if s == str {
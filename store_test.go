@@ -1,9 +1,12 @@
 package kallax
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"testing"
+	"time"
 
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/require"
@@ -66,6 +69,274 @@ func (s *StoreSuite) TestInsert_NoColumns() {
 	s.Equal(ErrNoColumns, s.store.Insert(onlyPkModelSchema, m))
 }
 
+func (s *StoreSuite) TestInsertMany() {
+	models := []Record{
+		newModel("a", "a@a.a", 1),
+		newModel("b", "b@b.b", 2),
+		newModel("c", "c@c.c", 3),
+	}
+	s.NoError(s.store.InsertMany(ModelSchema, models...))
+
+	ids := make(map[int64]struct{})
+	for _, r := range models {
+		m := r.(*model)
+		s.True(m.IsPersisted(), "model should be persisted now")
+		s.True(m.IsWritable(), "model should be writable now")
+		s.False(m.GetID().IsEmpty())
+		ids[m.ID] = struct{}{}
+		s.assertModel(m)
+	}
+	s.Len(ids, len(models))
+	s.assertCount(int64(len(models)))
+}
+
+func (s *StoreSuite) TestInsertMany_VirtualColumns() {
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+
+	rels := []Record{newRel(m.GetID(), "a"), newRel(m.GetID(), "b"), newRel(m.GetID(), "c")}
+	rels[1].(*rel).ClearVirtualColumns()
+	s.NoError(s.store.InsertMany(RelSchema, rels...))
+
+	q := NewBaseQuery(RelSchema)
+	q.Where(Eq(f("model_id"), m.ID))
+	count, err := s.store.Count(q)
+	s.NoError(err)
+	s.Equal(int64(2), count)
+}
+
+func (s *StoreSuite) TestInsertMany_MaxParams() {
+	// without COPY, the rows are inserted with multi-row statements, which
+	// also take the reserved ids as parameters
+	store := s.store.DisableCacher()
+	store.canCopy = false
+
+	models := make([]Record, maxInsertParams/3)
+	for i := range models {
+		models[i] = newModel("a", "a@a.a", i)
+	}
+	s.NoError(store.InsertMany(ModelSchema, models...))
+	s.assertCount(int64(len(models)))
+}
+
+func (s *StoreSuite) TestInsertMany_Empty() {
+	s.NoError(s.store.InsertMany(ModelSchema))
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestInsertMany_NotNew() {
+	var m model
+	m.setPersisted()
+	s.Equal(ErrNonNewDocument, s.store.InsertMany(ModelSchema, newModel("a", "a@a.a", 1), &m))
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestInsertMany_NoColumns() {
+	s.Equal(ErrNoColumns, s.store.InsertMany(onlyPkModelSchema, new(onlyPkModel)))
+}
+
+func (s *StoreSuite) TestInsertMany_Fail() {
+	s.Error(s.errStore.InsertMany(ModelSchema, newModel("a", "a@a.a", 1)))
+}
+
+func (s *StoreSuite) TestUpsert() {
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Upsert(ModelSchema, m, []SchemaField{f("id")}, f("name")))
+	s.True(m.IsPersisted(), "model should be persisted now")
+	s.False(m.GetID().IsEmpty())
+	s.assertModel(m)
+}
+
+func (s *StoreSuite) TestUpsert_Update() {
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+
+	other := newModel("b", "b@b.b", 2)
+	other.ID = m.ID
+	s.NoError(s.store.Upsert(ModelSchema, other, []SchemaField{f("id")}, f("name")))
+	s.True(other.IsPersisted())
+	s.Equal("b", other.Name)
+	s.Equal("a@a.a", other.Email)
+	s.Equal(1, other.Age)
+	s.assertModel(other)
+	s.assertCount(1)
+}
+
+func (s *StoreSuite) TestUpsert_DoNothing() {
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+
+	other := newModel("b", "b@b.b", 2)
+	other.ID = m.ID
+	s.NoError(s.store.Upsert(ModelSchema, other, []SchemaField{f("id")}))
+	s.True(other.IsPersisted())
+	s.Equal("a", other.Name)
+	s.Equal("a@a.a", other.Email)
+	s.Equal(1, other.Age)
+	s.assertCount(1)
+}
+
+func (s *StoreSuite) TestUpsert_Versioned() {
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(versionedModelSchema, m))
+
+	other := newModel("b", "b@b.b", 1)
+	other.ID = m.ID
+	s.NoError(s.store.Upsert(versionedModelSchema, other, []SchemaField{f("id")}, f("name"), f("age")))
+	s.Equal("b", other.Name)
+	s.Equal(2, other.Age, "version is incremented")
+
+	m.Name = "c"
+	_, err := s.store.Update(versionedModelSchema, m)
+	s.Equal(ErrStaleRecord, err)
+}
+
+func (s *StoreSuite) TestUpsert_NoConflictColumns() {
+	m := newModel("a", "a@a.a", 1)
+	s.Equal(ErrNoConflictColumns, s.store.Upsert(ModelSchema, m, nil, f("name")))
+}
+
+func (s *StoreSuite) TestUpdateWhere() {
+	for i := 0; i < 3; i++ {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "a@a.a", i)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 0))
+	count, err := s.store.UpdateWhere(q, map[SchemaField]interface{}{
+		f("email"): "b@b.b",
+	})
+	s.NoError(err)
+	s.Equal(int64(2), count)
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("email"), "b@b.b"))
+	s.Equal(int64(2), s.store.MustCount(q))
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("age"), 0))
+	rs, err := s.store.UpdateWhereReturning(q, map[SchemaField]interface{}{
+		f("name"): "foo",
+	})
+	s.NoError(err)
+
+	var models []*model
+	for rs.Next() {
+		r, err := rs.Get(ModelSchema)
+		s.NoError(err)
+		models = append(models, r.(*model))
+	}
+	s.Len(models, 1)
+	s.Equal("foo", models[0].Name)
+	s.assertModel(models[0])
+}
+
+func (s *StoreSuite) TestUpdateWhere_Versioned() {
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(versionedModelSchema, m))
+
+	count, err := s.store.UpdateWhere(NewBaseQuery(versionedModelSchema), map[SchemaField]interface{}{
+		f("name"): "b",
+	})
+	s.NoError(err)
+	s.Equal(int64(1), count)
+
+	m.Name = "c"
+	_, err = s.store.Update(versionedModelSchema, m)
+	s.Equal(ErrStaleRecord, err)
+}
+
+func (s *StoreSuite) TestDeleteWhere() {
+	for i := 0; i < 3; i++ {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "a@a.a", i)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 1))
+	count, err := s.store.DeleteWhere(q)
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.assertCount(2)
+
+	rs, err := s.store.DeleteWhereReturning(NewBaseQuery(ModelSchema))
+	s.NoError(err)
+
+	var deleted int
+	for rs.Next() {
+		_, err := rs.Get(ModelSchema)
+		s.NoError(err)
+		deleted++
+	}
+	s.Equal(2, deleted)
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestAggregates() {
+	for i, age := range []int{1, 2, 2, 3} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "a@a.a", age)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	sum, err := s.store.Sum(q, f("age"))
+	s.NoError(err)
+	s.Equal(float64(8), sum)
+
+	avg, err := s.store.Avg(q, f("age"))
+	s.NoError(err)
+	s.Equal(float64(2), avg)
+
+	var min, max int
+	s.NoError(s.store.Min(q, f("age"), &min))
+	s.Equal(1, min)
+	s.NoError(s.store.Max(q, f("age"), &max))
+	s.Equal(3, max)
+
+	count, err := s.store.CountDistinct(q, f("age"))
+	s.NoError(err)
+	s.Equal(int64(3), count)
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 5))
+	sum, err = s.store.Sum(q, f("age"))
+	s.NoError(err)
+	s.Equal(float64(0), sum)
+}
+
+func (s *StoreSuite) TestFindAggregate() {
+	for i, age := range []int{1, 2, 2, 3, 3, 3} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "a@a.a", age)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.GroupBy(f("age"))
+	q.Having(Gt(CountAll(), 1))
+	q.Order(Asc(f("age")))
+	rs, err := s.store.FindAggregate(q, CountAll(), Sum(f("age")))
+	s.NoError(err)
+
+	rows, err := rs.All()
+	s.NoError(err)
+	s.Len(rows, 2)
+
+	var expected = []struct{ age, count, sum int64 }{{2, 2, 4}, {3, 3, 9}}
+	for i, row := range rows {
+		age, err := row.Int64(f("age"))
+		s.NoError(err)
+		s.Equal(expected[i].age, age)
+
+		count, err := row.Int64(CountAll())
+		s.NoError(err)
+		s.Equal(expected[i].count, count)
+
+		sum, err := row.Int64(Sum(f("age")))
+		s.NoError(err)
+		s.Equal(expected[i].sum, sum)
+	}
+
+	_, err = s.store.FindAggregate(NewBaseQuery(ModelSchema))
+	s.Equal(ErrNoAggregates, err)
+}
+
 func (s *StoreSuite) TestUpdate() {
 	var m = newModel("a", "a@a.a", 1)
 	s.NoError(s.store.Insert(ModelSchema, m))
@@ -107,6 +378,56 @@ func (s *StoreSuite) TestUpdate_ColumnNotFound() {
 	s.Error(err)
 }
 
+func (s *StoreSuite) TestUpdate_Versioned() {
+	var m = newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(versionedModelSchema, m))
+
+	var stale = newModel("a", "a@a.a", 1)
+	stale.ID = m.ID
+	stale.setPersisted()
+
+	m.Name = "b"
+	rows, err := s.store.Update(versionedModelSchema, m)
+	s.NoError(err)
+	s.Equal(int64(1), rows, "rows affected")
+	s.Equal(2, m.Age, "version is incremented")
+	s.assertModel(m)
+
+	m.Name = "c"
+	rows, err = s.store.Update(versionedModelSchema, m, f("name"))
+	s.NoError(err)
+	s.Equal(int64(1), rows, "rows affected")
+	s.Equal(3, m.Age, "version is incremented")
+	s.assertModel(m)
+
+	stale.Name = "d"
+	_, err = s.store.Update(versionedModelSchema, stale)
+	s.Equal(ErrStaleRecord, err)
+	s.Equal(1, stale.Age, "version is not incremented")
+	s.assertModel(m)
+}
+
+func (s *StoreSuite) TestUpdate_VersionNotInteger() {
+	var m = newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+
+	schema := NewBaseSchema(
+		"model",
+		"__model",
+		f("id"),
+		nil,
+		func() Record {
+			return new(model)
+		},
+		true,
+		f("id"),
+		f("name"),
+	).WithVersion(f("name"))
+
+	_, err := s.store.Update(schema, m)
+	s.Error(err)
+}
+
 func (s *StoreSuite) TestUpdate_NotUpdated() {
 	var m = newModel("a", "a@a.a", 1)
 	s.NoError(s.store.Insert(ModelSchema, m))
@@ -251,6 +572,42 @@ func (s *StoreSuite) TestMustFind() {
 	})
 }
 
+func (s *StoreSuite) TestFindContext_Cancelled() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "", 1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.store.FindContext(ctx, NewBaseQuery(ModelSchema))
+	s.Equal(context.Canceled, err)
+}
+
+func (s *StoreSuite) TestFindContext_1toN_Cancelled() {
+	s.rel1ToNFixtures()
+
+	q := NewBaseQuery(ModelSchema)
+	s.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rs, err := s.store.FindContext(ctx, q)
+	s.NoError(err)
+	cancel()
+
+	s.True(rs.Next())
+	_, err = rs.Get(ModelSchema)
+	s.Equal(context.Canceled, err)
+}
+
+func (s *StoreSuite) TestInsertContext_Cancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := newModel("Joe", "", 1)
+	s.Equal(context.Canceled, s.store.InsertContext(ctx, ModelSchema, m))
+	s.False(m.IsPersisted())
+	s.assertCount(0)
+}
+
 func (s *StoreSuite) TestDebugWith() {
 	var queries []string
 	var logger = func(q string, args ...interface{}) {
@@ -333,6 +690,25 @@ func (s *StoreSuite) TestTransaction_Rollback() {
 	s.assertCount(0)
 }
 
+func (s *StoreSuite) TestTransactionContext_Cancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := s.store.TransactionContext(ctx, nil, func(store *Store) error {
+		s.NoError(store.InsertContext(ctx, ModelSchema, newModel("Joe", "", 1)))
+		cancel()
+		return nil
+	})
+	s.Error(err)
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestCountContext() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "", 1)))
+
+	cnt, err := s.store.CountContext(context.Background(), NewBaseQuery(ModelSchema))
+	s.NoError(err)
+	s.Equal(int64(1), cnt)
+}
+
 func (s *StoreSuite) TestTransaction_RawExec() {
 	err := s.store.Transaction(func(store *Store) error {
 		_, err := store.RawExec("INSERT INTO model (name, email, age) VALUES ($1, $2, $3)", "foo", "bar", 1)
@@ -483,6 +859,112 @@ func (s *StoreSuite) TestFind_1toN_Filter() {
 	s.Equal("bar", model.Rels[0].Foo)
 }
 
+func (s *StoreSuite) relNToNFixtures() (*model, []*rel) {
+	m := newModel("Foo", "bar", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Bar", "baz", 2)))
+
+	var rels []*rel
+	for _, foo := range []string{"foo", "bar", "baz"} {
+		r := newRel(new(NumericID), foo)
+		s.NoError(s.store.Insert(RelSchema, r))
+		rels = append(rels, r)
+	}
+
+	s.NoError(s.store.Link(ModelSchema, "rels_through", m, rels[0], rels[1], rels[2]))
+	return m, rels
+}
+
+func (s *StoreSuite) TestFind_NtoN() {
+	s.relNToNFixtures()
+
+	q := NewBaseQuery(ModelSchema)
+	s.NoError(q.AddRelation(RelSchema, "rels_through", ManyToMany, nil))
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+
+	s.True(rs.Next())
+	record, err := rs.Get(ModelSchema)
+	s.NoError(err)
+	m := record.(*model)
+	s.Equal("Foo", m.Name)
+	s.Require().Len(m.Rels, 3)
+	s.Equal("foo", m.Rels[0].Foo)
+	s.Equal("bar", m.Rels[1].Foo)
+	s.Equal("baz", m.Rels[2].Foo)
+
+	s.True(rs.Next())
+	record, err = rs.Get(ModelSchema)
+	s.NoError(err)
+	m = record.(*model)
+	s.Equal("Bar", m.Name)
+	s.Len(m.Rels, 0)
+
+	s.False(rs.Next())
+}
+
+func (s *StoreSuite) TestFind_NtoN_Filter() {
+	s.relNToNFixtures()
+
+	q := NewBaseQuery(ModelSchema)
+	s.NoError(q.AddRelation(RelSchema, "rels_through", ManyToMany, Eq(f("foo"), "bar")))
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+
+	s.True(rs.Next())
+	record, err := rs.Get(ModelSchema)
+	s.NoError(err)
+	m := record.(*model)
+	s.False(m.IsWritable())
+	s.Require().Len(m.Rels, 1)
+	s.Equal("bar", m.Rels[0].Foo)
+}
+
+func (s *StoreSuite) TestLink() {
+	m, rels := s.relNToNFixtures()
+
+	// linking an already linked record does not duplicate it
+	s.NoError(s.store.Link(ModelSchema, "rels_through", m, rels[0]))
+
+	cnt, err := s.store.RawExec("SELECT * FROM model_rel WHERE model_id = $1", m.ID)
+	s.NoError(err)
+	s.Equal(int64(3), cnt)
+}
+
+func (s *StoreSuite) TestLink_Duplicated() {
+	m := newModel("Foo", "bar", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+	r := newRel(nil, "foo")
+	s.NoError(s.store.Insert(RelSchema, r))
+
+	s.NoError(s.store.Link(ModelSchema, "rels_through", m, r, r))
+
+	cnt, err := s.store.RawExec("SELECT * FROM model_rel WHERE model_id = $1", m.ID)
+	s.NoError(err)
+	s.Equal(int64(1), cnt)
+}
+
+func (s *StoreSuite) TestLink_NotManyToMany() {
+	m := newModel("Foo", "bar", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+	s.Equal(ErrManyToManyNotSupported, s.store.Link(ModelSchema, "rels", m, newRel(new(NumericID), "foo")))
+}
+
+func (s *StoreSuite) TestUnlink() {
+	m, rels := s.relNToNFixtures()
+
+	s.NoError(s.store.Unlink(ModelSchema, "rels_through", m, rels[1]))
+	cnt, err := s.store.RawExec("SELECT * FROM model_rel WHERE model_id = $1", m.ID)
+	s.NoError(err)
+	s.Equal(int64(2), cnt)
+
+	s.NoError(s.store.Unlink(ModelSchema, "rels_through", m))
+	cnt, err = s.store.RawExec("SELECT * FROM model_rel WHERE model_id = $1", m.ID)
+	s.NoError(err)
+	s.Equal(int64(0), cnt)
+	s.assertCount(2)
+}
+
 func (s *StoreSuite) TestFind_1toNAnd1to1() {
 	s.rel1ToNFixtures()
 
@@ -597,3 +1079,196 @@ func TestStoreFrom(t *testing.T) {
 	StoreFrom(&s2, s1)
 	require.Exactly(s1.Store, s2.Store)
 }
+
+func TestUpdateWhereSQL(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("name"), "a"))
+	sql, args, err := updateWhereSQL(PostgreSQL, q, map[SchemaField]interface{}{
+		f("name"): "b",
+		f("age"):  2,
+	}, nil)
+	require.NoError(err)
+	require.Equal("UPDATE model AS __model SET age = $1, name = $2 WHERE __model.name = $3", sql)
+	require.Equal([]interface{}{2, "b", "a"}, args)
+
+	sql, _, err = updateWhereSQL(PostgreSQL, NewBaseQuery(ModelSchema), map[SchemaField]interface{}{
+		f("name"): "b",
+	}, []string{"id", "name"})
+	require.NoError(err)
+	require.Equal("UPDATE model AS __model SET name = $1 RETURNING id,name", sql)
+
+	sql, args, err = updateWhereSQL(PostgreSQL, NewBaseQuery(versionedModelSchema), map[SchemaField]interface{}{
+		f("name"): "b",
+		f("age"):  5,
+	}, nil)
+	require.NoError(err)
+	require.Equal("UPDATE model AS __model SET name = $1, age = age + 1", sql)
+	require.Equal([]interface{}{"b"}, args)
+
+	_, _, err = updateWhereSQL(PostgreSQL, q, nil, nil)
+	require.Equal(ErrNoValues, err)
+
+	q.Limit(1)
+	_, _, err = updateWhereSQL(PostgreSQL, q, map[SchemaField]interface{}{f("name"): "b"}, nil)
+	require.Equal(ErrLimitNotSupported, err)
+}
+
+func TestDeleteWhereSQL(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("name"), "a"))
+	q.Where(Gt(f("age"), 1))
+	sql, args, err := deleteWhereSQL(PostgreSQL, q, time.Now(), []string{"id"})
+	require.NoError(err)
+	require.Equal("DELETE FROM model AS __model WHERE __model.name = $1 AND __model.age > $2 RETURNING id", sql)
+	require.Equal([]interface{}{"a", 1}, args)
+
+	q.Offset(1)
+	_, _, err = deleteWhereSQL(PostgreSQL, q, time.Now(), nil)
+	require.Equal(ErrLimitNotSupported, err)
+}
+
+func TestWhereSQL_Relationships(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	require.NoError(q.AddRelation(RelSchema, "rel", OneToOne, nil))
+	q.Where(Eq(f("foo"), "a"))
+
+	_, _, err := updateWhereSQL(PostgreSQL, q, map[SchemaField]interface{}{f("name"): "b"}, nil)
+	require.Equal(ErrRelationshipsNotSupported, err)
+
+	_, _, err = deleteWhereSQL(PostgreSQL, q, time.Now(), nil)
+	require.Equal(ErrRelationshipsNotSupported, err)
+
+	_, _, err = deleteWhereSQL(PostgreSQL, NewBaseQuery(softDeleteModelSchema), time.Now(), nil)
+	require.NoError(err)
+
+	// conditions on related records are subqueries, which do not need joins
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Has(RelSchema, "rels", Eq(f("foo"), "a")))
+	sql, _, err := forceDeleteWhereSQL(PostgreSQL, q, nil)
+	require.NoError(err)
+	require.Equal("DELETE FROM model AS __model WHERE EXISTS (SELECT 1 FROM rel __rel_rels WHERE __rel_rels.model_id = __model.id AND __rel_rels.foo = $1)", sql)
+}
+
+func TestDeleteWhereSQL_SoftDelete(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+
+	q := NewBaseQuery(softDeleteModelSchema)
+	q.Where(Eq(f("name"), "a"))
+	sql, args, err := deleteWhereSQL(PostgreSQL, q, now, []string{"id"})
+	require.NoError(err)
+	require.Equal("UPDATE model AS __model SET deleted_at = $1 WHERE __model.name = $2 AND __model.deleted_at IS NULL RETURNING id", sql)
+	require.Equal([]interface{}{now, "a"}, args)
+
+	q.WithDeleted()
+	sql, _, err = deleteWhereSQL(PostgreSQL, q, now, nil)
+	require.NoError(err)
+	require.Equal("UPDATE model AS __model SET deleted_at = $1 WHERE __model.name = $2 AND __model.deleted_at IS NULL", sql)
+
+	sql, args, err = forceDeleteWhereSQL(PostgreSQL, q, nil)
+	require.NoError(err)
+	require.Equal("DELETE FROM model AS __model WHERE __model.name = $1", sql)
+	require.Equal([]interface{}{"a"}, args)
+}
+
+func TestRestore_NoSoftDelete(t *testing.T) {
+	m := newModel("a", "a@a.a", 1)
+	m.ID = 1
+	require.Equal(t, ErrNoSoftDelete, new(Store).Restore(ModelSchema, m))
+}
+
+func TestFind_LockWithBatching(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.ForUpdate()
+	require.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+
+	_, err := new(Store).Find(q)
+	require.Equal(ErrLockNotSupported, err)
+}
+
+func TestAggregateBuilder(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("name"), "a"))
+	q.ForUpdate()
+	q.SkipLocked()
+
+	sql, args, err := aggregateBuilder(PostgreSQL, q).Column("COUNT(*)").ToSql()
+	require.NoError(err)
+	require.Equal("SELECT COUNT(*) FROM model __model WHERE __model.name = $1", sql)
+	require.Equal([]interface{}{"a"}, args)
+}
+
+func TestTotalAggregateBuilder(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("name"), "a"))
+	q.Order(Desc(f("name")))
+
+	b, err := totalAggregateBuilder(PostgreSQL, q)
+	require.NoError(err)
+	sql, args, err := b.Column("COUNT(*)").ToSql()
+	require.NoError(err)
+	require.Equal("SELECT COUNT(*) FROM model __model WHERE __model.name = $1", sql)
+	require.Equal([]interface{}{"a"}, args)
+
+	q.GroupBy(f("name"))
+	_, err = new(Store).Count(q)
+	require.Equal(ErrGroupedAggregate, err)
+	_, err = new(Store).Sum(q, f("id"))
+	require.Equal(ErrGroupedAggregate, err)
+}
+
+func TestFind_CursorMismatch(t *testing.T) {
+	require := require.New(t)
+
+	cursor, err := NewCursor(1)
+	require.NoError(err)
+
+	q := NewBaseQuery(ModelSchema)
+	q.After(cursor)
+
+	_, err = new(Store).Find(q)
+	require.Equal(ErrCursorMismatch, err)
+
+	_, err = new(Store).Count(q)
+	require.Equal(ErrCursorMismatch, err)
+}
+
+func TestInsertBatches(t *testing.T) {
+	require := require.New(t)
+
+	cols := []string{"name", "email", "age"}
+	rows := make([]insertRow, maxInsertParams/len(cols))
+	for i := range rows {
+		rows[i].cols = cols
+	}
+
+	batches := insertBatches(rows, 0)
+	require.Len(batches, 1)
+
+	batches = insertBatches(rows, 1)
+	require.Len(batches, 2)
+	require.Len(batches[0], maxInsertParams/(len(cols)+1))
+	require.Len(batches[1], len(rows)-len(batches[0]))
+
+	rows[1].cols = []string{"name"}
+	batches = insertBatches(rows[:3], 1)
+	require.Len(batches, 3)
+}
+
+func TestCheckInserted(t *testing.T) {
+	require := require.New(t)
+	require.NoError(checkInserted(driver.RowsAffected(3), 3))
+	require.EqualError(checkInserted(driver.RowsAffected(2), 3), "kallax: 2 rows were inserted instead of 3")
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	kallax "gopkg.in/src-d/go-kallax.v1"
 )
 
 type EventsSuite struct {
@@ -49,6 +50,29 @@ func (s *EventsSuite) TestEventsInsert() {
 	}, doc.Checks)
 }
 
+func (s *EventsSuite) TestEventsInsertMany() {
+	store := NewEventsFixtureStore(s.db)
+
+	docs := []*EventsFixture{NewEventsFixture(), NewEventsFixture()}
+	s.Nil(store.InsertMany(docs))
+	for _, doc := range docs {
+		s.True(doc.IsPersisted())
+		s.assertEventsPassed(map[string]bool{
+			"BeforeInsert": true,
+			"AfterInsert":  true,
+		}, doc.Checks)
+	}
+}
+
+func (s *EventsSuite) TestEventsInsertManyError() {
+	store := NewEventsFixtureStore(s.db)
+
+	docs := []*EventsFixture{NewEventsFixture(), NewEventsFixture()}
+	docs[1].MustFailAfter = errors.New("kallax: after")
+	s.Equal(docs[1].MustFailAfter, store.InsertMany(docs))
+	s.Equal(int64(0), store.MustCount(NewEventsFixtureQuery()))
+}
+
 func (s *EventsSuite) TestEventsUpdate() {
 	store := NewEventsFixtureStore(s.db)
 
@@ -125,6 +149,18 @@ func (s *EventsSuite) TestEventsSaveInsert() {
 	}, doc.Checks)
 }
 
+func (s *EventsSuite) TestEventsSaveUpsert() {
+	store := NewEventsSaveFixtureStore(s.db)
+
+	doc := NewEventsSaveFixture()
+	err := store.Upsert(doc, []kallax.SchemaField{Schema.EventsSaveFixture.ID})
+	s.Nil(err)
+	s.assertEventsPassed(map[string]bool{
+		"BeforeSave": true,
+		"AfterSave":  true,
+	}, doc.Checks)
+}
+
 func (s *EventsSuite) TestEventsSaveUpdate() {
 	store := NewEventsSaveFixtureStore(s.db)
 
@@ -5,6 +5,7 @@
 package tests
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/url"
@@ -18,7 +19,7 @@ import (
 var _ types.SQLType
 var _ fmt.Formatter
 
-type modelSaveFunc func(*kallax.Store) error
+type modelSaveFunc func(context.Context, *kallax.Store) error
 
 // NewA returns a new instance of A.
 func NewA(name string) (record *A) {
@@ -124,14 +125,20 @@ func (s *AStore) DisableCacher() *AStore {
 	return &AStore{s.Store.DisableCacher()}
 }
 
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *AStore) WithDialect(dialect kallax.Dialect) *AStore {
+	return &AStore{s.Store.WithDialect(dialect)}
+}
+
 func (s *AStore) relationshipRecords(record *A) []modelSaveFunc {
 	var result []modelSaveFunc
 
 	if record.B != nil && !record.B.IsSaving() {
 		r := record.B
 		r.AddVirtualColumn("a_id", record.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&BStore{store}).Save(r)
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&BStore{store}).SaveContext(ctx, r)
 			return err
 		})
 	}
@@ -142,19 +149,71 @@ func (s *AStore) relationshipRecords(record *A) []modelSaveFunc {
 // Insert inserts a A in the database. A non-persisted object is
 // required for this operation.
 func (s *AStore) Insert(record *A) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *AStore) InsertContext(ctx context.Context, record *A) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
 	records := s.relationshipRecords(record)
 
 	if len(records) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			if err := s.Insert(Schema.A.BaseSchema, record); err != nil {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertContext(ctx, Schema.A.BaseSchema, record); err != nil {
 				return err
 			}
 
 			for _, r := range records {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.A.BaseSchema, record)
+}
+
+// InsertMany inserts all the given A in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *AStore) InsertMany(records []*A) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *AStore) InsertManyContext(ctx context.Context, records []*A) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertManyContext(ctx, Schema.A.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
@@ -163,7 +222,7 @@ func (s *AStore) Insert(record *A) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.A.BaseSchema, record)
+	return s.Store.InsertManyContext(ctx, Schema.A.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -173,20 +232,26 @@ func (s *AStore) Insert(record *A) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *AStore) Update(record *A, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *AStore) UpdateContext(ctx context.Context, record *A, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
 	records := s.relationshipRecords(record)
 
 	if len(records) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			updated, err = s.Update(Schema.A.BaseSchema, record, cols...)
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			updated, err = s.UpdateContext(ctx, Schema.A.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
 
 			for _, r := range records {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
@@ -200,17 +265,23 @@ func (s *AStore) Update(record *A, cols ...kallax.SchemaField) (updated int64, e
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.A.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.A.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *AStore) Save(record *A) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *AStore) SaveContext(ctx context.Context, record *A) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -218,14 +289,104 @@ func (s *AStore) Save(record *A) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *AStore) Upsert(record *A, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *AStore) UpsertContext(ctx context.Context, record *A, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.A.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
 func (s *AStore) Delete(record *A) error {
-	return s.Store.Delete(Schema.A.BaseSchema, record)
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *AStore) DeleteContext(ctx context.Context, record *A) error {
+	return s.Store.DeleteContext(ctx, Schema.A.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *AStore) UpdateWhere(q *AQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *AStore) UpdateWhereContext(ctx context.Context, q *AQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *AStore) UpdateWhereReturning(q *AQuery, values map[kallax.SchemaField]interface{}) (*AResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *AStore) UpdateWhereReturningContext(ctx context.Context, q *AQuery, values map[kallax.SchemaField]interface{}) (*AResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *AStore) DeleteWhere(q *AQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *AStore) DeleteWhereContext(ctx context.Context, q *AQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *AStore) DeleteWhereReturning(q *AQuery) (*AResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *AStore) DeleteWhereReturningContext(ctx context.Context, q *AQuery) (*AResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
 func (s *AStore) Find(q *AQuery) (*AResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *AStore) FindContext(ctx context.Context, q *AQuery) (*AResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -239,24 +400,48 @@ func (s *AStore) MustFind(q *AQuery) *AResultSet {
 	return NewAResultSet(s.Store.MustFind(q))
 }
 
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *AStore) MustFindContext(ctx context.Context, q *AQuery) *AResultSet {
+	return NewAResultSet(s.Store.MustFindContext(ctx, q))
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *AStore) Count(q *AQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *AStore) CountContext(ctx context.Context, q *AQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *AStore) MustCount(q *AQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *AStore) MustCountContext(ctx context.Context, q *AQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *AStore) FindOne(q *AQuery) (*A, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *AStore) FindOneContext(ctx context.Context, q *AQuery) (*A, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +464,13 @@ func (s *AStore) FindOne(q *AQuery) (*A, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *AStore) FindAll(q *AQuery) ([]*A, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *AStore) FindAllContext(ctx context.Context, q *AQuery) ([]*A, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +481,13 @@ func (s *AStore) FindAll(q *AQuery) ([]*A, error) {
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
 func (s *AStore) MustFindOne(q *AQuery) *A {
-	record, err := s.FindOne(q)
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *AStore) MustFindOneContext(ctx context.Context, q *AQuery) *A {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -303,16 +500,28 @@ func (s *AStore) Reload(record *A) error {
 	return s.Store.Reload(Schema.A.BaseSchema, record)
 }
 
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *AStore) ReloadContext(ctx context.Context, record *A) error {
+	return s.Store.ReloadContext(ctx, Schema.A.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *AStore) Transaction(callback func(*AStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *AStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*AStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&AStore{store})
 	})
 }
@@ -320,6 +529,12 @@ func (s *AStore) Transaction(callback func(*AStore) error) error {
 // RemoveB removes from the database the given relationship of the
 // model. It also resets the field B of the model.
 func (s *AStore) RemoveB(record *A) error {
+	return s.RemoveBContext(context.Background(), record)
+}
+
+// RemoveBContext is the same as RemoveB, but the given
+// context is used to run the queries.
+func (s *AStore) RemoveBContext(ctx context.Context, record *A) error {
 	var r kallax.Record = record.B
 	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 		if err := beforeDeleter.BeforeDelete(); err != nil {
@@ -329,8 +544,8 @@ func (s *AStore) RemoveB(record *A) error {
 
 	var err error
 	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.B.BaseSchema, r)
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.B.BaseSchema, r)
 			if err != nil {
 				return err
 			}
@@ -338,7 +553,7 @@ func (s *AStore) RemoveB(record *A) error {
 			return afterDeleter.AfterDelete()
 		})
 	} else {
-		err = s.Store.Delete(Schema.B.BaseSchema, r)
+		err = s.Store.DeleteContext(ctx, Schema.B.BaseSchema, r)
 	}
 	if err != nil {
 		return err
@@ -390,6 +605,20 @@ func (q *AQuery) Order(cols ...kallax.ColumnOrder) *AQuery {
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *AQuery) After(cursor *kallax.Cursor) *AQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *AQuery) Before(cursor *kallax.Cursor) *AQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
 func (q *AQuery) BatchSize(size uint64) *AQuery {
@@ -416,11 +645,58 @@ func (q *AQuery) Where(cond kallax.Condition) *AQuery {
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *AQuery) GroupBy(cols ...kallax.SchemaField) *AQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *AQuery) Having(cond kallax.Condition) *AQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *AQuery) ForUpdate() *AQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *AQuery) ForShare() *AQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *AQuery) NoWait() *AQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *AQuery) SkipLocked() *AQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 func (q *AQuery) WithB() *AQuery {
 	q.AddRelation(Schema.B.BaseSchema, "B", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereHasB filters the records whose B relationship has any
+// record matching the given condition, which can be nil.
+func (q *AQuery) WhereHasB(cond kallax.Condition) *AQuery {
+	q.Where(kallax.Has(Schema.B.BaseSchema, "B", cond))
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -539,6 +815,12 @@ func (rs *AResultSet) One() (*A, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *AResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
 func (rs *AResultSet) Err() error {
 	return rs.lastErr
@@ -673,14 +955,20 @@ func (s *BStore) DisableCacher() *BStore {
 	return &BStore{s.Store.DisableCacher()}
 }
 
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *BStore) WithDialect(dialect kallax.Dialect) *BStore {
+	return &BStore{s.Store.WithDialect(dialect)}
+}
+
 func (s *BStore) relationshipRecords(record *B) []modelSaveFunc {
 	var result []modelSaveFunc
 
 	if record.C != nil && !record.C.IsSaving() {
 		r := record.C
 		r.AddVirtualColumn("b_id", record.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&CStore{store}).Save(r)
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&CStore{store}).SaveContext(ctx, r)
 			return err
 		})
 	}
@@ -693,8 +981,8 @@ func (s *BStore) inverseRecords(record *B) []modelSaveFunc {
 
 	if record.A != nil && !record.A.IsSaving() {
 		record.AddVirtualColumn("a_id", record.A.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&AStore{store}).Save(record.A)
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&AStore{store}).SaveContext(ctx, record.A)
 			return err
 		})
 	}
@@ -705,6 +993,12 @@ func (s *BStore) inverseRecords(record *B) []modelSaveFunc {
 // Insert inserts a B in the database. A non-persisted object is
 // required for this operation.
 func (s *BStore) Insert(record *B) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *BStore) InsertContext(ctx context.Context, record *B) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
@@ -713,19 +1007,76 @@ func (s *BStore) Insert(record *B) error {
 	inverseRecords := s.inverseRecords(record)
 
 	if len(records) > 0 || len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			if err := s.Insert(Schema.B.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.B.BaseSchema, record); err != nil {
 				return err
 			}
 
 			for _, r := range records {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.B.BaseSchema, record)
+}
+
+// InsertMany inserts all the given B in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *BStore) InsertMany(records []*B) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *BStore) InsertManyContext(ctx context.Context, records []*B) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 || len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertManyContext(ctx, Schema.B.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
@@ -734,7 +1085,7 @@ func (s *BStore) Insert(record *B) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.B.BaseSchema, record)
+	return s.Store.InsertManyContext(ctx, Schema.B.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -744,6 +1095,12 @@ func (s *BStore) Insert(record *B) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *BStore) Update(record *B, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *BStore) UpdateContext(ctx context.Context, record *B, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
@@ -752,20 +1109,20 @@ func (s *BStore) Update(record *B, cols ...kallax.SchemaField) (updated int64, e
 	inverseRecords := s.inverseRecords(record)
 
 	if len(records) > 0 || len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			updated, err = s.Update(Schema.B.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.B.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
 
 			for _, r := range records {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
@@ -779,17 +1136,23 @@ func (s *BStore) Update(record *B, cols ...kallax.SchemaField) (updated int64, e
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.B.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.B.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *BStore) Save(record *B) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *BStore) SaveContext(ctx context.Context, record *B) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -797,14 +1160,104 @@ func (s *BStore) Save(record *B) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *BStore) Upsert(record *B, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *BStore) UpsertContext(ctx context.Context, record *B, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.B.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
 func (s *BStore) Delete(record *B) error {
-	return s.Store.Delete(Schema.B.BaseSchema, record)
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *BStore) DeleteContext(ctx context.Context, record *B) error {
+	return s.Store.DeleteContext(ctx, Schema.B.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *BStore) UpdateWhere(q *BQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *BStore) UpdateWhereContext(ctx context.Context, q *BQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *BStore) UpdateWhereReturning(q *BQuery, values map[kallax.SchemaField]interface{}) (*BResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *BStore) UpdateWhereReturningContext(ctx context.Context, q *BQuery, values map[kallax.SchemaField]interface{}) (*BResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *BStore) DeleteWhere(q *BQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *BStore) DeleteWhereContext(ctx context.Context, q *BQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *BStore) DeleteWhereReturning(q *BQuery) (*BResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *BStore) DeleteWhereReturningContext(ctx context.Context, q *BQuery) (*BResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
 func (s *BStore) Find(q *BQuery) (*BResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *BStore) FindContext(ctx context.Context, q *BQuery) (*BResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -818,24 +1271,48 @@ func (s *BStore) MustFind(q *BQuery) *BResultSet {
 	return NewBResultSet(s.Store.MustFind(q))
 }
 
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *BStore) MustFindContext(ctx context.Context, q *BQuery) *BResultSet {
+	return NewBResultSet(s.Store.MustFindContext(ctx, q))
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *BStore) Count(q *BQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *BStore) CountContext(ctx context.Context, q *BQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *BStore) MustCount(q *BQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *BStore) MustCountContext(ctx context.Context, q *BQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *BStore) FindOne(q *BQuery) (*B, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *BStore) FindOneContext(ctx context.Context, q *BQuery) (*B, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -858,7 +1335,13 @@ func (s *BStore) FindOne(q *BQuery) (*B, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *BStore) FindAll(q *BQuery) ([]*B, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *BStore) FindAllContext(ctx context.Context, q *BQuery) ([]*B, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -869,7 +1352,13 @@ func (s *BStore) FindAll(q *BQuery) ([]*B, error) {
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
 func (s *BStore) MustFindOne(q *BQuery) *B {
-	record, err := s.FindOne(q)
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *BStore) MustFindOneContext(ctx context.Context, q *BQuery) *B {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -882,16 +1371,28 @@ func (s *BStore) Reload(record *B) error {
 	return s.Store.Reload(Schema.B.BaseSchema, record)
 }
 
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *BStore) ReloadContext(ctx context.Context, record *B) error {
+	return s.Store.ReloadContext(ctx, Schema.B.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *BStore) Transaction(callback func(*BStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *BStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*BStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&BStore{store})
 	})
 }
@@ -899,6 +1400,12 @@ func (s *BStore) Transaction(callback func(*BStore) error) error {
 // RemoveC removes from the database the given relationship of the
 // model. It also resets the field C of the model.
 func (s *BStore) RemoveC(record *B) error {
+	return s.RemoveCContext(context.Background(), record)
+}
+
+// RemoveCContext is the same as RemoveC, but the given
+// context is used to run the queries.
+func (s *BStore) RemoveCContext(ctx context.Context, record *B) error {
 	var r kallax.Record = record.C
 	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 		if err := beforeDeleter.BeforeDelete(); err != nil {
@@ -908,8 +1415,8 @@ func (s *BStore) RemoveC(record *B) error {
 
 	var err error
 	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.C.BaseSchema, r)
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.C.BaseSchema, r)
 			if err != nil {
 				return err
 			}
@@ -917,7 +1424,7 @@ func (s *BStore) RemoveC(record *B) error {
 			return afterDeleter.AfterDelete()
 		})
 	} else {
-		err = s.Store.Delete(Schema.C.BaseSchema, r)
+		err = s.Store.DeleteContext(ctx, Schema.C.BaseSchema, r)
 	}
 	if err != nil {
 		return err
@@ -969,6 +1476,20 @@ func (q *BQuery) Order(cols ...kallax.ColumnOrder) *BQuery {
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *BQuery) After(cursor *kallax.Cursor) *BQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *BQuery) Before(cursor *kallax.Cursor) *BQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
 func (q *BQuery) BatchSize(size uint64) *BQuery {
@@ -995,16 +1516,70 @@ func (q *BQuery) Where(cond kallax.Condition) *BQuery {
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *BQuery) GroupBy(cols ...kallax.SchemaField) *BQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *BQuery) Having(cond kallax.Condition) *BQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *BQuery) ForUpdate() *BQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *BQuery) ForShare() *BQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *BQuery) NoWait() *BQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *BQuery) SkipLocked() *BQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 func (q *BQuery) WithA() *BQuery {
 	q.AddRelation(Schema.A.BaseSchema, "A", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereHasA filters the records whose A relationship has any
+// record matching the given condition, which can be nil.
+func (q *BQuery) WhereHasA(cond kallax.Condition) *BQuery {
+	q.Where(kallax.Has(Schema.A.BaseSchema, "A", cond))
+	return q
+}
+
 func (q *BQuery) WithC() *BQuery {
 	q.AddRelation(Schema.C.BaseSchema, "C", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereHasC filters the records whose C relationship has any
+// record matching the given condition, which can be nil.
+func (q *BQuery) WhereHasC(cond kallax.Condition) *BQuery {
+	q.Where(kallax.Has(Schema.C.BaseSchema, "C", cond))
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -1129,6 +1704,12 @@ func (rs *BResultSet) One() (*B, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *BResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
 func (rs *BResultSet) Err() error {
 	return rs.lastErr
@@ -1225,13 +1806,50 @@ func (s *BrandStore) DisableCacher() *BrandStore {
 	return &BrandStore{s.Store.DisableCacher()}
 }
 
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *BrandStore) WithDialect(dialect kallax.Dialect) *BrandStore {
+	return &BrandStore{s.Store.WithDialect(dialect)}
+}
+
 // Insert inserts a Brand in the database. A non-persisted object is
 // required for this operation.
 func (s *BrandStore) Insert(record *Brand) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *BrandStore) InsertContext(ctx context.Context, record *Brand) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.Brand.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.Brand.BaseSchema, record)
+}
+
+// InsertMany inserts all the given Brand in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *BrandStore) InsertMany(records []*Brand) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *BrandStore) InsertManyContext(ctx context.Context, records []*Brand) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.Brand.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -1241,20 +1859,32 @@ func (s *BrandStore) Insert(record *Brand) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *BrandStore) Update(record *Brand, cols ...kallax.SchemaField) (updated int64, err error) {
-	record.SetSaving(true)
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *BrandStore) UpdateContext(ctx context.Context, record *Brand, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.Brand.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.Brand.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *BrandStore) Save(record *Brand) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *BrandStore) SaveContext(ctx context.Context, record *Brand) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -1262,14 +1892,104 @@ func (s *BrandStore) Save(record *Brand) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *BrandStore) Upsert(record *Brand, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *BrandStore) UpsertContext(ctx context.Context, record *Brand, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.Brand.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
 func (s *BrandStore) Delete(record *Brand) error {
-	return s.Store.Delete(Schema.Brand.BaseSchema, record)
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *BrandStore) DeleteContext(ctx context.Context, record *Brand) error {
+	return s.Store.DeleteContext(ctx, Schema.Brand.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *BrandStore) UpdateWhere(q *BrandQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *BrandStore) UpdateWhereContext(ctx context.Context, q *BrandQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *BrandStore) UpdateWhereReturning(q *BrandQuery, values map[kallax.SchemaField]interface{}) (*BrandResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *BrandStore) UpdateWhereReturningContext(ctx context.Context, q *BrandQuery, values map[kallax.SchemaField]interface{}) (*BrandResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBrandResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *BrandStore) DeleteWhere(q *BrandQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *BrandStore) DeleteWhereContext(ctx context.Context, q *BrandQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *BrandStore) DeleteWhereReturning(q *BrandQuery) (*BrandResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *BrandStore) DeleteWhereReturningContext(ctx context.Context, q *BrandQuery) (*BrandResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBrandResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
 func (s *BrandStore) Find(q *BrandQuery) (*BrandResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *BrandStore) FindContext(ctx context.Context, q *BrandQuery) (*BrandResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1283,24 +2003,48 @@ func (s *BrandStore) MustFind(q *BrandQuery) *BrandResultSet {
 	return NewBrandResultSet(s.Store.MustFind(q))
 }
 
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *BrandStore) MustFindContext(ctx context.Context, q *BrandQuery) *BrandResultSet {
+	return NewBrandResultSet(s.Store.MustFindContext(ctx, q))
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *BrandStore) Count(q *BrandQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *BrandStore) CountContext(ctx context.Context, q *BrandQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *BrandStore) MustCount(q *BrandQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *BrandStore) MustCountContext(ctx context.Context, q *BrandQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *BrandStore) FindOne(q *BrandQuery) (*Brand, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *BrandStore) FindOneContext(ctx context.Context, q *BrandQuery) (*Brand, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1323,7 +2067,13 @@ func (s *BrandStore) FindOne(q *BrandQuery) (*Brand, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *BrandStore) FindAll(q *BrandQuery) ([]*Brand, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *BrandStore) FindAllContext(ctx context.Context, q *BrandQuery) ([]*Brand, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1334,7 +2084,13 @@ func (s *BrandStore) FindAll(q *BrandQuery) ([]*Brand, error) {
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
 func (s *BrandStore) MustFindOne(q *BrandQuery) *Brand {
-	record, err := s.FindOne(q)
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *BrandStore) MustFindOneContext(ctx context.Context, q *BrandQuery) *Brand {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -1347,16 +2103,28 @@ func (s *BrandStore) Reload(record *Brand) error {
 	return s.Store.Reload(Schema.Brand.BaseSchema, record)
 }
 
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *BrandStore) ReloadContext(ctx context.Context, record *Brand) error {
+	return s.Store.ReloadContext(ctx, Schema.Brand.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *BrandStore) Transaction(callback func(*BrandStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *BrandStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*BrandStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&BrandStore{store})
 	})
 }
@@ -1403,6 +2171,20 @@ func (q *BrandQuery) Order(cols ...kallax.ColumnOrder) *BrandQuery {
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *BrandQuery) After(cursor *kallax.Cursor) *BrandQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *BrandQuery) Before(cursor *kallax.Cursor) *BrandQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
 func (q *BrandQuery) BatchSize(size uint64) *BrandQuery {
@@ -1429,6 +2211,46 @@ func (q *BrandQuery) Where(cond kallax.Condition) *BrandQuery {
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *BrandQuery) GroupBy(cols ...kallax.SchemaField) *BrandQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *BrandQuery) Having(cond kallax.Condition) *BrandQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *BrandQuery) ForUpdate() *BrandQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *BrandQuery) ForShare() *BrandQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *BrandQuery) NoWait() *BrandQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *BrandQuery) SkipLocked() *BrandQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -1547,6 +2369,12 @@ func (rs *BrandResultSet) One() (*Brand, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *BrandResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
 func (rs *BrandResultSet) Err() error {
 	return rs.lastErr
@@ -1669,13 +2497,19 @@ func (s *CStore) DisableCacher() *CStore {
 	return &CStore{s.Store.DisableCacher()}
 }
 
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *CStore) WithDialect(dialect kallax.Dialect) *CStore {
+	return &CStore{s.Store.WithDialect(dialect)}
+}
+
 func (s *CStore) inverseRecords(record *C) []modelSaveFunc {
 	var result []modelSaveFunc
 
 	if record.B != nil && !record.B.IsSaving() {
 		record.AddVirtualColumn("b_id", record.B.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&BStore{store}).Save(record.B)
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&BStore{store}).SaveContext(ctx, record.B)
 			return err
 		})
 	}
@@ -1686,20 +2520,72 @@ func (s *CStore) inverseRecords(record *C) []modelSaveFunc {
 // Insert inserts a C in the database. A non-persisted object is
 // required for this operation.
 func (s *CStore) Insert(record *C) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *CStore) InsertContext(ctx context.Context, record *C) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertContext(ctx, Schema.C.BaseSchema, record); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.C.BaseSchema, record)
+}
+
+// InsertMany inserts all the given C in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *CStore) InsertMany(records []*C) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *CStore) InsertManyContext(ctx context.Context, records []*C) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	if len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			if err := s.Insert(Schema.C.BaseSchema, record); err != nil {
+			if err := s.InsertManyContext(ctx, Schema.C.BaseSchema, recs...); err != nil {
 				return err
 			}
 
@@ -1707,7 +2593,7 @@ func (s *CStore) Insert(record *C) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.C.BaseSchema, record)
+	return s.Store.InsertManyContext(ctx, Schema.C.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -1717,20 +2603,26 @@ func (s *CStore) Insert(record *C) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *CStore) Update(record *C, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *CStore) UpdateContext(ctx context.Context, record *C, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			updated, err = s.Update(Schema.C.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.C.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -1744,17 +2636,23 @@ func (s *CStore) Update(record *C, cols ...kallax.SchemaField) (updated int64, e
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.C.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.C.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *CStore) Save(record *C) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *CStore) SaveContext(ctx context.Context, record *C) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -1762,14 +2660,104 @@ func (s *CStore) Save(record *C) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *CStore) Upsert(record *C, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *CStore) UpsertContext(ctx context.Context, record *C, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.C.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
 func (s *CStore) Delete(record *C) error {
-	return s.Store.Delete(Schema.C.BaseSchema, record)
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *CStore) DeleteContext(ctx context.Context, record *C) error {
+	return s.Store.DeleteContext(ctx, Schema.C.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *CStore) UpdateWhere(q *CQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *CStore) UpdateWhereContext(ctx context.Context, q *CQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *CStore) UpdateWhereReturning(q *CQuery, values map[kallax.SchemaField]interface{}) (*CResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *CStore) UpdateWhereReturningContext(ctx context.Context, q *CQuery, values map[kallax.SchemaField]interface{}) (*CResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *CStore) DeleteWhere(q *CQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *CStore) DeleteWhereContext(ctx context.Context, q *CQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *CStore) DeleteWhereReturning(q *CQuery) (*CResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *CStore) DeleteWhereReturningContext(ctx context.Context, q *CQuery) (*CResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
 func (s *CStore) Find(q *CQuery) (*CResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *CStore) FindContext(ctx context.Context, q *CQuery) (*CResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1783,24 +2771,48 @@ func (s *CStore) MustFind(q *CQuery) *CResultSet {
 	return NewCResultSet(s.Store.MustFind(q))
 }
 
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *CStore) MustFindContext(ctx context.Context, q *CQuery) *CResultSet {
+	return NewCResultSet(s.Store.MustFindContext(ctx, q))
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *CStore) Count(q *CQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *CStore) CountContext(ctx context.Context, q *CQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *CStore) MustCount(q *CQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *CStore) MustCountContext(ctx context.Context, q *CQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *CStore) FindOne(q *CQuery) (*C, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *CStore) FindOneContext(ctx context.Context, q *CQuery) (*C, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1823,7 +2835,13 @@ func (s *CStore) FindOne(q *CQuery) (*C, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *CStore) FindAll(q *CQuery) ([]*C, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *CStore) FindAllContext(ctx context.Context, q *CQuery) ([]*C, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1834,7 +2852,13 @@ func (s *CStore) FindAll(q *CQuery) ([]*C, error) {
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
 func (s *CStore) MustFindOne(q *CQuery) *C {
-	record, err := s.FindOne(q)
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *CStore) MustFindOneContext(ctx context.Context, q *CQuery) *C {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -1847,16 +2871,28 @@ func (s *CStore) Reload(record *C) error {
 	return s.Store.Reload(Schema.C.BaseSchema, record)
 }
 
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *CStore) ReloadContext(ctx context.Context, record *C) error {
+	return s.Store.ReloadContext(ctx, Schema.C.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *CStore) Transaction(callback func(*CStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *CStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*CStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&CStore{store})
 	})
 }
@@ -1903,6 +2939,20 @@ func (q *CQuery) Order(cols ...kallax.ColumnOrder) *CQuery {
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *CQuery) After(cursor *kallax.Cursor) *CQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *CQuery) Before(cursor *kallax.Cursor) *CQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
 func (q *CQuery) BatchSize(size uint64) *CQuery {
@@ -1929,11 +2979,58 @@ func (q *CQuery) Where(cond kallax.Condition) *CQuery {
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *CQuery) GroupBy(cols ...kallax.SchemaField) *CQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *CQuery) Having(cond kallax.Condition) *CQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *CQuery) ForUpdate() *CQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *CQuery) ForShare() *CQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *CQuery) NoWait() *CQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *CQuery) SkipLocked() *CQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 func (q *CQuery) WithB() *CQuery {
 	q.AddRelation(Schema.B.BaseSchema, "B", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereHasB filters the records whose B relationship has any
+// record matching the given condition, which can be nil.
+func (q *CQuery) WhereHasB(cond kallax.Condition) *CQuery {
+	q.Where(kallax.Has(Schema.B.BaseSchema, "B", cond))
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -2058,6 +3155,12 @@ func (rs *CResultSet) One() (*C, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *CResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
 func (rs *CResultSet) Err() error {
 	return rs.lastErr
@@ -2197,21 +3300,27 @@ func (s *CarStore) DisableCacher() *CarStore {
 	return &CarStore{s.Store.DisableCacher()}
 }
 
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *CarStore) WithDialect(dialect kallax.Dialect) *CarStore {
+	return &CarStore{s.Store.WithDialect(dialect)}
+}
+
 func (s *CarStore) inverseRecords(record *Car) []modelSaveFunc {
 	var result []modelSaveFunc
 
 	if record.Owner != nil && !record.Owner.IsSaving() {
 		record.AddVirtualColumn("owner_id", record.Owner.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&PersonStore{store}).Save(record.Owner)
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&PersonStore{store}).SaveContext(ctx, record.Owner)
 			return err
 		})
 	}
 
 	if !record.Brand.GetID().IsEmpty() && !record.Brand.IsSaving() {
 		record.AddVirtualColumn("brand_id", record.Brand.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&BrandStore{store}).Save(&record.Brand)
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&BrandStore{store}).SaveContext(ctx, &record.Brand)
 			return err
 		})
 	}
@@ -2222,6 +3331,12 @@ func (s *CarStore) inverseRecords(record *Car) []modelSaveFunc {
 // Insert inserts a Car in the database. A non-persisted object is
 // required for this operation.
 func (s *CarStore) Insert(record *Car) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *CarStore) InsertContext(ctx context.Context, record *Car) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
@@ -2232,14 +3347,14 @@ func (s *CarStore) Insert(record *Car) error {
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			if err := s.Insert(Schema.Car.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.Car.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -2251,8 +3366,8 @@ func (s *CarStore) Insert(record *Car) error {
 		})
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.Car.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.Car.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -2264,6 +3379,52 @@ func (s *CarStore) Insert(record *Car) error {
 	})
 }
 
+// InsertMany inserts all the given Car in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *CarStore) InsertMany(records []*Car) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *CarStore) InsertManyContext(ctx context.Context, records []*Car) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	if len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertManyContext(ctx, Schema.Car.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.Car.BaseSchema, recs...)
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -2271,6 +3432,12 @@ func (s *CarStore) Insert(record *Car) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *CarStore) UpdateContext(ctx context.Context, record *Car, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
@@ -2281,14 +3448,14 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			updated, err = s.Update(Schema.Car.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.Car.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -2306,8 +3473,8 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 		return updated, nil
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.Car.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.Car.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -2328,11 +3495,17 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *CarStore) Save(record *Car) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *CarStore) SaveContext(ctx context.Context, record *Car) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -2340,14 +3513,48 @@ func (s *CarStore) Save(record *Car) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *CarStore) Upsert(record *Car, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *CarStore) UpsertContext(ctx context.Context, record *Car, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.Car.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		return record.AfterSave()
+	})
+}
+
 // Delete removes the given record from the database.
 func (s *CarStore) Delete(record *Car) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *CarStore) DeleteContext(ctx context.Context, record *Car) error {
 	if err := record.BeforeDelete(); err != nil {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		err := s.Delete(Schema.Car.BaseSchema, record)
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		err := s.DeleteContext(ctx, Schema.Car.BaseSchema, record)
 		if err != nil {
 			return err
 		}
@@ -2356,12 +3563,78 @@ func (s *CarStore) Delete(record *Car) error {
 	})
 }
 
-// Find returns the set of results for the given query.
-func (s *CarStore) Find(q *CarQuery) (*CarResultSet, error) {
-	rs, err := s.Store.Find(q)
-	if err != nil {
-		return nil, err
-	}
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *CarStore) UpdateWhere(q *CarQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *CarStore) UpdateWhereContext(ctx context.Context, q *CarQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *CarStore) UpdateWhereReturning(q *CarQuery, values map[kallax.SchemaField]interface{}) (*CarResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *CarStore) UpdateWhereReturningContext(ctx context.Context, q *CarQuery, values map[kallax.SchemaField]interface{}) (*CarResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCarResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *CarStore) DeleteWhere(q *CarQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *CarStore) DeleteWhereContext(ctx context.Context, q *CarQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *CarStore) DeleteWhereReturning(q *CarQuery) (*CarResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *CarStore) DeleteWhereReturningContext(ctx context.Context, q *CarQuery) (*CarResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCarResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *CarStore) Find(q *CarQuery) (*CarResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *CarStore) FindContext(ctx context.Context, q *CarQuery) (*CarResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewCarResultSet(rs), nil
 }
@@ -2372,24 +3645,48 @@ func (s *CarStore) MustFind(q *CarQuery) *CarResultSet {
 	return NewCarResultSet(s.Store.MustFind(q))
 }
 
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *CarStore) MustFindContext(ctx context.Context, q *CarQuery) *CarResultSet {
+	return NewCarResultSet(s.Store.MustFindContext(ctx, q))
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *CarStore) Count(q *CarQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *CarStore) CountContext(ctx context.Context, q *CarQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *CarStore) MustCount(q *CarQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *CarStore) MustCountContext(ctx context.Context, q *CarQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *CarStore) FindOne(q *CarQuery) (*Car, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *CarStore) FindOneContext(ctx context.Context, q *CarQuery) (*Car, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2412,7 +3709,13 @@ func (s *CarStore) FindOne(q *CarQuery) (*Car, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *CarStore) FindAll(q *CarQuery) ([]*Car, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *CarStore) FindAllContext(ctx context.Context, q *CarQuery) ([]*Car, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2423,7 +3726,13 @@ func (s *CarStore) FindAll(q *CarQuery) ([]*Car, error) {
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
 func (s *CarStore) MustFindOne(q *CarQuery) *Car {
-	record, err := s.FindOne(q)
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *CarStore) MustFindOneContext(ctx context.Context, q *CarQuery) *Car {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -2436,16 +3745,28 @@ func (s *CarStore) Reload(record *Car) error {
 	return s.Store.Reload(Schema.Car.BaseSchema, record)
 }
 
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *CarStore) ReloadContext(ctx context.Context, record *Car) error {
+	return s.Store.ReloadContext(ctx, Schema.Car.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *CarStore) Transaction(callback func(*CarStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *CarStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*CarStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&CarStore{store})
 	})
 }
@@ -2492,6 +3813,20 @@ func (q *CarQuery) Order(cols ...kallax.ColumnOrder) *CarQuery {
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *CarQuery) After(cursor *kallax.Cursor) *CarQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *CarQuery) Before(cursor *kallax.Cursor) *CarQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
 func (q *CarQuery) BatchSize(size uint64) *CarQuery {
@@ -2518,16 +3853,70 @@ func (q *CarQuery) Where(cond kallax.Condition) *CarQuery {
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *CarQuery) GroupBy(cols ...kallax.SchemaField) *CarQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *CarQuery) Having(cond kallax.Condition) *CarQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *CarQuery) ForUpdate() *CarQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *CarQuery) ForShare() *CarQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *CarQuery) NoWait() *CarQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *CarQuery) SkipLocked() *CarQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 func (q *CarQuery) WithOwner() *CarQuery {
 	q.AddRelation(Schema.Person.BaseSchema, "Owner", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereHasOwner filters the records whose Owner relationship has any
+// record matching the given condition, which can be nil.
+func (q *CarQuery) WhereHasOwner(cond kallax.Condition) *CarQuery {
+	q.Where(kallax.Has(Schema.Person.BaseSchema, "Owner", cond))
+	return q
+}
+
 func (q *CarQuery) WithBrand() *CarQuery {
 	q.AddRelation(Schema.Brand.BaseSchema, "Brand", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereHasBrand filters the records whose Brand relationship has any
+// record matching the given condition, which can be nil.
+func (q *CarQuery) WhereHasBrand(cond kallax.Condition) *CarQuery {
+	q.Where(kallax.Has(Schema.Brand.BaseSchema, "Brand", cond))
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -2658,6 +4047,12 @@ func (rs *CarResultSet) One() (*Car, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *CarResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
 func (rs *CarResultSet) Err() error {
 	return rs.lastErr
@@ -2762,13 +4157,50 @@ func (s *ChildStore) DisableCacher() *ChildStore {
 	return &ChildStore{s.Store.DisableCacher()}
 }
 
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *ChildStore) WithDialect(dialect kallax.Dialect) *ChildStore {
+	return &ChildStore{s.Store.WithDialect(dialect)}
+}
+
 // Insert inserts a Child in the database. A non-persisted object is
 // required for this operation.
 func (s *ChildStore) Insert(record *Child) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *ChildStore) InsertContext(ctx context.Context, record *Child) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.Child.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.Child.BaseSchema, record)
+}
+
+// InsertMany inserts all the given Child in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *ChildStore) InsertMany(records []*Child) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *ChildStore) InsertManyContext(ctx context.Context, records []*Child) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.Child.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -2778,20 +4210,32 @@ func (s *ChildStore) Insert(record *Child) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *ChildStore) Update(record *Child, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *ChildStore) UpdateContext(ctx context.Context, record *Child, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.Child.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.Child.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *ChildStore) Save(record *Child) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *ChildStore) SaveContext(ctx context.Context, record *Child) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -2799,14 +4243,104 @@ func (s *ChildStore) Save(record *Child) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *ChildStore) Upsert(record *Child, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *ChildStore) UpsertContext(ctx context.Context, record *Child, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.Child.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
 func (s *ChildStore) Delete(record *Child) error {
-	return s.Store.Delete(Schema.Child.BaseSchema, record)
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *ChildStore) DeleteContext(ctx context.Context, record *Child) error {
+	return s.Store.DeleteContext(ctx, Schema.Child.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *ChildStore) UpdateWhere(q *ChildQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *ChildStore) UpdateWhereContext(ctx context.Context, q *ChildQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *ChildStore) UpdateWhereReturning(q *ChildQuery, values map[kallax.SchemaField]interface{}) (*ChildResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *ChildStore) UpdateWhereReturningContext(ctx context.Context, q *ChildQuery, values map[kallax.SchemaField]interface{}) (*ChildResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChildResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *ChildStore) DeleteWhere(q *ChildQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *ChildStore) DeleteWhereContext(ctx context.Context, q *ChildQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *ChildStore) DeleteWhereReturning(q *ChildQuery) (*ChildResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *ChildStore) DeleteWhereReturningContext(ctx context.Context, q *ChildQuery) (*ChildResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChildResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
 func (s *ChildStore) Find(q *ChildQuery) (*ChildResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *ChildStore) FindContext(ctx context.Context, q *ChildQuery) (*ChildResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2820,24 +4354,48 @@ func (s *ChildStore) MustFind(q *ChildQuery) *ChildResultSet {
 	return NewChildResultSet(s.Store.MustFind(q))
 }
 
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *ChildStore) MustFindContext(ctx context.Context, q *ChildQuery) *ChildResultSet {
+	return NewChildResultSet(s.Store.MustFindContext(ctx, q))
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *ChildStore) Count(q *ChildQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *ChildStore) CountContext(ctx context.Context, q *ChildQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *ChildStore) MustCount(q *ChildQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *ChildStore) MustCountContext(ctx context.Context, q *ChildQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *ChildStore) FindOne(q *ChildQuery) (*Child, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *ChildStore) FindOneContext(ctx context.Context, q *ChildQuery) (*Child, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2860,7 +4418,13 @@ func (s *ChildStore) FindOne(q *ChildQuery) (*Child, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *ChildStore) FindAll(q *ChildQuery) ([]*Child, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *ChildStore) FindAllContext(ctx context.Context, q *ChildQuery) ([]*Child, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2871,7 +4435,13 @@ func (s *ChildStore) FindAll(q *ChildQuery) ([]*Child, error) {
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
 func (s *ChildStore) MustFindOne(q *ChildQuery) *Child {
-	record, err := s.FindOne(q)
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *ChildStore) MustFindOneContext(ctx context.Context, q *ChildQuery) *Child {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
@@ -2884,16 +4454,28 @@ func (s *ChildStore) Reload(record *Child) error {
 	return s.Store.Reload(Schema.Child.BaseSchema, record)
 }
 
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *ChildStore) ReloadContext(ctx context.Context, record *Child) error {
+	return s.Store.ReloadContext(ctx, Schema.Child.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *ChildStore) Transaction(callback func(*ChildStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *ChildStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*ChildStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&ChildStore{store})
 	})
 }
@@ -2940,6 +4522,20 @@ func (q *ChildQuery) Order(cols ...kallax.ColumnOrder) *ChildQuery {
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *ChildQuery) After(cursor *kallax.Cursor) *ChildQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *ChildQuery) Before(cursor *kallax.Cursor) *ChildQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
 func (q *ChildQuery) BatchSize(size uint64) *ChildQuery {
@@ -2966,6 +4562,46 @@ func (q *ChildQuery) Where(cond kallax.Condition) *ChildQuery {
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *ChildQuery) GroupBy(cols ...kallax.SchemaField) *ChildQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *ChildQuery) Having(cond kallax.Condition) *ChildQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *ChildQuery) ForUpdate() *ChildQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *ChildQuery) ForShare() *ChildQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *ChildQuery) NoWait() *ChildQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *ChildQuery) SkipLocked() *ChildQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -3084,6 +4720,12 @@ func (rs *ChildResultSet) One() (*Child, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *ChildResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
 func (rs *ChildResultSet) Err() error {
 	return rs.lastErr
@@ -3094,129 +4736,140 @@ func (rs *ChildResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewEventsAllFixture returns a new instance of EventsAllFixture.
-func NewEventsAllFixture() (record *EventsAllFixture) {
-	return newEventsAllFixture()
+// NewCustomVersionedFixture returns a new instance of CustomVersionedFixture.
+func NewCustomVersionedFixture(foo string) (record *CustomVersionedFixture) {
+	return newCustomVersionedFixture(foo)
 }
 
 // GetID returns the primary key of the model.
-func (r *EventsAllFixture) GetID() kallax.Identifier {
+func (r *CustomVersionedFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *EventsAllFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *CustomVersionedFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
-	case "checks":
-		return types.JSON(&r.Checks), nil
-	case "must_fail_before":
-		return types.JSON(&r.MustFailBefore), nil
-	case "must_fail_after":
-		return types.JSON(&r.MustFailAfter), nil
+	case "rev":
+		return &r.Rev, nil
+	case "foo":
+		return &r.Foo, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in EventsAllFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in CustomVersionedFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *EventsAllFixture) Value(col string) (interface{}, error) {
+func (r *CustomVersionedFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "checks":
-		return types.JSON(r.Checks), nil
-	case "must_fail_before":
-		return types.JSON(r.MustFailBefore), nil
-	case "must_fail_after":
-		return types.JSON(r.MustFailAfter), nil
+	case "rev":
+		return r.Rev, nil
+	case "foo":
+		return r.Foo, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in EventsAllFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in CustomVersionedFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *EventsAllFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model EventsAllFixture has no relationships")
+func (r *CustomVersionedFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model CustomVersionedFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *EventsAllFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model EventsAllFixture has no relationships")
+func (r *CustomVersionedFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model CustomVersionedFixture has no relationships")
 }
 
-// EventsAllFixtureStore is the entity to access the records of the type EventsAllFixture
+// CustomVersionedFixtureStore is the entity to access the records of the type CustomVersionedFixture
 // in the database.
-type EventsAllFixtureStore struct {
+type CustomVersionedFixtureStore struct {
 	*kallax.Store
 }
 
-// NewEventsAllFixtureStore creates a new instance of EventsAllFixtureStore
+// NewCustomVersionedFixtureStore creates a new instance of CustomVersionedFixtureStore
 // using a SQL database.
-func NewEventsAllFixtureStore(db *sql.DB) *EventsAllFixtureStore {
-	return &EventsAllFixtureStore{kallax.NewStore(db)}
+func NewCustomVersionedFixtureStore(db *sql.DB) *CustomVersionedFixtureStore {
+	return &CustomVersionedFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *EventsAllFixtureStore) GenericStore() *kallax.Store {
+func (s *CustomVersionedFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *EventsAllFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *CustomVersionedFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *EventsAllFixtureStore) Debug() *EventsAllFixtureStore {
-	return &EventsAllFixtureStore{s.Store.Debug()}
+func (s *CustomVersionedFixtureStore) Debug() *CustomVersionedFixtureStore {
+	return &CustomVersionedFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *EventsAllFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsAllFixtureStore {
-	return &EventsAllFixtureStore{s.Store.DebugWith(logger)}
+func (s *CustomVersionedFixtureStore) DebugWith(logger kallax.LoggerFunc) *CustomVersionedFixtureStore {
+	return &CustomVersionedFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *EventsAllFixtureStore) DisableCacher() *EventsAllFixtureStore {
-	return &EventsAllFixtureStore{s.Store.DisableCacher()}
+func (s *CustomVersionedFixtureStore) DisableCacher() *CustomVersionedFixtureStore {
+	return &CustomVersionedFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a EventsAllFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *CustomVersionedFixtureStore) WithDialect(dialect kallax.Dialect) *CustomVersionedFixtureStore {
+	return &CustomVersionedFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a CustomVersionedFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
+func (s *CustomVersionedFixtureStore) Insert(record *CustomVersionedFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *CustomVersionedFixtureStore) InsertContext(ctx context.Context, record *CustomVersionedFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeSave(); err != nil {
-		return err
-	}
+	return s.Store.InsertContext(ctx, Schema.CustomVersionedFixture.BaseSchema, record)
+}
 
-	if err := record.BeforeInsert(); err != nil {
-		return err
-	}
+// InsertMany inserts all the given CustomVersionedFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *CustomVersionedFixtureStore) InsertMany(records []*CustomVersionedFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.EventsAllFixture.BaseSchema, record); err != nil {
-			return err
-		}
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *CustomVersionedFixtureStore) InsertManyContext(ctx context.Context, records []*CustomVersionedFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
 
-		if err := record.AfterInsert(); err != nil {
-			return err
-		}
+		recs[i] = record
+	}
 
-		if err := record.AfterSave(); err != nil {
-			return err
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
 		}
+	}()
 
-		return nil
-	})
+	return s.Store.InsertManyContext(ctx, Schema.CustomVersionedFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -3225,95 +4878,193 @@ func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *EventsAllFixtureStore) Update(record *EventsAllFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *CustomVersionedFixtureStore) Update(record *CustomVersionedFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *CustomVersionedFixtureStore) UpdateContext(ctx context.Context, record *CustomVersionedFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeSave(); err != nil {
-		return 0, err
-	}
+	return s.Store.UpdateContext(ctx, Schema.CustomVersionedFixture.BaseSchema, record, cols...)
+}
 
-	if err := record.BeforeUpdate(); err != nil {
-		return 0, err
-	}
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *CustomVersionedFixtureStore) Save(record *CustomVersionedFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.EventsAllFixture.BaseSchema, record, cols...)
-		if err != nil {
-			return err
-		}
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *CustomVersionedFixtureStore) SaveContext(ctx context.Context, record *CustomVersionedFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
 
-		if err := record.AfterUpdate(); err != nil {
-			return err
-		}
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
 
-		if err := record.AfterSave(); err != nil {
-			return err
-		}
+	return rowsUpdated > 0, nil
+}
 
-		return nil
-	})
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *CustomVersionedFixtureStore) Upsert(record *CustomVersionedFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *CustomVersionedFixtureStore) UpsertContext(ctx context.Context, record *CustomVersionedFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.CustomVersionedFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *CustomVersionedFixtureStore) Delete(record *CustomVersionedFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *CustomVersionedFixtureStore) DeleteContext(ctx context.Context, record *CustomVersionedFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.CustomVersionedFixture.BaseSchema, record)
+}
 
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *CustomVersionedFixtureStore) UpdateWhere(q *CustomVersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *CustomVersionedFixtureStore) UpdateWhereContext(ctx context.Context, q *CustomVersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *CustomVersionedFixtureStore) UpdateWhereReturning(q *CustomVersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (*CustomVersionedFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *CustomVersionedFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *CustomVersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (*CustomVersionedFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return updated, nil
+
+	return NewCustomVersionedFixtureResultSet(rs), nil
 }
 
-// Save inserts the object if the record is not persisted, otherwise it updates
-// it. Same rules of Update and Insert apply depending on the case.
-func (s *EventsAllFixtureStore) Save(record *EventsAllFixture) (updated bool, err error) {
-	if !record.IsPersisted() {
-		return false, s.Insert(record)
-	}
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *CustomVersionedFixtureStore) DeleteWhere(q *CustomVersionedFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
 
-	rowsUpdated, err := s.Update(record)
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *CustomVersionedFixtureStore) DeleteWhereContext(ctx context.Context, q *CustomVersionedFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *CustomVersionedFixtureStore) DeleteWhereReturning(q *CustomVersionedFixtureQuery) (*CustomVersionedFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *CustomVersionedFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *CustomVersionedFixtureQuery) (*CustomVersionedFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return rowsUpdated > 0, nil
+	return NewCustomVersionedFixtureResultSet(rs), nil
 }
 
-// Delete removes the given record from the database.
-func (s *EventsAllFixtureStore) Delete(record *EventsAllFixture) error {
-	return s.Store.Delete(Schema.EventsAllFixture.BaseSchema, record)
+// Find returns the set of results for the given query.
+func (s *CustomVersionedFixtureStore) Find(q *CustomVersionedFixtureQuery) (*CustomVersionedFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
 }
 
-// Find returns the set of results for the given query.
-func (s *EventsAllFixtureStore) Find(q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *CustomVersionedFixtureStore) FindContext(ctx context.Context, q *CustomVersionedFixtureQuery) (*CustomVersionedFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewEventsAllFixtureResultSet(rs), nil
+	return NewCustomVersionedFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *EventsAllFixtureStore) MustFind(q *EventsAllFixtureQuery) *EventsAllFixtureResultSet {
-	return NewEventsAllFixtureResultSet(s.Store.MustFind(q))
+func (s *CustomVersionedFixtureStore) MustFind(q *CustomVersionedFixtureQuery) *CustomVersionedFixtureResultSet {
+	return NewCustomVersionedFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *CustomVersionedFixtureStore) MustFindContext(ctx context.Context, q *CustomVersionedFixtureQuery) *CustomVersionedFixtureResultSet {
+	return NewCustomVersionedFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *EventsAllFixtureStore) Count(q *EventsAllFixtureQuery) (int64, error) {
+func (s *CustomVersionedFixtureStore) Count(q *CustomVersionedFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *CustomVersionedFixtureStore) CountContext(ctx context.Context, q *CustomVersionedFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *EventsAllFixtureStore) MustCount(q *EventsAllFixtureQuery) int64 {
+func (s *CustomVersionedFixtureStore) MustCount(q *CustomVersionedFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *CustomVersionedFixtureStore) MustCountContext(ctx context.Context, q *CustomVersionedFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *EventsAllFixtureStore) FindOne(q *EventsAllFixtureQuery) (*EventsAllFixture, error) {
+func (s *CustomVersionedFixtureStore) FindOne(q *CustomVersionedFixtureQuery) (*CustomVersionedFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *CustomVersionedFixtureStore) FindOneContext(ctx context.Context, q *CustomVersionedFixtureQuery) (*CustomVersionedFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3335,8 +5086,14 @@ func (s *EventsAllFixtureStore) FindOne(q *EventsAllFixtureQuery) (*EventsAllFix
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *EventsAllFixtureStore) FindAll(q *EventsAllFixtureQuery) ([]*EventsAllFixture, error) {
-	rs, err := s.Find(q)
+func (s *CustomVersionedFixtureStore) FindAll(q *CustomVersionedFixtureQuery) ([]*CustomVersionedFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *CustomVersionedFixtureStore) FindAllContext(ctx context.Context, q *CustomVersionedFixtureQuery) ([]*CustomVersionedFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3346,49 +5103,67 @@ func (s *EventsAllFixtureStore) FindAll(q *EventsAllFixtureQuery) ([]*EventsAllF
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *EventsAllFixtureStore) MustFindOne(q *EventsAllFixtureQuery) *EventsAllFixture {
-	record, err := s.FindOne(q)
+func (s *CustomVersionedFixtureStore) MustFindOne(q *CustomVersionedFixtureQuery) *CustomVersionedFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *CustomVersionedFixtureStore) MustFindOneContext(ctx context.Context, q *CustomVersionedFixtureQuery) *CustomVersionedFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the EventsAllFixture with the data in the database and
+// Reload refreshes the CustomVersionedFixture with the data in the database and
 // makes it writable.
-func (s *EventsAllFixtureStore) Reload(record *EventsAllFixture) error {
-	return s.Store.Reload(Schema.EventsAllFixture.BaseSchema, record)
+func (s *CustomVersionedFixtureStore) Reload(record *CustomVersionedFixture) error {
+	return s.Store.Reload(Schema.CustomVersionedFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *CustomVersionedFixtureStore) ReloadContext(ctx context.Context, record *CustomVersionedFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.CustomVersionedFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *EventsAllFixtureStore) Transaction(callback func(*EventsAllFixtureStore) error) error {
+func (s *CustomVersionedFixtureStore) Transaction(callback func(*CustomVersionedFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *CustomVersionedFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*CustomVersionedFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&EventsAllFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&CustomVersionedFixtureStore{store})
 	})
 }
 
-// EventsAllFixtureQuery is the object used to create queries for the EventsAllFixture
+// CustomVersionedFixtureQuery is the object used to create queries for the CustomVersionedFixture
 // entity.
-type EventsAllFixtureQuery struct {
+type CustomVersionedFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewEventsAllFixtureQuery returns a new instance of EventsAllFixtureQuery.
-func NewEventsAllFixtureQuery() *EventsAllFixtureQuery {
-	return &EventsAllFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.EventsAllFixture.BaseSchema),
+// NewCustomVersionedFixtureQuery returns a new instance of CustomVersionedFixtureQuery.
+func NewCustomVersionedFixtureQuery() *CustomVersionedFixtureQuery {
+	return &CustomVersionedFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.CustomVersionedFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *EventsAllFixtureQuery) Select(columns ...kallax.SchemaField) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) Select(columns ...kallax.SchemaField) *CustomVersionedFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -3397,55 +5172,109 @@ func (q *EventsAllFixtureQuery) Select(columns ...kallax.SchemaField) *EventsAll
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *EventsAllFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) SelectNot(columns ...kallax.SchemaField) *CustomVersionedFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *EventsAllFixtureQuery) Copy() *EventsAllFixtureQuery {
-	return &EventsAllFixtureQuery{
+func (q *CustomVersionedFixtureQuery) Copy() *CustomVersionedFixtureQuery {
+	return &CustomVersionedFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *EventsAllFixtureQuery) Order(cols ...kallax.ColumnOrder) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) Order(cols ...kallax.ColumnOrder) *CustomVersionedFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *CustomVersionedFixtureQuery) After(cursor *kallax.Cursor) *CustomVersionedFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *CustomVersionedFixtureQuery) Before(cursor *kallax.Cursor) *CustomVersionedFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *EventsAllFixtureQuery) BatchSize(size uint64) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) BatchSize(size uint64) *CustomVersionedFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *EventsAllFixtureQuery) Limit(n uint64) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) Limit(n uint64) *CustomVersionedFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *EventsAllFixtureQuery) Offset(n uint64) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) Offset(n uint64) *CustomVersionedFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *EventsAllFixtureQuery) Where(cond kallax.Condition) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) Where(cond kallax.Condition) *CustomVersionedFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *CustomVersionedFixtureQuery) GroupBy(cols ...kallax.SchemaField) *CustomVersionedFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *CustomVersionedFixtureQuery) Having(cond kallax.Condition) *CustomVersionedFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *CustomVersionedFixtureQuery) ForUpdate() *CustomVersionedFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *CustomVersionedFixtureQuery) ForShare() *CustomVersionedFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *CustomVersionedFixtureQuery) NoWait() *CustomVersionedFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *CustomVersionedFixtureQuery) SkipLocked() *CustomVersionedFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *EventsAllFixtureQuery) FindByID(v ...kallax.ULID) *EventsAllFixtureQuery {
+func (q *CustomVersionedFixtureQuery) FindByID(v ...kallax.ULID) *CustomVersionedFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -3453,27 +5282,39 @@ func (q *EventsAllFixtureQuery) FindByID(v ...kallax.ULID) *EventsAllFixtureQuer
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.EventsAllFixture.ID, values...))
+	return q.Where(kallax.In(Schema.CustomVersionedFixture.ID, values...))
 }
 
-// EventsAllFixtureResultSet is the set of results returned by a query to the
+// FindByRev adds a new filter to the query that will require that
+// the Rev property is equal to the passed value.
+func (q *CustomVersionedFixtureQuery) FindByRev(cond kallax.ScalarCond, v int32) *CustomVersionedFixtureQuery {
+	return q.Where(cond(Schema.CustomVersionedFixture.Rev, v))
+}
+
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *CustomVersionedFixtureQuery) FindByFoo(v string) *CustomVersionedFixtureQuery {
+	return q.Where(kallax.Eq(Schema.CustomVersionedFixture.Foo, v))
+}
+
+// CustomVersionedFixtureResultSet is the set of results returned by a query to the
 // database.
-type EventsAllFixtureResultSet struct {
+type CustomVersionedFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *EventsAllFixture
+	last      *CustomVersionedFixture
 	lastErr   error
 }
 
-// NewEventsAllFixtureResultSet creates a new result set for rows of the type
-// EventsAllFixture.
-func NewEventsAllFixtureResultSet(rs kallax.ResultSet) *EventsAllFixtureResultSet {
-	return &EventsAllFixtureResultSet{ResultSet: rs}
+// NewCustomVersionedFixtureResultSet creates a new result set for rows of the type
+// CustomVersionedFixture.
+func NewCustomVersionedFixtureResultSet(rs kallax.ResultSet) *CustomVersionedFixtureResultSet {
+	return &CustomVersionedFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *EventsAllFixtureResultSet) Next() bool {
+func (rs *CustomVersionedFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -3481,14 +5322,14 @@ func (rs *EventsAllFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.EventsAllFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.CustomVersionedFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*EventsAllFixture)
+		rs.last, ok = record.(*CustomVersionedFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EventsAllFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *CustomVersionedFixture")
 			rs.last = nil
 		}
 	}
@@ -3497,7 +5338,7 @@ func (rs *EventsAllFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *EventsAllFixtureResultSet) Get() (*EventsAllFixture, error) {
+func (rs *CustomVersionedFixtureResultSet) Get() (*CustomVersionedFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -3505,7 +5346,7 @@ func (rs *EventsAllFixtureResultSet) Get() (*EventsAllFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *EventsAllFixtureResultSet) ForEach(fn func(*EventsAllFixture) error) error {
+func (rs *CustomVersionedFixtureResultSet) ForEach(fn func(*CustomVersionedFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -3524,8 +5365,8 @@ func (rs *EventsAllFixtureResultSet) ForEach(fn func(*EventsAllFixture) error) e
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *EventsAllFixtureResultSet) All() ([]*EventsAllFixture, error) {
-	var result []*EventsAllFixture
+func (rs *CustomVersionedFixtureResultSet) All() ([]*CustomVersionedFixture, error) {
+	var result []*CustomVersionedFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -3537,7 +5378,7 @@ func (rs *EventsAllFixtureResultSet) All() ([]*EventsAllFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *EventsAllFixtureResultSet) One() (*EventsAllFixture, error) {
+func (rs *CustomVersionedFixtureResultSet) One() (*CustomVersionedFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -3554,131 +5395,163 @@ func (rs *EventsAllFixtureResultSet) One() (*EventsAllFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *CustomVersionedFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *EventsAllFixtureResultSet) Err() error {
+func (rs *CustomVersionedFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *EventsAllFixtureResultSet) Close() error {
+func (rs *CustomVersionedFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewEventsFixture returns a new instance of EventsFixture.
-func NewEventsFixture() (record *EventsFixture) {
-	return newEventsFixture()
+// NewEnumFixture returns a new instance of EnumFixture.
+func NewEnumFixture(status EnumStatus, size int) (record *EnumFixture) {
+	return newEnumFixture(status, size)
 }
 
 // GetID returns the primary key of the model.
-func (r *EventsFixture) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *EnumFixture) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *EventsFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *EnumFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
-	case "checks":
-		return types.JSON(&r.Checks), nil
-	case "must_fail_before":
-		return types.JSON(&r.MustFailBefore), nil
-	case "must_fail_after":
-		return types.JSON(&r.MustFailAfter), nil
+		return (*kallax.NumericID)(&r.ID), nil
+	case "status":
+		return types.Enum(&r.Status, "active", "inactive"), nil
+	case "previous":
+		return types.Enum(&r.Previous, "active", "inactive"), nil
+	case "size":
+		return types.Enum(&r.Size, 1, 2, 3), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in EventsFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EnumFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *EventsFixture) Value(col string) (interface{}, error) {
+func (r *EnumFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "checks":
-		return types.JSON(r.Checks), nil
-	case "must_fail_before":
-		return types.JSON(r.MustFailBefore), nil
-	case "must_fail_after":
-		return types.JSON(r.MustFailAfter), nil
+	case "status":
+		return types.Enum(&r.Status, "active", "inactive").Value()
+	case "previous":
+		if r.Previous == (*EnumStatus)(nil) {
+			return nil, nil
+		}
+		return types.Enum(&r.Previous, "active", "inactive").Value()
+	case "size":
+		return types.Enum(&r.Size, 1, 2, 3).Value()
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in EventsFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EnumFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *EventsFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model EventsFixture has no relationships")
+func (r *EnumFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model EnumFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *EventsFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model EventsFixture has no relationships")
+func (r *EnumFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model EnumFixture has no relationships")
 }
 
-// EventsFixtureStore is the entity to access the records of the type EventsFixture
+// EnumFixtureStore is the entity to access the records of the type EnumFixture
 // in the database.
-type EventsFixtureStore struct {
+type EnumFixtureStore struct {
 	*kallax.Store
 }
 
-// NewEventsFixtureStore creates a new instance of EventsFixtureStore
+// NewEnumFixtureStore creates a new instance of EnumFixtureStore
 // using a SQL database.
-func NewEventsFixtureStore(db *sql.DB) *EventsFixtureStore {
-	return &EventsFixtureStore{kallax.NewStore(db)}
+func NewEnumFixtureStore(db *sql.DB) *EnumFixtureStore {
+	return &EnumFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *EventsFixtureStore) GenericStore() *kallax.Store {
+func (s *EnumFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *EventsFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *EnumFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *EventsFixtureStore) Debug() *EventsFixtureStore {
-	return &EventsFixtureStore{s.Store.Debug()}
+func (s *EnumFixtureStore) Debug() *EnumFixtureStore {
+	return &EnumFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *EventsFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsFixtureStore {
-	return &EventsFixtureStore{s.Store.DebugWith(logger)}
+func (s *EnumFixtureStore) DebugWith(logger kallax.LoggerFunc) *EnumFixtureStore {
+	return &EnumFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *EventsFixtureStore) DisableCacher() *EventsFixtureStore {
-	return &EventsFixtureStore{s.Store.DisableCacher()}
+func (s *EnumFixtureStore) DisableCacher() *EnumFixtureStore {
+	return &EnumFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a EventsFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *EnumFixtureStore) WithDialect(dialect kallax.Dialect) *EnumFixtureStore {
+	return &EnumFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a EnumFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *EventsFixtureStore) Insert(record *EventsFixture) error {
+func (s *EnumFixtureStore) Insert(record *EnumFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *EnumFixtureStore) InsertContext(ctx context.Context, record *EnumFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeInsert(); err != nil {
-		return err
-	}
+	return s.Store.InsertContext(ctx, Schema.EnumFixture.BaseSchema, record)
+}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.EventsFixture.BaseSchema, record); err != nil {
-			return err
-		}
+// InsertMany inserts all the given EnumFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *EnumFixtureStore) InsertMany(records []*EnumFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-		if err := record.AfterInsert(); err != nil {
-			return err
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *EnumFixtureStore) InsertManyContext(ctx context.Context, records []*EnumFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
 		}
+	}()
 
-		return nil
-	})
+	return s.Store.InsertManyContext(ctx, Schema.EnumFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -3687,41 +5560,33 @@ func (s *EventsFixtureStore) Insert(record *EventsFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *EventsFixtureStore) Update(record *EventsFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *EnumFixtureStore) Update(record *EnumFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *EnumFixtureStore) UpdateContext(ctx context.Context, record *EnumFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeUpdate(); err != nil {
-		return 0, err
-	}
-
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.EventsFixture.BaseSchema, record, cols...)
-		if err != nil {
-			return err
-		}
-
-		if err := record.AfterUpdate(); err != nil {
-			return err
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		return 0, err
-	}
-	return updated, nil
+	return s.Store.UpdateContext(ctx, Schema.EnumFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *EventsFixtureStore) Save(record *EventsFixture) (updated bool, err error) {
+func (s *EnumFixtureStore) Save(record *EnumFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *EnumFixtureStore) SaveContext(ctx context.Context, record *EnumFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -3729,45 +5594,159 @@ func (s *EventsFixtureStore) Save(record *EventsFixture) (updated bool, err erro
 	return rowsUpdated > 0, nil
 }
 
-// Delete removes the given record from the database.
-func (s *EventsFixtureStore) Delete(record *EventsFixture) error {
-	return s.Store.Delete(Schema.EventsFixture.BaseSchema, record)
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *EnumFixtureStore) Upsert(record *EnumFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
 }
 
-// Find returns the set of results for the given query.
-func (s *EventsFixtureStore) Find(q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
-	if err != nil {
-		return nil, err
-	}
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *EnumFixtureStore) UpsertContext(ctx context.Context, record *EnumFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
 
-	return NewEventsFixtureResultSet(rs), nil
+	return s.Store.UpsertContext(ctx, Schema.EnumFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *EnumFixtureStore) Delete(record *EnumFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *EnumFixtureStore) DeleteContext(ctx context.Context, record *EnumFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.EnumFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *EnumFixtureStore) UpdateWhere(q *EnumFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *EnumFixtureStore) UpdateWhereContext(ctx context.Context, q *EnumFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *EnumFixtureStore) UpdateWhereReturning(q *EnumFixtureQuery, values map[kallax.SchemaField]interface{}) (*EnumFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *EnumFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *EnumFixtureQuery, values map[kallax.SchemaField]interface{}) (*EnumFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnumFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *EnumFixtureStore) DeleteWhere(q *EnumFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *EnumFixtureStore) DeleteWhereContext(ctx context.Context, q *EnumFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *EnumFixtureStore) DeleteWhereReturning(q *EnumFixtureQuery) (*EnumFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *EnumFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *EnumFixtureQuery) (*EnumFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnumFixtureResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *EnumFixtureStore) Find(q *EnumFixtureQuery) (*EnumFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *EnumFixtureStore) FindContext(ctx context.Context, q *EnumFixtureQuery) (*EnumFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnumFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *EventsFixtureStore) MustFind(q *EventsFixtureQuery) *EventsFixtureResultSet {
-	return NewEventsFixtureResultSet(s.Store.MustFind(q))
+func (s *EnumFixtureStore) MustFind(q *EnumFixtureQuery) *EnumFixtureResultSet {
+	return NewEnumFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *EnumFixtureStore) MustFindContext(ctx context.Context, q *EnumFixtureQuery) *EnumFixtureResultSet {
+	return NewEnumFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *EventsFixtureStore) Count(q *EventsFixtureQuery) (int64, error) {
+func (s *EnumFixtureStore) Count(q *EnumFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *EnumFixtureStore) CountContext(ctx context.Context, q *EnumFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *EventsFixtureStore) MustCount(q *EventsFixtureQuery) int64 {
+func (s *EnumFixtureStore) MustCount(q *EnumFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *EnumFixtureStore) MustCountContext(ctx context.Context, q *EnumFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *EventsFixtureStore) FindOne(q *EventsFixtureQuery) (*EventsFixture, error) {
+func (s *EnumFixtureStore) FindOne(q *EnumFixtureQuery) (*EnumFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *EnumFixtureStore) FindOneContext(ctx context.Context, q *EnumFixtureQuery) (*EnumFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3789,8 +5768,14 @@ func (s *EventsFixtureStore) FindOne(q *EventsFixtureQuery) (*EventsFixture, err
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *EventsFixtureStore) FindAll(q *EventsFixtureQuery) ([]*EventsFixture, error) {
-	rs, err := s.Find(q)
+func (s *EnumFixtureStore) FindAll(q *EnumFixtureQuery) ([]*EnumFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *EnumFixtureStore) FindAllContext(ctx context.Context, q *EnumFixtureQuery) ([]*EnumFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3800,49 +5785,67 @@ func (s *EventsFixtureStore) FindAll(q *EventsFixtureQuery) ([]*EventsFixture, e
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *EventsFixtureStore) MustFindOne(q *EventsFixtureQuery) *EventsFixture {
-	record, err := s.FindOne(q)
+func (s *EnumFixtureStore) MustFindOne(q *EnumFixtureQuery) *EnumFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *EnumFixtureStore) MustFindOneContext(ctx context.Context, q *EnumFixtureQuery) *EnumFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the EventsFixture with the data in the database and
+// Reload refreshes the EnumFixture with the data in the database and
 // makes it writable.
-func (s *EventsFixtureStore) Reload(record *EventsFixture) error {
-	return s.Store.Reload(Schema.EventsFixture.BaseSchema, record)
+func (s *EnumFixtureStore) Reload(record *EnumFixture) error {
+	return s.Store.Reload(Schema.EnumFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *EnumFixtureStore) ReloadContext(ctx context.Context, record *EnumFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EnumFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *EventsFixtureStore) Transaction(callback func(*EventsFixtureStore) error) error {
+func (s *EnumFixtureStore) Transaction(callback func(*EnumFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *EnumFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*EnumFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&EventsFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&EnumFixtureStore{store})
 	})
 }
 
-// EventsFixtureQuery is the object used to create queries for the EventsFixture
+// EnumFixtureQuery is the object used to create queries for the EnumFixture
 // entity.
-type EventsFixtureQuery struct {
+type EnumFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewEventsFixtureQuery returns a new instance of EventsFixtureQuery.
-func NewEventsFixtureQuery() *EventsFixtureQuery {
-	return &EventsFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.EventsFixture.BaseSchema),
+// NewEnumFixtureQuery returns a new instance of EnumFixtureQuery.
+func NewEnumFixtureQuery() *EnumFixtureQuery {
+	return &EnumFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.EnumFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *EventsFixtureQuery) Select(columns ...kallax.SchemaField) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) Select(columns ...kallax.SchemaField) *EnumFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -3851,55 +5854,109 @@ func (q *EventsFixtureQuery) Select(columns ...kallax.SchemaField) *EventsFixtur
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *EventsFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EnumFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *EventsFixtureQuery) Copy() *EventsFixtureQuery {
-	return &EventsFixtureQuery{
+func (q *EnumFixtureQuery) Copy() *EnumFixtureQuery {
+	return &EnumFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *EventsFixtureQuery) Order(cols ...kallax.ColumnOrder) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) Order(cols ...kallax.ColumnOrder) *EnumFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *EnumFixtureQuery) After(cursor *kallax.Cursor) *EnumFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *EnumFixtureQuery) Before(cursor *kallax.Cursor) *EnumFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *EventsFixtureQuery) BatchSize(size uint64) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) BatchSize(size uint64) *EnumFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *EventsFixtureQuery) Limit(n uint64) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) Limit(n uint64) *EnumFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *EventsFixtureQuery) Offset(n uint64) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) Offset(n uint64) *EnumFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *EventsFixtureQuery) Where(cond kallax.Condition) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) Where(cond kallax.Condition) *EnumFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *EnumFixtureQuery) GroupBy(cols ...kallax.SchemaField) *EnumFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *EnumFixtureQuery) Having(cond kallax.Condition) *EnumFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *EnumFixtureQuery) ForUpdate() *EnumFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *EnumFixtureQuery) ForShare() *EnumFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *EnumFixtureQuery) NoWait() *EnumFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *EnumFixtureQuery) SkipLocked() *EnumFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *EventsFixtureQuery) FindByID(v ...kallax.ULID) *EventsFixtureQuery {
+func (q *EnumFixtureQuery) FindByID(v ...int64) *EnumFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -3907,27 +5964,39 @@ func (q *EventsFixtureQuery) FindByID(v ...kallax.ULID) *EventsFixtureQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.EventsFixture.ID, values...))
+	return q.Where(kallax.In(Schema.EnumFixture.ID, values...))
 }
 
-// EventsFixtureResultSet is the set of results returned by a query to the
+// FindByStatus adds a new filter to the query that will require that
+// the Status property is equal to the passed value.
+func (q *EnumFixtureQuery) FindByStatus(v EnumStatus) *EnumFixtureQuery {
+	return q.Where(kallax.Eq(Schema.EnumFixture.Status, v))
+}
+
+// FindBySize adds a new filter to the query that will require that
+// the Size property is equal to the passed value.
+func (q *EnumFixtureQuery) FindBySize(cond kallax.ScalarCond, v int) *EnumFixtureQuery {
+	return q.Where(cond(Schema.EnumFixture.Size, v))
+}
+
+// EnumFixtureResultSet is the set of results returned by a query to the
 // database.
-type EventsFixtureResultSet struct {
+type EnumFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *EventsFixture
+	last      *EnumFixture
 	lastErr   error
 }
 
-// NewEventsFixtureResultSet creates a new result set for rows of the type
-// EventsFixture.
-func NewEventsFixtureResultSet(rs kallax.ResultSet) *EventsFixtureResultSet {
-	return &EventsFixtureResultSet{ResultSet: rs}
+// NewEnumFixtureResultSet creates a new result set for rows of the type
+// EnumFixture.
+func NewEnumFixtureResultSet(rs kallax.ResultSet) *EnumFixtureResultSet {
+	return &EnumFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *EventsFixtureResultSet) Next() bool {
+func (rs *EnumFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -3935,14 +6004,14 @@ func (rs *EventsFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.EventsFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.EnumFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*EventsFixture)
+		rs.last, ok = record.(*EnumFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EventsFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EnumFixture")
 			rs.last = nil
 		}
 	}
@@ -3951,7 +6020,7 @@ func (rs *EventsFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *EventsFixtureResultSet) Get() (*EventsFixture, error) {
+func (rs *EnumFixtureResultSet) Get() (*EnumFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -3959,7 +6028,7 @@ func (rs *EventsFixtureResultSet) Get() (*EventsFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *EventsFixtureResultSet) ForEach(fn func(*EventsFixture) error) error {
+func (rs *EnumFixtureResultSet) ForEach(fn func(*EnumFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -3978,8 +6047,8 @@ func (rs *EventsFixtureResultSet) ForEach(fn func(*EventsFixture) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *EventsFixtureResultSet) All() ([]*EventsFixture, error) {
-	var result []*EventsFixture
+func (rs *EnumFixtureResultSet) All() ([]*EnumFixture, error) {
+	var result []*EnumFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -3991,7 +6060,7 @@ func (rs *EventsFixtureResultSet) All() ([]*EventsFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *EventsFixtureResultSet) One() (*EventsFixture, error) {
+func (rs *EnumFixtureResultSet) One() (*EnumFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -4008,28 +6077,34 @@ func (rs *EventsFixtureResultSet) One() (*EventsFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *EnumFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *EventsFixtureResultSet) Err() error {
+func (rs *EnumFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *EventsFixtureResultSet) Close() error {
+func (rs *EnumFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewEventsSaveFixture returns a new instance of EventsSaveFixture.
-func NewEventsSaveFixture() (record *EventsSaveFixture) {
-	return newEventsSaveFixture()
+// NewEventsAllFixture returns a new instance of EventsAllFixture.
+func NewEventsAllFixture() (record *EventsAllFixture) {
+	return newEventsAllFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *EventsSaveFixture) GetID() kallax.Identifier {
+func (r *EventsAllFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *EventsSaveFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *EventsAllFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
@@ -4041,12 +6116,12 @@ func (r *EventsSaveFixture) ColumnAddress(col string) (interface{}, error) {
 		return types.JSON(&r.MustFailAfter), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in EventsSaveFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EventsAllFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *EventsSaveFixture) Value(col string) (interface{}, error) {
+func (r *EventsAllFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
@@ -4058,63 +6133,75 @@ func (r *EventsSaveFixture) Value(col string) (interface{}, error) {
 		return types.JSON(r.MustFailAfter), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in EventsSaveFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EventsAllFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *EventsSaveFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model EventsSaveFixture has no relationships")
+func (r *EventsAllFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model EventsAllFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *EventsSaveFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model EventsSaveFixture has no relationships")
+func (r *EventsAllFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model EventsAllFixture has no relationships")
 }
 
-// EventsSaveFixtureStore is the entity to access the records of the type EventsSaveFixture
+// EventsAllFixtureStore is the entity to access the records of the type EventsAllFixture
 // in the database.
-type EventsSaveFixtureStore struct {
+type EventsAllFixtureStore struct {
 	*kallax.Store
 }
 
-// NewEventsSaveFixtureStore creates a new instance of EventsSaveFixtureStore
+// NewEventsAllFixtureStore creates a new instance of EventsAllFixtureStore
 // using a SQL database.
-func NewEventsSaveFixtureStore(db *sql.DB) *EventsSaveFixtureStore {
-	return &EventsSaveFixtureStore{kallax.NewStore(db)}
+func NewEventsAllFixtureStore(db *sql.DB) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *EventsSaveFixtureStore) GenericStore() *kallax.Store {
+func (s *EventsAllFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *EventsSaveFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *EventsAllFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *EventsSaveFixtureStore) Debug() *EventsSaveFixtureStore {
-	return &EventsSaveFixtureStore{s.Store.Debug()}
+func (s *EventsAllFixtureStore) Debug() *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *EventsSaveFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsSaveFixtureStore {
-	return &EventsSaveFixtureStore{s.Store.DebugWith(logger)}
+func (s *EventsAllFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *EventsSaveFixtureStore) DisableCacher() *EventsSaveFixtureStore {
-	return &EventsSaveFixtureStore{s.Store.DisableCacher()}
+func (s *EventsAllFixtureStore) DisableCacher() *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a EventsSaveFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *EventsAllFixtureStore) WithDialect(dialect kallax.Dialect) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a EventsAllFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
+func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *EventsAllFixtureStore) InsertContext(ctx context.Context, record *EventsAllFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
@@ -4122,8 +6209,16 @@ func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.EventsSaveFixture.BaseSchema, record); err != nil {
+	if err := record.BeforeInsert(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.EventsAllFixture.BaseSchema, record); err != nil {
+			return err
+		}
+
+		if err := record.AfterInsert(); err != nil {
 			return err
 		}
 
@@ -4135,13 +6230,44 @@ func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
 	})
 }
 
+// InsertMany inserts all the given EventsAllFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *EventsAllFixtureStore) InsertMany(records []*EventsAllFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *EventsAllFixtureStore) InsertManyContext(ctx context.Context, records []*EventsAllFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.EventsAllFixture.BaseSchema, recs...)
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *EventsSaveFixtureStore) Update(record *EventsSaveFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *EventsAllFixtureStore) Update(record *EventsAllFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *EventsAllFixtureStore) UpdateContext(ctx context.Context, record *EventsAllFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
@@ -4149,12 +6275,20 @@ func (s *EventsSaveFixtureStore) Update(record *EventsSaveFixture, cols ...kalla
 		return 0, err
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.EventsSaveFixture.BaseSchema, record, cols...)
+	if err := record.BeforeUpdate(); err != nil {
+		return 0, err
+	}
+
+	err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.EventsAllFixture.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
 
+		if err := record.AfterUpdate(); err != nil {
+			return err
+		}
+
 		if err := record.AfterSave(); err != nil {
 			return err
 		}
@@ -4170,12 +6304,18 @@ func (s *EventsSaveFixtureStore) Update(record *EventsSaveFixture, cols ...kalla
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *EventsSaveFixtureStore) Save(record *EventsSaveFixture) (updated bool, err error) {
+func (s *EventsAllFixtureStore) Save(record *EventsAllFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *EventsAllFixtureStore) SaveContext(ctx context.Context, record *EventsAllFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -4183,45 +6323,169 @@ func (s *EventsSaveFixtureStore) Save(record *EventsSaveFixture) (updated bool,
 	return rowsUpdated > 0, nil
 }
 
-// Delete removes the given record from the database.
-func (s *EventsSaveFixtureStore) Delete(record *EventsSaveFixture) error {
-	return s.Store.Delete(Schema.EventsSaveFixture.BaseSchema, record)
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *EventsAllFixtureStore) Upsert(record *EventsAllFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
 }
 
-// Find returns the set of results for the given query.
-func (s *EventsSaveFixtureStore) Find(q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
-	if err != nil {
-		return nil, err
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *EventsAllFixtureStore) UpsertContext(ctx context.Context, record *EventsAllFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
 	}
 
-	return NewEventsSaveFixtureResultSet(rs), nil
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.EventsAllFixture.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		return record.AfterSave()
+	})
 }
 
-// MustFind returns the set of results for the given query, but panics if there
-// is any error.
-func (s *EventsSaveFixtureStore) MustFind(q *EventsSaveFixtureQuery) *EventsSaveFixtureResultSet {
-	return NewEventsSaveFixtureResultSet(s.Store.MustFind(q))
+// Delete removes the given record from the database.
+func (s *EventsAllFixtureStore) Delete(record *EventsAllFixture) error {
+	return s.DeleteContext(context.Background(), record)
 }
 
-// Count returns the number of rows that would be retrieved with the given
-// query.
-func (s *EventsSaveFixtureStore) Count(q *EventsSaveFixtureQuery) (int64, error) {
-	return s.Store.Count(q)
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *EventsAllFixtureStore) DeleteContext(ctx context.Context, record *EventsAllFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.EventsAllFixture.BaseSchema, record)
 }
 
-// MustCount returns the number of rows that would be retrieved with the given
-// query, but panics if there is an error.
-func (s *EventsSaveFixtureStore) MustCount(q *EventsSaveFixtureQuery) int64 {
-	return s.Store.MustCount(q)
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *EventsAllFixtureStore) UpdateWhere(q *EventsAllFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
 }
 
-// FindOne returns the first row returned by the given query.
-// `ErrNotFound` is returned if there are no results.
-func (s *EventsSaveFixtureStore) FindOne(q *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *EventsAllFixtureStore) UpdateWhereContext(ctx context.Context, q *EventsAllFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *EventsAllFixtureStore) UpdateWhereReturning(q *EventsAllFixtureQuery, values map[kallax.SchemaField]interface{}) (*EventsAllFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *EventsAllFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *EventsAllFixtureQuery, values map[kallax.SchemaField]interface{}) (*EventsAllFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsAllFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *EventsAllFixtureStore) DeleteWhere(q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *EventsAllFixtureStore) DeleteWhereContext(ctx context.Context, q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *EventsAllFixtureStore) DeleteWhereReturning(q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *EventsAllFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsAllFixtureResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *EventsAllFixtureStore) Find(q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *EventsAllFixtureStore) FindContext(ctx context.Context, q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsAllFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *EventsAllFixtureStore) MustFind(q *EventsAllFixtureQuery) *EventsAllFixtureResultSet {
+	return NewEventsAllFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *EventsAllFixtureStore) MustFindContext(ctx context.Context, q *EventsAllFixtureQuery) *EventsAllFixtureResultSet {
+	return NewEventsAllFixtureResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *EventsAllFixtureStore) Count(q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *EventsAllFixtureStore) CountContext(ctx context.Context, q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *EventsAllFixtureStore) MustCount(q *EventsAllFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *EventsAllFixtureStore) MustCountContext(ctx context.Context, q *EventsAllFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *EventsAllFixtureStore) FindOne(q *EventsAllFixtureQuery) (*EventsAllFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *EventsAllFixtureStore) FindOneContext(ctx context.Context, q *EventsAllFixtureQuery) (*EventsAllFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4243,8 +6507,14 @@ func (s *EventsSaveFixtureStore) FindOne(q *EventsSaveFixtureQuery) (*EventsSave
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *EventsSaveFixtureStore) FindAll(q *EventsSaveFixtureQuery) ([]*EventsSaveFixture, error) {
-	rs, err := s.Find(q)
+func (s *EventsAllFixtureStore) FindAll(q *EventsAllFixtureQuery) ([]*EventsAllFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *EventsAllFixtureStore) FindAllContext(ctx context.Context, q *EventsAllFixtureQuery) ([]*EventsAllFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4254,49 +6524,67 @@ func (s *EventsSaveFixtureStore) FindAll(q *EventsSaveFixtureQuery) ([]*EventsSa
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *EventsSaveFixtureStore) MustFindOne(q *EventsSaveFixtureQuery) *EventsSaveFixture {
-	record, err := s.FindOne(q)
+func (s *EventsAllFixtureStore) MustFindOne(q *EventsAllFixtureQuery) *EventsAllFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *EventsAllFixtureStore) MustFindOneContext(ctx context.Context, q *EventsAllFixtureQuery) *EventsAllFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the EventsSaveFixture with the data in the database and
+// Reload refreshes the EventsAllFixture with the data in the database and
 // makes it writable.
-func (s *EventsSaveFixtureStore) Reload(record *EventsSaveFixture) error {
-	return s.Store.Reload(Schema.EventsSaveFixture.BaseSchema, record)
+func (s *EventsAllFixtureStore) Reload(record *EventsAllFixture) error {
+	return s.Store.Reload(Schema.EventsAllFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *EventsAllFixtureStore) ReloadContext(ctx context.Context, record *EventsAllFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EventsAllFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *EventsSaveFixtureStore) Transaction(callback func(*EventsSaveFixtureStore) error) error {
+func (s *EventsAllFixtureStore) Transaction(callback func(*EventsAllFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *EventsAllFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*EventsAllFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&EventsSaveFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&EventsAllFixtureStore{store})
 	})
 }
 
-// EventsSaveFixtureQuery is the object used to create queries for the EventsSaveFixture
+// EventsAllFixtureQuery is the object used to create queries for the EventsAllFixture
 // entity.
-type EventsSaveFixtureQuery struct {
+type EventsAllFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewEventsSaveFixtureQuery returns a new instance of EventsSaveFixtureQuery.
-func NewEventsSaveFixtureQuery() *EventsSaveFixtureQuery {
-	return &EventsSaveFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.EventsSaveFixture.BaseSchema),
+// NewEventsAllFixtureQuery returns a new instance of EventsAllFixtureQuery.
+func NewEventsAllFixtureQuery() *EventsAllFixtureQuery {
+	return &EventsAllFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.EventsAllFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *EventsSaveFixtureQuery) Select(columns ...kallax.SchemaField) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) Select(columns ...kallax.SchemaField) *EventsAllFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -4305,55 +6593,109 @@ func (q *EventsSaveFixtureQuery) Select(columns ...kallax.SchemaField) *EventsSa
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *EventsSaveFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EventsAllFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *EventsSaveFixtureQuery) Copy() *EventsSaveFixtureQuery {
-	return &EventsSaveFixtureQuery{
+func (q *EventsAllFixtureQuery) Copy() *EventsAllFixtureQuery {
+	return &EventsAllFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *EventsSaveFixtureQuery) Order(cols ...kallax.ColumnOrder) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) Order(cols ...kallax.ColumnOrder) *EventsAllFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *EventsAllFixtureQuery) After(cursor *kallax.Cursor) *EventsAllFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *EventsAllFixtureQuery) Before(cursor *kallax.Cursor) *EventsAllFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *EventsSaveFixtureQuery) BatchSize(size uint64) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) BatchSize(size uint64) *EventsAllFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *EventsSaveFixtureQuery) Limit(n uint64) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) Limit(n uint64) *EventsAllFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *EventsSaveFixtureQuery) Offset(n uint64) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) Offset(n uint64) *EventsAllFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *EventsSaveFixtureQuery) Where(cond kallax.Condition) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) Where(cond kallax.Condition) *EventsAllFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *EventsAllFixtureQuery) GroupBy(cols ...kallax.SchemaField) *EventsAllFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *EventsAllFixtureQuery) Having(cond kallax.Condition) *EventsAllFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *EventsAllFixtureQuery) ForUpdate() *EventsAllFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *EventsAllFixtureQuery) ForShare() *EventsAllFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *EventsAllFixtureQuery) NoWait() *EventsAllFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *EventsAllFixtureQuery) SkipLocked() *EventsAllFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *EventsSaveFixtureQuery) FindByID(v ...kallax.ULID) *EventsSaveFixtureQuery {
+func (q *EventsAllFixtureQuery) FindByID(v ...kallax.ULID) *EventsAllFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -4361,27 +6703,27 @@ func (q *EventsSaveFixtureQuery) FindByID(v ...kallax.ULID) *EventsSaveFixtureQu
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.EventsSaveFixture.ID, values...))
+	return q.Where(kallax.In(Schema.EventsAllFixture.ID, values...))
 }
 
-// EventsSaveFixtureResultSet is the set of results returned by a query to the
+// EventsAllFixtureResultSet is the set of results returned by a query to the
 // database.
-type EventsSaveFixtureResultSet struct {
+type EventsAllFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *EventsSaveFixture
+	last      *EventsAllFixture
 	lastErr   error
 }
 
-// NewEventsSaveFixtureResultSet creates a new result set for rows of the type
-// EventsSaveFixture.
-func NewEventsSaveFixtureResultSet(rs kallax.ResultSet) *EventsSaveFixtureResultSet {
-	return &EventsSaveFixtureResultSet{ResultSet: rs}
+// NewEventsAllFixtureResultSet creates a new result set for rows of the type
+// EventsAllFixture.
+func NewEventsAllFixtureResultSet(rs kallax.ResultSet) *EventsAllFixtureResultSet {
+	return &EventsAllFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *EventsSaveFixtureResultSet) Next() bool {
+func (rs *EventsAllFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -4389,14 +6731,14 @@ func (rs *EventsSaveFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.EventsSaveFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.EventsAllFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*EventsSaveFixture)
+		rs.last, ok = record.(*EventsAllFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EventsSaveFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EventsAllFixture")
 			rs.last = nil
 		}
 	}
@@ -4405,7 +6747,7 @@ func (rs *EventsSaveFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *EventsSaveFixtureResultSet) Get() (*EventsSaveFixture, error) {
+func (rs *EventsAllFixtureResultSet) Get() (*EventsAllFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -4413,7 +6755,7 @@ func (rs *EventsSaveFixtureResultSet) Get() (*EventsSaveFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *EventsSaveFixtureResultSet) ForEach(fn func(*EventsSaveFixture) error) error {
+func (rs *EventsAllFixtureResultSet) ForEach(fn func(*EventsAllFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -4432,8 +6774,8 @@ func (rs *EventsSaveFixtureResultSet) ForEach(fn func(*EventsSaveFixture) error)
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *EventsSaveFixtureResultSet) All() ([]*EventsSaveFixture, error) {
-	var result []*EventsSaveFixture
+func (rs *EventsAllFixtureResultSet) All() ([]*EventsAllFixture, error) {
+	var result []*EventsAllFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -4445,7 +6787,7 @@ func (rs *EventsSaveFixtureResultSet) All() ([]*EventsSaveFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *EventsSaveFixtureResultSet) One() (*EventsSaveFixture, error) {
+func (rs *EventsAllFixtureResultSet) One() (*EventsAllFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -4462,127 +6804,174 @@ func (rs *EventsSaveFixtureResultSet) One() (*EventsSaveFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *EventsAllFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *EventsSaveFixtureResultSet) Err() error {
+func (rs *EventsAllFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *EventsSaveFixtureResultSet) Close() error {
+func (rs *EventsAllFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewJSONModel returns a new instance of JSONModel.
-func NewJSONModel() (record *JSONModel) {
-	return newJSONModel()
+// NewEventsFixture returns a new instance of EventsFixture.
+func NewEventsFixture() (record *EventsFixture) {
+	return newEventsFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *JSONModel) GetID() kallax.Identifier {
+func (r *EventsFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *JSONModel) ColumnAddress(col string) (interface{}, error) {
+func (r *EventsFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
-	case "foo":
-		return &r.Foo, nil
-	case "bar":
-		if r.Bar == nil {
-			r.Bar = new(Bar)
-		}
-		return types.JSON(r.Bar), nil
-	case "baz_slice":
-		return types.JSON(&r.BazSlice), nil
-	case "baz":
-		return types.JSON(&r.Baz), nil
+	case "checks":
+		return types.JSON(&r.Checks), nil
+	case "must_fail_before":
+		return types.JSON(&r.MustFailBefore), nil
+	case "must_fail_after":
+		return types.JSON(&r.MustFailAfter), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in JSONModel: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EventsFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *JSONModel) Value(col string) (interface{}, error) {
+func (r *EventsFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "foo":
-		return r.Foo, nil
-	case "bar":
-		if r.Bar == (*Bar)(nil) {
-			return nil, nil
-		}
-		return types.JSON(r.Bar), nil
-	case "baz_slice":
-		return types.JSON(r.BazSlice), nil
-	case "baz":
-		return types.JSON(r.Baz), nil
+	case "checks":
+		return types.JSON(r.Checks), nil
+	case "must_fail_before":
+		return types.JSON(r.MustFailBefore), nil
+	case "must_fail_after":
+		return types.JSON(r.MustFailAfter), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in JSONModel: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EventsFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *JSONModel) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model JSONModel has no relationships")
+func (r *EventsFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model EventsFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *JSONModel) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model JSONModel has no relationships")
+func (r *EventsFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model EventsFixture has no relationships")
 }
 
-// JSONModelStore is the entity to access the records of the type JSONModel
+// EventsFixtureStore is the entity to access the records of the type EventsFixture
 // in the database.
-type JSONModelStore struct {
+type EventsFixtureStore struct {
 	*kallax.Store
 }
 
-// NewJSONModelStore creates a new instance of JSONModelStore
+// NewEventsFixtureStore creates a new instance of EventsFixtureStore
 // using a SQL database.
-func NewJSONModelStore(db *sql.DB) *JSONModelStore {
-	return &JSONModelStore{kallax.NewStore(db)}
+func NewEventsFixtureStore(db *sql.DB) *EventsFixtureStore {
+	return &EventsFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *JSONModelStore) GenericStore() *kallax.Store {
+func (s *EventsFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *JSONModelStore) SetGenericStore(store *kallax.Store) {
+func (s *EventsFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *JSONModelStore) Debug() *JSONModelStore {
-	return &JSONModelStore{s.Store.Debug()}
+func (s *EventsFixtureStore) Debug() *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *JSONModelStore) DebugWith(logger kallax.LoggerFunc) *JSONModelStore {
-	return &JSONModelStore{s.Store.DebugWith(logger)}
+func (s *EventsFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *JSONModelStore) DisableCacher() *JSONModelStore {
-	return &JSONModelStore{s.Store.DisableCacher()}
+func (s *EventsFixtureStore) DisableCacher() *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a JSONModel in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *EventsFixtureStore) WithDialect(dialect kallax.Dialect) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a EventsFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *JSONModelStore) Insert(record *JSONModel) error {
+func (s *EventsFixtureStore) Insert(record *EventsFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *EventsFixtureStore) InsertContext(ctx context.Context, record *EventsFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.JSONModel.BaseSchema, record)
+	if err := record.BeforeInsert(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.EventsFixture.BaseSchema, record); err != nil {
+			return err
+		}
+
+		if err := record.AfterInsert(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// InsertMany inserts all the given EventsFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *EventsFixtureStore) InsertMany(records []*EventsFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *EventsFixtureStore) InsertManyContext(ctx context.Context, records []*EventsFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.EventsFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -4591,21 +6980,53 @@ func (s *JSONModelStore) Insert(record *JSONModel) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *JSONModelStore) Update(record *JSONModel, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *EventsFixtureStore) Update(record *EventsFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *EventsFixtureStore) UpdateContext(ctx context.Context, record *EventsFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.JSONModel.BaseSchema, record, cols...)
+	if err := record.BeforeUpdate(); err != nil {
+		return 0, err
+	}
+
+	err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.EventsFixture.BaseSchema, record, cols...)
+		if err != nil {
+			return err
+		}
+
+		if err := record.AfterUpdate(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *JSONModelStore) Save(record *JSONModel) (updated bool, err error) {
+func (s *EventsFixtureStore) Save(record *EventsFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *EventsFixtureStore) SaveContext(ctx context.Context, record *EventsFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -4613,45 +7034,159 @@ func (s *JSONModelStore) Save(record *JSONModel) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *EventsFixtureStore) Upsert(record *EventsFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *EventsFixtureStore) UpsertContext(ctx context.Context, record *EventsFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.EventsFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *JSONModelStore) Delete(record *JSONModel) error {
-	return s.Store.Delete(Schema.JSONModel.BaseSchema, record)
+func (s *EventsFixtureStore) Delete(record *EventsFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *EventsFixtureStore) DeleteContext(ctx context.Context, record *EventsFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.EventsFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *EventsFixtureStore) UpdateWhere(q *EventsFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *EventsFixtureStore) UpdateWhereContext(ctx context.Context, q *EventsFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *EventsFixtureStore) UpdateWhereReturning(q *EventsFixtureQuery, values map[kallax.SchemaField]interface{}) (*EventsFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *EventsFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *EventsFixtureQuery, values map[kallax.SchemaField]interface{}) (*EventsFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *EventsFixtureStore) DeleteWhere(q *EventsFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *EventsFixtureStore) DeleteWhereContext(ctx context.Context, q *EventsFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *EventsFixtureStore) DeleteWhereReturning(q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *EventsFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *JSONModelStore) Find(q *JSONModelQuery) (*JSONModelResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *EventsFixtureStore) Find(q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *EventsFixtureStore) FindContext(ctx context.Context, q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewJSONModelResultSet(rs), nil
+	return NewEventsFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *JSONModelStore) MustFind(q *JSONModelQuery) *JSONModelResultSet {
-	return NewJSONModelResultSet(s.Store.MustFind(q))
+func (s *EventsFixtureStore) MustFind(q *EventsFixtureQuery) *EventsFixtureResultSet {
+	return NewEventsFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *EventsFixtureStore) MustFindContext(ctx context.Context, q *EventsFixtureQuery) *EventsFixtureResultSet {
+	return NewEventsFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *JSONModelStore) Count(q *JSONModelQuery) (int64, error) {
+func (s *EventsFixtureStore) Count(q *EventsFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *EventsFixtureStore) CountContext(ctx context.Context, q *EventsFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *JSONModelStore) MustCount(q *JSONModelQuery) int64 {
+func (s *EventsFixtureStore) MustCount(q *EventsFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *EventsFixtureStore) MustCountContext(ctx context.Context, q *EventsFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *JSONModelStore) FindOne(q *JSONModelQuery) (*JSONModel, error) {
+func (s *EventsFixtureStore) FindOne(q *EventsFixtureQuery) (*EventsFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *EventsFixtureStore) FindOneContext(ctx context.Context, q *EventsFixtureQuery) (*EventsFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4673,8 +7208,14 @@ func (s *JSONModelStore) FindOne(q *JSONModelQuery) (*JSONModel, error) {
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *JSONModelStore) FindAll(q *JSONModelQuery) ([]*JSONModel, error) {
-	rs, err := s.Find(q)
+func (s *EventsFixtureStore) FindAll(q *EventsFixtureQuery) ([]*EventsFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *EventsFixtureStore) FindAllContext(ctx context.Context, q *EventsFixtureQuery) ([]*EventsFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4684,49 +7225,67 @@ func (s *JSONModelStore) FindAll(q *JSONModelQuery) ([]*JSONModel, error) {
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *JSONModelStore) MustFindOne(q *JSONModelQuery) *JSONModel {
-	record, err := s.FindOne(q)
+func (s *EventsFixtureStore) MustFindOne(q *EventsFixtureQuery) *EventsFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *EventsFixtureStore) MustFindOneContext(ctx context.Context, q *EventsFixtureQuery) *EventsFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the JSONModel with the data in the database and
+// Reload refreshes the EventsFixture with the data in the database and
 // makes it writable.
-func (s *JSONModelStore) Reload(record *JSONModel) error {
-	return s.Store.Reload(Schema.JSONModel.BaseSchema, record)
+func (s *EventsFixtureStore) Reload(record *EventsFixture) error {
+	return s.Store.Reload(Schema.EventsFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *EventsFixtureStore) ReloadContext(ctx context.Context, record *EventsFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EventsFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *JSONModelStore) Transaction(callback func(*JSONModelStore) error) error {
+func (s *EventsFixtureStore) Transaction(callback func(*EventsFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *EventsFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*EventsFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&JSONModelStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&EventsFixtureStore{store})
 	})
 }
 
-// JSONModelQuery is the object used to create queries for the JSONModel
+// EventsFixtureQuery is the object used to create queries for the EventsFixture
 // entity.
-type JSONModelQuery struct {
+type EventsFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewJSONModelQuery returns a new instance of JSONModelQuery.
-func NewJSONModelQuery() *JSONModelQuery {
-	return &JSONModelQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.JSONModel.BaseSchema),
+// NewEventsFixtureQuery returns a new instance of EventsFixtureQuery.
+func NewEventsFixtureQuery() *EventsFixtureQuery {
+	return &EventsFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.EventsFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *JSONModelQuery) Select(columns ...kallax.SchemaField) *JSONModelQuery {
+func (q *EventsFixtureQuery) Select(columns ...kallax.SchemaField) *EventsFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -4735,55 +7294,109 @@ func (q *JSONModelQuery) Select(columns ...kallax.SchemaField) *JSONModelQuery {
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *JSONModelQuery) SelectNot(columns ...kallax.SchemaField) *JSONModelQuery {
+func (q *EventsFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EventsFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *JSONModelQuery) Copy() *JSONModelQuery {
-	return &JSONModelQuery{
+func (q *EventsFixtureQuery) Copy() *EventsFixtureQuery {
+	return &EventsFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *JSONModelQuery) Order(cols ...kallax.ColumnOrder) *JSONModelQuery {
+func (q *EventsFixtureQuery) Order(cols ...kallax.ColumnOrder) *EventsFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *EventsFixtureQuery) After(cursor *kallax.Cursor) *EventsFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *EventsFixtureQuery) Before(cursor *kallax.Cursor) *EventsFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *JSONModelQuery) BatchSize(size uint64) *JSONModelQuery {
+func (q *EventsFixtureQuery) BatchSize(size uint64) *EventsFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *JSONModelQuery) Limit(n uint64) *JSONModelQuery {
+func (q *EventsFixtureQuery) Limit(n uint64) *EventsFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *JSONModelQuery) Offset(n uint64) *JSONModelQuery {
+func (q *EventsFixtureQuery) Offset(n uint64) *EventsFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *JSONModelQuery) Where(cond kallax.Condition) *JSONModelQuery {
+func (q *EventsFixtureQuery) Where(cond kallax.Condition) *EventsFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *EventsFixtureQuery) GroupBy(cols ...kallax.SchemaField) *EventsFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *EventsFixtureQuery) Having(cond kallax.Condition) *EventsFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *EventsFixtureQuery) ForUpdate() *EventsFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *EventsFixtureQuery) ForShare() *EventsFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *EventsFixtureQuery) NoWait() *EventsFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *EventsFixtureQuery) SkipLocked() *EventsFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *JSONModelQuery) FindByID(v ...kallax.ULID) *JSONModelQuery {
+func (q *EventsFixtureQuery) FindByID(v ...kallax.ULID) *EventsFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -4791,33 +7404,27 @@ func (q *JSONModelQuery) FindByID(v ...kallax.ULID) *JSONModelQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.JSONModel.ID, values...))
-}
-
-// FindByFoo adds a new filter to the query that will require that
-// the Foo property is equal to the passed value.
-func (q *JSONModelQuery) FindByFoo(v string) *JSONModelQuery {
-	return q.Where(kallax.Eq(Schema.JSONModel.Foo, v))
+	return q.Where(kallax.In(Schema.EventsFixture.ID, values...))
 }
 
-// JSONModelResultSet is the set of results returned by a query to the
+// EventsFixtureResultSet is the set of results returned by a query to the
 // database.
-type JSONModelResultSet struct {
+type EventsFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *JSONModel
+	last      *EventsFixture
 	lastErr   error
 }
 
-// NewJSONModelResultSet creates a new result set for rows of the type
-// JSONModel.
-func NewJSONModelResultSet(rs kallax.ResultSet) *JSONModelResultSet {
-	return &JSONModelResultSet{ResultSet: rs}
+// NewEventsFixtureResultSet creates a new result set for rows of the type
+// EventsFixture.
+func NewEventsFixtureResultSet(rs kallax.ResultSet) *EventsFixtureResultSet {
+	return &EventsFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *JSONModelResultSet) Next() bool {
+func (rs *EventsFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -4825,14 +7432,14 @@ func (rs *JSONModelResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.JSONModel.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.EventsFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*JSONModel)
+		rs.last, ok = record.(*EventsFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *JSONModel")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EventsFixture")
 			rs.last = nil
 		}
 	}
@@ -4841,7 +7448,7 @@ func (rs *JSONModelResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *JSONModelResultSet) Get() (*JSONModel, error) {
+func (rs *EventsFixtureResultSet) Get() (*EventsFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -4849,7 +7456,7 @@ func (rs *JSONModelResultSet) Get() (*JSONModel, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *JSONModelResultSet) ForEach(fn func(*JSONModel) error) error {
+func (rs *EventsFixtureResultSet) ForEach(fn func(*EventsFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -4868,8 +7475,8 @@ func (rs *JSONModelResultSet) ForEach(fn func(*JSONModel) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *JSONModelResultSet) All() ([]*JSONModel, error) {
-	var result []*JSONModel
+func (rs *EventsFixtureResultSet) All() ([]*EventsFixture, error) {
+	var result []*EventsFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -4881,7 +7488,7 @@ func (rs *JSONModelResultSet) All() ([]*JSONModel, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *JSONModelResultSet) One() (*JSONModel, error) {
+func (rs *EventsFixtureResultSet) One() (*EventsFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -4898,120 +7505,174 @@ func (rs *JSONModelResultSet) One() (*JSONModel, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *EventsFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *JSONModelResultSet) Err() error {
+func (rs *EventsFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *JSONModelResultSet) Close() error {
+func (rs *EventsFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewMultiKeySortFixture returns a new instance of MultiKeySortFixture.
-func NewMultiKeySortFixture() (record *MultiKeySortFixture) {
-	return newMultiKeySortFixture()
+// NewEventsSaveFixture returns a new instance of EventsSaveFixture.
+func NewEventsSaveFixture() (record *EventsSaveFixture) {
+	return newEventsSaveFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *MultiKeySortFixture) GetID() kallax.Identifier {
+func (r *EventsSaveFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *MultiKeySortFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *EventsSaveFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
-	case "name":
-		return &r.Name, nil
-	case "start":
-		return &r.Start, nil
-	case "_end":
-		return &r.End, nil
+	case "checks":
+		return types.JSON(&r.Checks), nil
+	case "must_fail_before":
+		return types.JSON(&r.MustFailBefore), nil
+	case "must_fail_after":
+		return types.JSON(&r.MustFailAfter), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in MultiKeySortFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EventsSaveFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *MultiKeySortFixture) Value(col string) (interface{}, error) {
+func (r *EventsSaveFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "name":
-		return r.Name, nil
-	case "start":
-		return r.Start, nil
-	case "_end":
-		return r.End, nil
+	case "checks":
+		return types.JSON(r.Checks), nil
+	case "must_fail_before":
+		return types.JSON(r.MustFailBefore), nil
+	case "must_fail_after":
+		return types.JSON(r.MustFailAfter), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in MultiKeySortFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in EventsSaveFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *MultiKeySortFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model MultiKeySortFixture has no relationships")
+func (r *EventsSaveFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model EventsSaveFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *MultiKeySortFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model MultiKeySortFixture has no relationships")
+func (r *EventsSaveFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model EventsSaveFixture has no relationships")
 }
 
-// MultiKeySortFixtureStore is the entity to access the records of the type MultiKeySortFixture
+// EventsSaveFixtureStore is the entity to access the records of the type EventsSaveFixture
 // in the database.
-type MultiKeySortFixtureStore struct {
+type EventsSaveFixtureStore struct {
 	*kallax.Store
 }
 
-// NewMultiKeySortFixtureStore creates a new instance of MultiKeySortFixtureStore
+// NewEventsSaveFixtureStore creates a new instance of EventsSaveFixtureStore
 // using a SQL database.
-func NewMultiKeySortFixtureStore(db *sql.DB) *MultiKeySortFixtureStore {
-	return &MultiKeySortFixtureStore{kallax.NewStore(db)}
+func NewEventsSaveFixtureStore(db *sql.DB) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *MultiKeySortFixtureStore) GenericStore() *kallax.Store {
+func (s *EventsSaveFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *MultiKeySortFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *EventsSaveFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *MultiKeySortFixtureStore) Debug() *MultiKeySortFixtureStore {
-	return &MultiKeySortFixtureStore{s.Store.Debug()}
+func (s *EventsSaveFixtureStore) Debug() *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *MultiKeySortFixtureStore) DebugWith(logger kallax.LoggerFunc) *MultiKeySortFixtureStore {
-	return &MultiKeySortFixtureStore{s.Store.DebugWith(logger)}
+func (s *EventsSaveFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *MultiKeySortFixtureStore) DisableCacher() *MultiKeySortFixtureStore {
-	return &MultiKeySortFixtureStore{s.Store.DisableCacher()}
+func (s *EventsSaveFixtureStore) DisableCacher() *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a MultiKeySortFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *EventsSaveFixtureStore) WithDialect(dialect kallax.Dialect) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a EventsSaveFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *MultiKeySortFixtureStore) Insert(record *MultiKeySortFixture) error {
+func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *EventsSaveFixtureStore) InsertContext(ctx context.Context, record *EventsSaveFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	record.Start = record.Start.Truncate(time.Microsecond)
-	record.End = record.End.Truncate(time.Microsecond)
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.EventsSaveFixture.BaseSchema, record); err != nil {
+			return err
+		}
+
+		if err := record.AfterSave(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// InsertMany inserts all the given EventsSaveFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *EventsSaveFixtureStore) InsertMany(records []*EventsSaveFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *EventsSaveFixtureStore) InsertManyContext(ctx context.Context, records []*EventsSaveFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
 
-	return s.Store.Insert(Schema.MultiKeySortFixture.BaseSchema, record)
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.EventsSaveFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -5020,24 +7681,53 @@ func (s *MultiKeySortFixtureStore) Insert(record *MultiKeySortFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *MultiKeySortFixtureStore) Update(record *MultiKeySortFixture, cols ...kallax.SchemaField) (updated int64, err error) {
-	record.Start = record.Start.Truncate(time.Microsecond)
-	record.End = record.End.Truncate(time.Microsecond)
+func (s *EventsSaveFixtureStore) Update(record *EventsSaveFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *EventsSaveFixtureStore) UpdateContext(ctx context.Context, record *EventsSaveFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.MultiKeySortFixture.BaseSchema, record, cols...)
+	if err := record.BeforeSave(); err != nil {
+		return 0, err
+	}
+
+	err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.EventsSaveFixture.BaseSchema, record, cols...)
+		if err != nil {
+			return err
+		}
+
+		if err := record.AfterSave(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *MultiKeySortFixtureStore) Save(record *MultiKeySortFixture) (updated bool, err error) {
+func (s *EventsSaveFixtureStore) Save(record *EventsSaveFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *EventsSaveFixtureStore) SaveContext(ctx context.Context, record *EventsSaveFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -5045,45 +7735,169 @@ func (s *MultiKeySortFixtureStore) Save(record *MultiKeySortFixture) (updated bo
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *EventsSaveFixtureStore) Upsert(record *EventsSaveFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *EventsSaveFixtureStore) UpsertContext(ctx context.Context, record *EventsSaveFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.EventsSaveFixture.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		return record.AfterSave()
+	})
+}
+
 // Delete removes the given record from the database.
-func (s *MultiKeySortFixtureStore) Delete(record *MultiKeySortFixture) error {
-	return s.Store.Delete(Schema.MultiKeySortFixture.BaseSchema, record)
+func (s *EventsSaveFixtureStore) Delete(record *EventsSaveFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *EventsSaveFixtureStore) DeleteContext(ctx context.Context, record *EventsSaveFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.EventsSaveFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *EventsSaveFixtureStore) UpdateWhere(q *EventsSaveFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *EventsSaveFixtureStore) UpdateWhereContext(ctx context.Context, q *EventsSaveFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *EventsSaveFixtureStore) UpdateWhereReturning(q *EventsSaveFixtureQuery, values map[kallax.SchemaField]interface{}) (*EventsSaveFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *EventsSaveFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *EventsSaveFixtureQuery, values map[kallax.SchemaField]interface{}) (*EventsSaveFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsSaveFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *EventsSaveFixtureStore) DeleteWhere(q *EventsSaveFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *EventsSaveFixtureStore) DeleteWhereContext(ctx context.Context, q *EventsSaveFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *EventsSaveFixtureStore) DeleteWhereReturning(q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *EventsSaveFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsSaveFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *MultiKeySortFixtureStore) Find(q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *EventsSaveFixtureStore) Find(q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *EventsSaveFixtureStore) FindContext(ctx context.Context, q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewMultiKeySortFixtureResultSet(rs), nil
+	return NewEventsSaveFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *MultiKeySortFixtureStore) MustFind(q *MultiKeySortFixtureQuery) *MultiKeySortFixtureResultSet {
-	return NewMultiKeySortFixtureResultSet(s.Store.MustFind(q))
+func (s *EventsSaveFixtureStore) MustFind(q *EventsSaveFixtureQuery) *EventsSaveFixtureResultSet {
+	return NewEventsSaveFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *EventsSaveFixtureStore) MustFindContext(ctx context.Context, q *EventsSaveFixtureQuery) *EventsSaveFixtureResultSet {
+	return NewEventsSaveFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *MultiKeySortFixtureStore) Count(q *MultiKeySortFixtureQuery) (int64, error) {
+func (s *EventsSaveFixtureStore) Count(q *EventsSaveFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *EventsSaveFixtureStore) CountContext(ctx context.Context, q *EventsSaveFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *MultiKeySortFixtureStore) MustCount(q *MultiKeySortFixtureQuery) int64 {
+func (s *EventsSaveFixtureStore) MustCount(q *EventsSaveFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *EventsSaveFixtureStore) MustCountContext(ctx context.Context, q *EventsSaveFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *MultiKeySortFixtureStore) FindOne(q *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
+func (s *EventsSaveFixtureStore) FindOne(q *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *EventsSaveFixtureStore) FindOneContext(ctx context.Context, q *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5105,8 +7919,14 @@ func (s *MultiKeySortFixtureStore) FindOne(q *MultiKeySortFixtureQuery) (*MultiK
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *MultiKeySortFixtureStore) FindAll(q *MultiKeySortFixtureQuery) ([]*MultiKeySortFixture, error) {
-	rs, err := s.Find(q)
+func (s *EventsSaveFixtureStore) FindAll(q *EventsSaveFixtureQuery) ([]*EventsSaveFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *EventsSaveFixtureStore) FindAllContext(ctx context.Context, q *EventsSaveFixtureQuery) ([]*EventsSaveFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5116,49 +7936,67 @@ func (s *MultiKeySortFixtureStore) FindAll(q *MultiKeySortFixtureQuery) ([]*Mult
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *MultiKeySortFixtureStore) MustFindOne(q *MultiKeySortFixtureQuery) *MultiKeySortFixture {
-	record, err := s.FindOne(q)
+func (s *EventsSaveFixtureStore) MustFindOne(q *EventsSaveFixtureQuery) *EventsSaveFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *EventsSaveFixtureStore) MustFindOneContext(ctx context.Context, q *EventsSaveFixtureQuery) *EventsSaveFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the MultiKeySortFixture with the data in the database and
+// Reload refreshes the EventsSaveFixture with the data in the database and
 // makes it writable.
-func (s *MultiKeySortFixtureStore) Reload(record *MultiKeySortFixture) error {
-	return s.Store.Reload(Schema.MultiKeySortFixture.BaseSchema, record)
+func (s *EventsSaveFixtureStore) Reload(record *EventsSaveFixture) error {
+	return s.Store.Reload(Schema.EventsSaveFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *EventsSaveFixtureStore) ReloadContext(ctx context.Context, record *EventsSaveFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EventsSaveFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *MultiKeySortFixtureStore) Transaction(callback func(*MultiKeySortFixtureStore) error) error {
+func (s *EventsSaveFixtureStore) Transaction(callback func(*EventsSaveFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *EventsSaveFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*EventsSaveFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&MultiKeySortFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&EventsSaveFixtureStore{store})
 	})
 }
 
-// MultiKeySortFixtureQuery is the object used to create queries for the MultiKeySortFixture
+// EventsSaveFixtureQuery is the object used to create queries for the EventsSaveFixture
 // entity.
-type MultiKeySortFixtureQuery struct {
+type EventsSaveFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewMultiKeySortFixtureQuery returns a new instance of MultiKeySortFixtureQuery.
-func NewMultiKeySortFixtureQuery() *MultiKeySortFixtureQuery {
-	return &MultiKeySortFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.MultiKeySortFixture.BaseSchema),
+// NewEventsSaveFixtureQuery returns a new instance of EventsSaveFixtureQuery.
+func NewEventsSaveFixtureQuery() *EventsSaveFixtureQuery {
+	return &EventsSaveFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.EventsSaveFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *MultiKeySortFixtureQuery) Select(columns ...kallax.SchemaField) *MultiKeySortFixtureQuery {
+func (q *EventsSaveFixtureQuery) Select(columns ...kallax.SchemaField) *EventsSaveFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -5167,55 +8005,109 @@ func (q *MultiKeySortFixtureQuery) Select(columns ...kallax.SchemaField) *MultiK
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *MultiKeySortFixtureQuery) SelectNot(columns ...kallax.SchemaField) *MultiKeySortFixtureQuery {
+func (q *EventsSaveFixtureQuery) SelectNot(columns ...kallax.SchemaField) *EventsSaveFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *MultiKeySortFixtureQuery) Copy() *MultiKeySortFixtureQuery {
-	return &MultiKeySortFixtureQuery{
+func (q *EventsSaveFixtureQuery) Copy() *EventsSaveFixtureQuery {
+	return &EventsSaveFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *MultiKeySortFixtureQuery) Order(cols ...kallax.ColumnOrder) *MultiKeySortFixtureQuery {
+func (q *EventsSaveFixtureQuery) Order(cols ...kallax.ColumnOrder) *EventsSaveFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
-// BatchSize sets the number of items to fetch per batch when there are 1:N
-// relationships selected in the query.
-func (q *MultiKeySortFixtureQuery) BatchSize(size uint64) *MultiKeySortFixtureQuery {
-	q.BaseQuery.BatchSize(size)
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *EventsSaveFixtureQuery) After(cursor *kallax.Cursor) *EventsSaveFixtureQuery {
+	q.BaseQuery.After(cursor)
 	return q
 }
 
-// Limit sets the max number of items to retrieve.
-func (q *MultiKeySortFixtureQuery) Limit(n uint64) *MultiKeySortFixtureQuery {
-	q.BaseQuery.Limit(n)
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *EventsSaveFixtureQuery) Before(cursor *kallax.Cursor) *EventsSaveFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *EventsSaveFixtureQuery) BatchSize(size uint64) *EventsSaveFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *EventsSaveFixtureQuery) Limit(n uint64) *EventsSaveFixtureQuery {
+	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *MultiKeySortFixtureQuery) Offset(n uint64) *MultiKeySortFixtureQuery {
+func (q *EventsSaveFixtureQuery) Offset(n uint64) *EventsSaveFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *MultiKeySortFixtureQuery) Where(cond kallax.Condition) *MultiKeySortFixtureQuery {
+func (q *EventsSaveFixtureQuery) Where(cond kallax.Condition) *EventsSaveFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *EventsSaveFixtureQuery) GroupBy(cols ...kallax.SchemaField) *EventsSaveFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *EventsSaveFixtureQuery) Having(cond kallax.Condition) *EventsSaveFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *EventsSaveFixtureQuery) ForUpdate() *EventsSaveFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *EventsSaveFixtureQuery) ForShare() *EventsSaveFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *EventsSaveFixtureQuery) NoWait() *EventsSaveFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *EventsSaveFixtureQuery) SkipLocked() *EventsSaveFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *MultiKeySortFixtureQuery) FindByID(v ...kallax.ULID) *MultiKeySortFixtureQuery {
+func (q *EventsSaveFixtureQuery) FindByID(v ...kallax.ULID) *EventsSaveFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -5223,45 +8115,27 @@ func (q *MultiKeySortFixtureQuery) FindByID(v ...kallax.ULID) *MultiKeySortFixtu
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.MultiKeySortFixture.ID, values...))
-}
-
-// FindByName adds a new filter to the query that will require that
-// the Name property is equal to the passed value.
-func (q *MultiKeySortFixtureQuery) FindByName(v string) *MultiKeySortFixtureQuery {
-	return q.Where(kallax.Eq(Schema.MultiKeySortFixture.Name, v))
-}
-
-// FindByStart adds a new filter to the query that will require that
-// the Start property is equal to the passed value.
-func (q *MultiKeySortFixtureQuery) FindByStart(cond kallax.ScalarCond, v time.Time) *MultiKeySortFixtureQuery {
-	return q.Where(cond(Schema.MultiKeySortFixture.Start, v))
-}
-
-// FindByEnd adds a new filter to the query that will require that
-// the End property is equal to the passed value.
-func (q *MultiKeySortFixtureQuery) FindByEnd(cond kallax.ScalarCond, v time.Time) *MultiKeySortFixtureQuery {
-	return q.Where(cond(Schema.MultiKeySortFixture.End, v))
+	return q.Where(kallax.In(Schema.EventsSaveFixture.ID, values...))
 }
 
-// MultiKeySortFixtureResultSet is the set of results returned by a query to the
+// EventsSaveFixtureResultSet is the set of results returned by a query to the
 // database.
-type MultiKeySortFixtureResultSet struct {
+type EventsSaveFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *MultiKeySortFixture
+	last      *EventsSaveFixture
 	lastErr   error
 }
 
-// NewMultiKeySortFixtureResultSet creates a new result set for rows of the type
-// MultiKeySortFixture.
-func NewMultiKeySortFixtureResultSet(rs kallax.ResultSet) *MultiKeySortFixtureResultSet {
-	return &MultiKeySortFixtureResultSet{ResultSet: rs}
+// NewEventsSaveFixtureResultSet creates a new result set for rows of the type
+// EventsSaveFixture.
+func NewEventsSaveFixtureResultSet(rs kallax.ResultSet) *EventsSaveFixtureResultSet {
+	return &EventsSaveFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *MultiKeySortFixtureResultSet) Next() bool {
+func (rs *EventsSaveFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -5269,14 +8143,14 @@ func (rs *MultiKeySortFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.MultiKeySortFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.EventsSaveFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*MultiKeySortFixture)
+		rs.last, ok = record.(*EventsSaveFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *MultiKeySortFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *EventsSaveFixture")
 			rs.last = nil
 		}
 	}
@@ -5285,7 +8159,7 @@ func (rs *MultiKeySortFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *MultiKeySortFixtureResultSet) Get() (*MultiKeySortFixture, error) {
+func (rs *EventsSaveFixtureResultSet) Get() (*EventsSaveFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -5293,7 +8167,7 @@ func (rs *MultiKeySortFixtureResultSet) Get() (*MultiKeySortFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *MultiKeySortFixtureResultSet) ForEach(fn func(*MultiKeySortFixture) error) error {
+func (rs *EventsSaveFixtureResultSet) ForEach(fn func(*EventsSaveFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -5312,8 +8186,8 @@ func (rs *MultiKeySortFixtureResultSet) ForEach(fn func(*MultiKeySortFixture) er
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *MultiKeySortFixtureResultSet) All() ([]*MultiKeySortFixture, error) {
-	var result []*MultiKeySortFixture
+func (rs *EventsSaveFixtureResultSet) All() ([]*EventsSaveFixture, error) {
+	var result []*EventsSaveFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -5325,7 +8199,7 @@ func (rs *MultiKeySortFixtureResultSet) All() ([]*MultiKeySortFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *MultiKeySortFixtureResultSet) One() (*MultiKeySortFixture, error) {
+func (rs *EventsSaveFixtureResultSet) One() (*EventsSaveFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -5342,136 +8216,156 @@ func (rs *MultiKeySortFixtureResultSet) One() (*MultiKeySortFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *EventsSaveFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *MultiKeySortFixtureResultSet) Err() error {
+func (rs *EventsSaveFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *MultiKeySortFixtureResultSet) Close() error {
+func (rs *EventsSaveFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewNullable returns a new instance of Nullable.
-func NewNullable() (record *Nullable) {
-	return new(Nullable)
+// NewFullTextFixture returns a new instance of FullTextFixture.
+func NewFullTextFixture(title string) (record *FullTextFixture) {
+	return newFullTextFixture(title)
 }
 
 // GetID returns the primary key of the model.
-func (r *Nullable) GetID() kallax.Identifier {
+func (r *FullTextFixture) GetID() kallax.Identifier {
 	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *Nullable) ColumnAddress(col string) (interface{}, error) {
+func (r *FullTextFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.NumericID)(&r.ID), nil
-	case "t":
-		return types.Nullable(&r.T), nil
-	case "some_json":
-		if r.SomeJSON == nil {
-			r.SomeJSON = new(SomeJSON)
-		}
-		return types.JSON(r.SomeJSON), nil
-	case "scanner":
-		if r.Scanner == nil {
-			r.Scanner = new(kallax.ULID)
-		}
-		return types.Nullable(r.Scanner), nil
+	case "title":
+		return &r.Title, nil
+	case "document":
+		return &r.Document, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Nullable: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in FullTextFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *Nullable) Value(col string) (interface{}, error) {
+func (r *FullTextFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "t":
-		if r.T == (*time.Time)(nil) {
-			return nil, nil
-		}
-		return r.T, nil
-	case "some_json":
-		if r.SomeJSON == (*SomeJSON)(nil) {
-			return nil, nil
-		}
-		return types.JSON(r.SomeJSON), nil
-	case "scanner":
-		if r.Scanner == (*kallax.ULID)(nil) {
-			return nil, nil
-		}
-		return r.Scanner, nil
+	case "title":
+		return r.Title, nil
+	case "document":
+		return r.Document, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Nullable: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in FullTextFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *Nullable) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model Nullable has no relationships")
+func (r *FullTextFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model FullTextFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *Nullable) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model Nullable has no relationships")
+func (r *FullTextFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model FullTextFixture has no relationships")
 }
 
-// NullableStore is the entity to access the records of the type Nullable
+// FullTextFixtureStore is the entity to access the records of the type FullTextFixture
 // in the database.
-type NullableStore struct {
+type FullTextFixtureStore struct {
 	*kallax.Store
 }
 
-// NewNullableStore creates a new instance of NullableStore
+// NewFullTextFixtureStore creates a new instance of FullTextFixtureStore
 // using a SQL database.
-func NewNullableStore(db *sql.DB) *NullableStore {
-	return &NullableStore{kallax.NewStore(db)}
+func NewFullTextFixtureStore(db *sql.DB) *FullTextFixtureStore {
+	return &FullTextFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *NullableStore) GenericStore() *kallax.Store {
+func (s *FullTextFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *NullableStore) SetGenericStore(store *kallax.Store) {
+func (s *FullTextFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *NullableStore) Debug() *NullableStore {
-	return &NullableStore{s.Store.Debug()}
+func (s *FullTextFixtureStore) Debug() *FullTextFixtureStore {
+	return &FullTextFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *NullableStore) DebugWith(logger kallax.LoggerFunc) *NullableStore {
-	return &NullableStore{s.Store.DebugWith(logger)}
+func (s *FullTextFixtureStore) DebugWith(logger kallax.LoggerFunc) *FullTextFixtureStore {
+	return &FullTextFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *NullableStore) DisableCacher() *NullableStore {
-	return &NullableStore{s.Store.DisableCacher()}
+func (s *FullTextFixtureStore) DisableCacher() *FullTextFixtureStore {
+	return &FullTextFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a Nullable in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *FullTextFixtureStore) WithDialect(dialect kallax.Dialect) *FullTextFixtureStore {
+	return &FullTextFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a FullTextFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *NullableStore) Insert(record *Nullable) error {
+func (s *FullTextFixtureStore) Insert(record *FullTextFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *FullTextFixtureStore) InsertContext(ctx context.Context, record *FullTextFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if record.T != nil {
-		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
+	return s.Store.InsertContext(ctx, Schema.FullTextFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given FullTextFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *FullTextFixtureStore) InsertMany(records []*FullTextFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *FullTextFixtureStore) InsertManyContext(ctx context.Context, records []*FullTextFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
 	}
 
-	return s.Store.Insert(Schema.Nullable.BaseSchema, record)
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.FullTextFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -5480,25 +8374,33 @@ func (s *NullableStore) Insert(record *Nullable) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *NullableStore) Update(record *Nullable, cols ...kallax.SchemaField) (updated int64, err error) {
-	if record.T != nil {
-		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
-	}
+func (s *FullTextFixtureStore) Update(record *FullTextFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *FullTextFixtureStore) UpdateContext(ctx context.Context, record *FullTextFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.Nullable.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.FullTextFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *NullableStore) Save(record *Nullable) (updated bool, err error) {
+func (s *FullTextFixtureStore) Save(record *FullTextFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *FullTextFixtureStore) SaveContext(ctx context.Context, record *FullTextFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -5506,45 +8408,159 @@ func (s *NullableStore) Save(record *Nullable) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *FullTextFixtureStore) Upsert(record *FullTextFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *FullTextFixtureStore) UpsertContext(ctx context.Context, record *FullTextFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.FullTextFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *NullableStore) Delete(record *Nullable) error {
-	return s.Store.Delete(Schema.Nullable.BaseSchema, record)
+func (s *FullTextFixtureStore) Delete(record *FullTextFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *FullTextFixtureStore) DeleteContext(ctx context.Context, record *FullTextFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.FullTextFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *FullTextFixtureStore) UpdateWhere(q *FullTextFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *FullTextFixtureStore) UpdateWhereContext(ctx context.Context, q *FullTextFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *FullTextFixtureStore) UpdateWhereReturning(q *FullTextFixtureQuery, values map[kallax.SchemaField]interface{}) (*FullTextFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *FullTextFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *FullTextFixtureQuery, values map[kallax.SchemaField]interface{}) (*FullTextFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFullTextFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *FullTextFixtureStore) DeleteWhere(q *FullTextFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *FullTextFixtureStore) DeleteWhereContext(ctx context.Context, q *FullTextFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *FullTextFixtureStore) DeleteWhereReturning(q *FullTextFixtureQuery) (*FullTextFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *FullTextFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *FullTextFixtureQuery) (*FullTextFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFullTextFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *NullableStore) Find(q *NullableQuery) (*NullableResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *FullTextFixtureStore) Find(q *FullTextFixtureQuery) (*FullTextFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *FullTextFixtureStore) FindContext(ctx context.Context, q *FullTextFixtureQuery) (*FullTextFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewNullableResultSet(rs), nil
+	return NewFullTextFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *NullableStore) MustFind(q *NullableQuery) *NullableResultSet {
-	return NewNullableResultSet(s.Store.MustFind(q))
+func (s *FullTextFixtureStore) MustFind(q *FullTextFixtureQuery) *FullTextFixtureResultSet {
+	return NewFullTextFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *FullTextFixtureStore) MustFindContext(ctx context.Context, q *FullTextFixtureQuery) *FullTextFixtureResultSet {
+	return NewFullTextFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *NullableStore) Count(q *NullableQuery) (int64, error) {
+func (s *FullTextFixtureStore) Count(q *FullTextFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *FullTextFixtureStore) CountContext(ctx context.Context, q *FullTextFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *NullableStore) MustCount(q *NullableQuery) int64 {
+func (s *FullTextFixtureStore) MustCount(q *FullTextFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *FullTextFixtureStore) MustCountContext(ctx context.Context, q *FullTextFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *NullableStore) FindOne(q *NullableQuery) (*Nullable, error) {
+func (s *FullTextFixtureStore) FindOne(q *FullTextFixtureQuery) (*FullTextFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *FullTextFixtureStore) FindOneContext(ctx context.Context, q *FullTextFixtureQuery) (*FullTextFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5566,8 +8582,14 @@ func (s *NullableStore) FindOne(q *NullableQuery) (*Nullable, error) {
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *NullableStore) FindAll(q *NullableQuery) ([]*Nullable, error) {
-	rs, err := s.Find(q)
+func (s *FullTextFixtureStore) FindAll(q *FullTextFixtureQuery) ([]*FullTextFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *FullTextFixtureStore) FindAllContext(ctx context.Context, q *FullTextFixtureQuery) ([]*FullTextFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5577,49 +8599,67 @@ func (s *NullableStore) FindAll(q *NullableQuery) ([]*Nullable, error) {
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *NullableStore) MustFindOne(q *NullableQuery) *Nullable {
-	record, err := s.FindOne(q)
+func (s *FullTextFixtureStore) MustFindOne(q *FullTextFixtureQuery) *FullTextFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *FullTextFixtureStore) MustFindOneContext(ctx context.Context, q *FullTextFixtureQuery) *FullTextFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the Nullable with the data in the database and
+// Reload refreshes the FullTextFixture with the data in the database and
 // makes it writable.
-func (s *NullableStore) Reload(record *Nullable) error {
-	return s.Store.Reload(Schema.Nullable.BaseSchema, record)
+func (s *FullTextFixtureStore) Reload(record *FullTextFixture) error {
+	return s.Store.Reload(Schema.FullTextFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *FullTextFixtureStore) ReloadContext(ctx context.Context, record *FullTextFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.FullTextFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *NullableStore) Transaction(callback func(*NullableStore) error) error {
+func (s *FullTextFixtureStore) Transaction(callback func(*FullTextFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *FullTextFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*FullTextFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&NullableStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&FullTextFixtureStore{store})
 	})
 }
 
-// NullableQuery is the object used to create queries for the Nullable
+// FullTextFixtureQuery is the object used to create queries for the FullTextFixture
 // entity.
-type NullableQuery struct {
+type FullTextFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewNullableQuery returns a new instance of NullableQuery.
-func NewNullableQuery() *NullableQuery {
-	return &NullableQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.Nullable.BaseSchema),
+// NewFullTextFixtureQuery returns a new instance of FullTextFixtureQuery.
+func NewFullTextFixtureQuery() *FullTextFixtureQuery {
+	return &FullTextFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.FullTextFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *NullableQuery) Select(columns ...kallax.SchemaField) *NullableQuery {
+func (q *FullTextFixtureQuery) Select(columns ...kallax.SchemaField) *FullTextFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -5628,55 +8668,109 @@ func (q *NullableQuery) Select(columns ...kallax.SchemaField) *NullableQuery {
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *NullableQuery) SelectNot(columns ...kallax.SchemaField) *NullableQuery {
+func (q *FullTextFixtureQuery) SelectNot(columns ...kallax.SchemaField) *FullTextFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *NullableQuery) Copy() *NullableQuery {
-	return &NullableQuery{
+func (q *FullTextFixtureQuery) Copy() *FullTextFixtureQuery {
+	return &FullTextFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *NullableQuery) Order(cols ...kallax.ColumnOrder) *NullableQuery {
+func (q *FullTextFixtureQuery) Order(cols ...kallax.ColumnOrder) *FullTextFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *FullTextFixtureQuery) After(cursor *kallax.Cursor) *FullTextFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *FullTextFixtureQuery) Before(cursor *kallax.Cursor) *FullTextFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *NullableQuery) BatchSize(size uint64) *NullableQuery {
+func (q *FullTextFixtureQuery) BatchSize(size uint64) *FullTextFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *NullableQuery) Limit(n uint64) *NullableQuery {
+func (q *FullTextFixtureQuery) Limit(n uint64) *FullTextFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *NullableQuery) Offset(n uint64) *NullableQuery {
+func (q *FullTextFixtureQuery) Offset(n uint64) *FullTextFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *NullableQuery) Where(cond kallax.Condition) *NullableQuery {
+func (q *FullTextFixtureQuery) Where(cond kallax.Condition) *FullTextFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *FullTextFixtureQuery) GroupBy(cols ...kallax.SchemaField) *FullTextFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *FullTextFixtureQuery) Having(cond kallax.Condition) *FullTextFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *FullTextFixtureQuery) ForUpdate() *FullTextFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *FullTextFixtureQuery) ForShare() *FullTextFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *FullTextFixtureQuery) NoWait() *FullTextFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *FullTextFixtureQuery) SkipLocked() *FullTextFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *NullableQuery) FindByID(v ...int64) *NullableQuery {
+func (q *FullTextFixtureQuery) FindByID(v ...int64) *FullTextFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -5684,39 +8778,39 @@ func (q *NullableQuery) FindByID(v ...int64) *NullableQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.Nullable.ID, values...))
+	return q.Where(kallax.In(Schema.FullTextFixture.ID, values...))
 }
 
-// FindByT adds a new filter to the query that will require that
-// the T property is equal to the passed value.
-func (q *NullableQuery) FindByT(cond kallax.ScalarCond, v time.Time) *NullableQuery {
-	return q.Where(cond(Schema.Nullable.T, v))
+// FindByTitle adds a new filter to the query that will require that
+// the Title property is equal to the passed value.
+func (q *FullTextFixtureQuery) FindByTitle(v string) *FullTextFixtureQuery {
+	return q.Where(kallax.Eq(Schema.FullTextFixture.Title, v))
 }
 
-// FindByScanner adds a new filter to the query that will require that
-// the Scanner property is equal to the passed value.
-func (q *NullableQuery) FindByScanner(v kallax.ULID) *NullableQuery {
-	return q.Where(kallax.Eq(Schema.Nullable.Scanner, v))
+// FindByDocument adds a new filter to the query that will require that
+// the Document property is equal to the passed value.
+func (q *FullTextFixtureQuery) FindByDocument(v types.TSVector) *FullTextFixtureQuery {
+	return q.Where(kallax.Eq(Schema.FullTextFixture.Document, v))
 }
 
-// NullableResultSet is the set of results returned by a query to the
+// FullTextFixtureResultSet is the set of results returned by a query to the
 // database.
-type NullableResultSet struct {
+type FullTextFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *Nullable
+	last      *FullTextFixture
 	lastErr   error
 }
 
-// NewNullableResultSet creates a new result set for rows of the type
-// Nullable.
-func NewNullableResultSet(rs kallax.ResultSet) *NullableResultSet {
-	return &NullableResultSet{ResultSet: rs}
+// NewFullTextFixtureResultSet creates a new result set for rows of the type
+// FullTextFixture.
+func NewFullTextFixtureResultSet(rs kallax.ResultSet) *FullTextFixtureResultSet {
+	return &FullTextFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *NullableResultSet) Next() bool {
+func (rs *FullTextFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -5724,14 +8818,14 @@ func (rs *NullableResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.Nullable.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.FullTextFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*Nullable)
+		rs.last, ok = record.(*FullTextFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Nullable")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *FullTextFixture")
 			rs.last = nil
 		}
 	}
@@ -5740,7 +8834,7 @@ func (rs *NullableResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *NullableResultSet) Get() (*Nullable, error) {
+func (rs *FullTextFixtureResultSet) Get() (*FullTextFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -5748,7 +8842,7 @@ func (rs *NullableResultSet) Get() (*Nullable, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *NullableResultSet) ForEach(fn func(*Nullable) error) error {
+func (rs *FullTextFixtureResultSet) ForEach(fn func(*FullTextFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -5767,8 +8861,8 @@ func (rs *NullableResultSet) ForEach(fn func(*Nullable) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *NullableResultSet) All() ([]*Nullable, error) {
-	var result []*Nullable
+func (rs *FullTextFixtureResultSet) All() ([]*FullTextFixture, error) {
+	var result []*FullTextFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -5780,7 +8874,7 @@ func (rs *NullableResultSet) All() ([]*Nullable, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *NullableResultSet) One() (*Nullable, error) {
+func (rs *FullTextFixtureResultSet) One() (*FullTextFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -5797,167 +8891,170 @@ func (rs *NullableResultSet) One() (*Nullable, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *FullTextFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *NullableResultSet) Err() error {
+func (rs *FullTextFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *NullableResultSet) Close() error {
+func (rs *FullTextFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewParent returns a new instance of Parent.
-func NewParent() (record *Parent) {
-	return new(Parent)
+// NewJSONModel returns a new instance of JSONModel.
+func NewJSONModel() (record *JSONModel) {
+	return newJSONModel()
 }
 
 // GetID returns the primary key of the model.
-func (r *Parent) GetID() kallax.Identifier {
-	return (*kallax.NumericID)(&r.ID)
+func (r *JSONModel) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *Parent) ColumnAddress(col string) (interface{}, error) {
+func (r *JSONModel) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.NumericID)(&r.ID), nil
-	case "name":
-		return &r.Name, nil
+		return (*kallax.ULID)(&r.ID), nil
+	case "foo":
+		return &r.Foo, nil
+	case "bar":
+		if r.Bar == nil {
+			r.Bar = new(Bar)
+		}
+		return types.JSON(r.Bar), nil
+	case "baz_slice":
+		return types.JSON(&r.BazSlice), nil
+	case "baz":
+		return types.JSON(&r.Baz), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Parent: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in JSONModel: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *Parent) Value(col string) (interface{}, error) {
+func (r *JSONModel) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "name":
-		return r.Name, nil
+	case "foo":
+		return r.Foo, nil
+	case "bar":
+		if r.Bar == (*Bar)(nil) {
+			return nil, nil
+		}
+		return types.JSON(r.Bar), nil
+	case "baz_slice":
+		return types.JSON(r.BazSlice), nil
+	case "baz":
+		return types.JSON(r.Baz), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Parent: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in JSONModel: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *Parent) NewRelationshipRecord(field string) (kallax.Record, error) {
-	switch field {
-	case "Children":
-		return new(Child), nil
-
-	}
-	return nil, fmt.Errorf("kallax: model Parent has no relationship %s", field)
+func (r *JSONModel) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model JSONModel has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *Parent) SetRelationship(field string, rel interface{}) error {
-	switch field {
-	case "Children":
-		records, ok := rel.([]kallax.Record)
-		if !ok {
-			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
-		}
-
-		r.Children = make([]*Child, len(records))
-		for i, record := range records {
-			rel, ok := record.(*Child)
-			if !ok {
-				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
-			}
-			r.Children[i] = rel
-		}
-		return nil
-
-	}
-	return fmt.Errorf("kallax: model Parent has no relationship %s", field)
+func (r *JSONModel) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model JSONModel has no relationships")
 }
 
-// ParentStore is the entity to access the records of the type Parent
+// JSONModelStore is the entity to access the records of the type JSONModel
 // in the database.
-type ParentStore struct {
+type JSONModelStore struct {
 	*kallax.Store
 }
 
-// NewParentStore creates a new instance of ParentStore
+// NewJSONModelStore creates a new instance of JSONModelStore
 // using a SQL database.
-func NewParentStore(db *sql.DB) *ParentStore {
-	return &ParentStore{kallax.NewStore(db)}
+func NewJSONModelStore(db *sql.DB) *JSONModelStore {
+	return &JSONModelStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *ParentStore) GenericStore() *kallax.Store {
+func (s *JSONModelStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *ParentStore) SetGenericStore(store *kallax.Store) {
+func (s *JSONModelStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *ParentStore) Debug() *ParentStore {
-	return &ParentStore{s.Store.Debug()}
+func (s *JSONModelStore) Debug() *JSONModelStore {
+	return &JSONModelStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *ParentStore) DebugWith(logger kallax.LoggerFunc) *ParentStore {
-	return &ParentStore{s.Store.DebugWith(logger)}
+func (s *JSONModelStore) DebugWith(logger kallax.LoggerFunc) *JSONModelStore {
+	return &JSONModelStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *ParentStore) DisableCacher() *ParentStore {
-	return &ParentStore{s.Store.DisableCacher()}
+func (s *JSONModelStore) DisableCacher() *JSONModelStore {
+	return &JSONModelStore{s.Store.DisableCacher()}
 }
 
-func (s *ParentStore) relationshipRecords(record *Parent) []modelSaveFunc {
-	var result []modelSaveFunc
-
-	for i := range record.Children {
-		r := record.Children[i]
-		if !r.IsSaving() {
-			r.AddVirtualColumn("parent_id", record.GetID())
-			result = append(result, func(store *kallax.Store) error {
-				_, err := (&ChildStore{store}).Save(r)
-				return err
-			})
-		}
-	}
-
-	return result
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *JSONModelStore) WithDialect(dialect kallax.Dialect) *JSONModelStore {
+	return &JSONModelStore{s.Store.WithDialect(dialect)}
 }
 
-// Insert inserts a Parent in the database. A non-persisted object is
+// Insert inserts a JSONModel in the database. A non-persisted object is
 // required for this operation.
-func (s *ParentStore) Insert(record *Parent) error {
+func (s *JSONModelStore) Insert(record *JSONModel) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *JSONModelStore) InsertContext(ctx context.Context, record *JSONModel) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	records := s.relationshipRecords(record)
+	return s.Store.InsertContext(ctx, Schema.JSONModel.BaseSchema, record)
+}
 
-	if len(records) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			if err := s.Insert(Schema.Parent.BaseSchema, record); err != nil {
-				return err
-			}
+// InsertMany inserts all the given JSONModel in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *JSONModelStore) InsertMany(records []*JSONModel) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *JSONModelStore) InsertManyContext(ctx context.Context, records []*JSONModel) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
 
-			return nil
-		})
+		recs[i] = record
 	}
 
-	return s.Store.Insert(Schema.Parent.BaseSchema, record)
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.JSONModel.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -5966,45 +9063,33 @@ func (s *ParentStore) Insert(record *Parent) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *ParentStore) Update(record *Parent, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *JSONModelStore) Update(record *JSONModel, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *JSONModelStore) UpdateContext(ctx context.Context, record *JSONModel, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	records := s.relationshipRecords(record)
-
-	if len(records) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			updated, err = s.Update(Schema.Parent.BaseSchema, record, cols...)
-			if err != nil {
-				return err
-			}
-
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
-
-			return nil
-		})
-		if err != nil {
-			return 0, err
-		}
-
-		return updated, nil
-	}
-
-	return s.Store.Update(Schema.Parent.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.JSONModel.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *ParentStore) Save(record *Parent) (updated bool, err error) {
+func (s *JSONModelStore) Save(record *JSONModel) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *JSONModelStore) SaveContext(ctx context.Context, record *JSONModel) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -6012,51 +9097,165 @@ func (s *ParentStore) Save(record *Parent) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *JSONModelStore) Upsert(record *JSONModel, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *JSONModelStore) UpsertContext(ctx context.Context, record *JSONModel, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.JSONModel.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *ParentStore) Delete(record *Parent) error {
-	return s.Store.Delete(Schema.Parent.BaseSchema, record)
+func (s *JSONModelStore) Delete(record *JSONModel) error {
+	return s.DeleteContext(context.Background(), record)
 }
 
-// Find returns the set of results for the given query.
-func (s *ParentStore) Find(q *ParentQuery) (*ParentResultSet, error) {
-	rs, err := s.Store.Find(q)
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *JSONModelStore) DeleteContext(ctx context.Context, record *JSONModel) error {
+	return s.Store.DeleteContext(ctx, Schema.JSONModel.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *JSONModelStore) UpdateWhere(q *JSONModelQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *JSONModelStore) UpdateWhereContext(ctx context.Context, q *JSONModelQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *JSONModelStore) UpdateWhereReturning(q *JSONModelQuery, values map[kallax.SchemaField]interface{}) (*JSONModelResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *JSONModelStore) UpdateWhereReturningContext(ctx context.Context, q *JSONModelQuery, values map[kallax.SchemaField]interface{}) (*JSONModelResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewParentResultSet(rs), nil
+	return NewJSONModelResultSet(rs), nil
 }
 
-// MustFind returns the set of results for the given query, but panics if there
-// is any error.
-func (s *ParentStore) MustFind(q *ParentQuery) *ParentResultSet {
-	return NewParentResultSet(s.Store.MustFind(q))
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *JSONModelStore) DeleteWhere(q *JSONModelQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
 }
 
-// Count returns the number of rows that would be retrieved with the given
-// query.
-func (s *ParentStore) Count(q *ParentQuery) (int64, error) {
-	return s.Store.Count(q)
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *JSONModelStore) DeleteWhereContext(ctx context.Context, q *JSONModelQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
 }
 
-// MustCount returns the number of rows that would be retrieved with the given
-// query, but panics if there is an error.
-func (s *ParentStore) MustCount(q *ParentQuery) int64 {
-	return s.Store.MustCount(q)
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *JSONModelStore) DeleteWhereReturning(q *JSONModelQuery) (*JSONModelResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
 }
 
-// FindOne returns the first row returned by the given query.
-// `ErrNotFound` is returned if there are no results.
-func (s *ParentStore) FindOne(q *ParentQuery) (*Parent, error) {
-	q.Limit(1)
-	q.Offset(0)
-	rs, err := s.Find(q)
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *JSONModelStore) DeleteWhereReturningContext(ctx context.Context, q *JSONModelQuery) (*JSONModelResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	if !rs.Next() {
-		return nil, kallax.ErrNotFound
+	return NewJSONModelResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *JSONModelStore) Find(q *JSONModelQuery) (*JSONModelResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *JSONModelStore) FindContext(ctx context.Context, q *JSONModelQuery) (*JSONModelResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJSONModelResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *JSONModelStore) MustFind(q *JSONModelQuery) *JSONModelResultSet {
+	return NewJSONModelResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *JSONModelStore) MustFindContext(ctx context.Context, q *JSONModelQuery) *JSONModelResultSet {
+	return NewJSONModelResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *JSONModelStore) Count(q *JSONModelQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *JSONModelStore) CountContext(ctx context.Context, q *JSONModelQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *JSONModelStore) MustCount(q *JSONModelQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *JSONModelStore) MustCountContext(ctx context.Context, q *JSONModelQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *JSONModelStore) FindOne(q *JSONModelQuery) (*JSONModel, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *JSONModelStore) FindOneContext(ctx context.Context, q *JSONModelQuery) (*JSONModel, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
 	}
 
 	record, err := rs.Get()
@@ -6072,8 +9271,14 @@ func (s *ParentStore) FindOne(q *ParentQuery) (*Parent, error) {
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *ParentStore) FindAll(q *ParentQuery) ([]*Parent, error) {
-	rs, err := s.Find(q)
+func (s *JSONModelStore) FindAll(q *JSONModelQuery) ([]*JSONModel, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *JSONModelStore) FindAllContext(ctx context.Context, q *JSONModelQuery) ([]*JSONModel, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6083,141 +9288,67 @@ func (s *ParentStore) FindAll(q *ParentQuery) ([]*Parent, error) {
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *ParentStore) MustFindOne(q *ParentQuery) *Parent {
-	record, err := s.FindOne(q)
+func (s *JSONModelStore) MustFindOne(q *JSONModelQuery) *JSONModel {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *JSONModelStore) MustFindOneContext(ctx context.Context, q *JSONModelQuery) *JSONModel {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the Parent with the data in the database and
+// Reload refreshes the JSONModel with the data in the database and
 // makes it writable.
-func (s *ParentStore) Reload(record *Parent) error {
-	return s.Store.Reload(Schema.Parent.BaseSchema, record)
+func (s *JSONModelStore) Reload(record *JSONModel) error {
+	return s.Store.Reload(Schema.JSONModel.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *JSONModelStore) ReloadContext(ctx context.Context, record *JSONModel) error {
+	return s.Store.ReloadContext(ctx, Schema.JSONModel.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *ParentStore) Transaction(callback func(*ParentStore) error) error {
+func (s *JSONModelStore) Transaction(callback func(*JSONModelStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *JSONModelStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*JSONModelStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&ParentStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&JSONModelStore{store})
 	})
 }
 
-// RemoveChildren removes the given items of the Children field of the
-// model. If no items are given, it removes all of them.
-// The items will also be removed from the passed record inside this method.
-// Note that is required that `Children` is not empty. This method clears the
-// the elements of Children in a model, it does not retrieve them to know
-// what relationships the model has.
-func (s *ParentStore) RemoveChildren(record *Parent, deleted ...*Child) error {
-	var updated []*Child
-	var clear bool
-	if len(deleted) == 0 {
-		clear = true
-		deleted = record.Children
-		if len(deleted) == 0 {
-			return nil
-		}
-	}
-
-	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
-			for _, d := range deleted {
-				var r kallax.Record = d
-
-				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-					if err := beforeDeleter.BeforeDelete(); err != nil {
-						return err
-					}
-				}
-
-				if err := s.Delete(Schema.Child.BaseSchema, d); err != nil {
-					return err
-				}
-
-				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-					if err := afterDeleter.AfterDelete(); err != nil {
-						return err
-					}
-				}
-			}
-			return nil
-		})
-
-		if err != nil {
-			return err
-		}
-
-		if clear {
-			record.Children = nil
-			return nil
-		}
-	} else {
-		var r kallax.Record = deleted[0]
-		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-			if err := beforeDeleter.BeforeDelete(); err != nil {
-				return err
-			}
-		}
-
-		var err error
-		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.Child.BaseSchema, r)
-				if err != nil {
-					return err
-				}
-
-				return afterDeleter.AfterDelete()
-			})
-		} else {
-			err = s.Store.Delete(Schema.Child.BaseSchema, deleted[0])
-		}
-
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, r := range record.Children {
-		var found bool
-		for _, d := range deleted {
-			if d.GetID().Equals(r.GetID()) {
-				found = true
-				break
-			}
-		}
-		if !found {
-			updated = append(updated, r)
-		}
-	}
-	record.Children = updated
-	return nil
-}
-
-// ParentQuery is the object used to create queries for the Parent
+// JSONModelQuery is the object used to create queries for the JSONModel
 // entity.
-type ParentQuery struct {
+type JSONModelQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewParentQuery returns a new instance of ParentQuery.
-func NewParentQuery() *ParentQuery {
-	return &ParentQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.Parent.BaseSchema),
+// NewJSONModelQuery returns a new instance of JSONModelQuery.
+func NewJSONModelQuery() *JSONModelQuery {
+	return &JSONModelQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.JSONModel.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *ParentQuery) Select(columns ...kallax.SchemaField) *ParentQuery {
+func (q *JSONModelQuery) Select(columns ...kallax.SchemaField) *JSONModelQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -6226,60 +9357,109 @@ func (q *ParentQuery) Select(columns ...kallax.SchemaField) *ParentQuery {
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *ParentQuery) SelectNot(columns ...kallax.SchemaField) *ParentQuery {
+func (q *JSONModelQuery) SelectNot(columns ...kallax.SchemaField) *JSONModelQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *ParentQuery) Copy() *ParentQuery {
-	return &ParentQuery{
+func (q *JSONModelQuery) Copy() *JSONModelQuery {
+	return &JSONModelQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *ParentQuery) Order(cols ...kallax.ColumnOrder) *ParentQuery {
+func (q *JSONModelQuery) Order(cols ...kallax.ColumnOrder) *JSONModelQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *JSONModelQuery) After(cursor *kallax.Cursor) *JSONModelQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *JSONModelQuery) Before(cursor *kallax.Cursor) *JSONModelQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *ParentQuery) BatchSize(size uint64) *ParentQuery {
+func (q *JSONModelQuery) BatchSize(size uint64) *JSONModelQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *ParentQuery) Limit(n uint64) *ParentQuery {
+func (q *JSONModelQuery) Limit(n uint64) *JSONModelQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *ParentQuery) Offset(n uint64) *ParentQuery {
+func (q *JSONModelQuery) Offset(n uint64) *JSONModelQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *ParentQuery) Where(cond kallax.Condition) *ParentQuery {
+func (q *JSONModelQuery) Where(cond kallax.Condition) *JSONModelQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *ParentQuery) WithChildren(cond kallax.Condition) *ParentQuery {
-	q.AddRelation(Schema.Child.BaseSchema, "Children", kallax.OneToMany, cond)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *JSONModelQuery) GroupBy(cols ...kallax.SchemaField) *JSONModelQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *JSONModelQuery) Having(cond kallax.Condition) *JSONModelQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *JSONModelQuery) ForUpdate() *JSONModelQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *JSONModelQuery) ForShare() *JSONModelQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *JSONModelQuery) NoWait() *JSONModelQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *JSONModelQuery) SkipLocked() *JSONModelQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *ParentQuery) FindByID(v ...int64) *ParentQuery {
+func (q *JSONModelQuery) FindByID(v ...kallax.ULID) *JSONModelQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -6287,33 +9467,33 @@ func (q *ParentQuery) FindByID(v ...int64) *ParentQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.Parent.ID, values...))
+	return q.Where(kallax.In(Schema.JSONModel.ID, values...))
 }
 
-// FindByName adds a new filter to the query that will require that
-// the Name property is equal to the passed value.
-func (q *ParentQuery) FindByName(v string) *ParentQuery {
-	return q.Where(kallax.Eq(Schema.Parent.Name, v))
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *JSONModelQuery) FindByFoo(v string) *JSONModelQuery {
+	return q.Where(kallax.Eq(Schema.JSONModel.Foo, v))
 }
 
-// ParentResultSet is the set of results returned by a query to the
+// JSONModelResultSet is the set of results returned by a query to the
 // database.
-type ParentResultSet struct {
+type JSONModelResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *Parent
+	last      *JSONModel
 	lastErr   error
 }
 
-// NewParentResultSet creates a new result set for rows of the type
-// Parent.
-func NewParentResultSet(rs kallax.ResultSet) *ParentResultSet {
-	return &ParentResultSet{ResultSet: rs}
+// NewJSONModelResultSet creates a new result set for rows of the type
+// JSONModel.
+func NewJSONModelResultSet(rs kallax.ResultSet) *JSONModelResultSet {
+	return &JSONModelResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *ParentResultSet) Next() bool {
+func (rs *JSONModelResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -6321,14 +9501,14 @@ func (rs *ParentResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.Parent.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.JSONModel.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*Parent)
+		rs.last, ok = record.(*JSONModel)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Parent")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *JSONModel")
 			rs.last = nil
 		}
 	}
@@ -6337,7 +9517,7 @@ func (rs *ParentResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *ParentResultSet) Get() (*Parent, error) {
+func (rs *JSONModelResultSet) Get() (*JSONModel, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -6345,7 +9525,7 @@ func (rs *ParentResultSet) Get() (*Parent, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *ParentResultSet) ForEach(fn func(*Parent) error) error {
+func (rs *JSONModelResultSet) ForEach(fn func(*JSONModel) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -6364,9 +9544,9 @@ func (rs *ParentResultSet) ForEach(fn func(*Parent) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *ParentResultSet) All() ([]*Parent, error) {
-	var result []*Parent
-	for rs.Next() {
+func (rs *JSONModelResultSet) All() ([]*JSONModel, error) {
+	var result []*JSONModel
+	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
 			return nil, err
@@ -6377,7 +9557,7 @@ func (rs *ParentResultSet) All() ([]*Parent, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *ParentResultSet) One() (*Parent, error) {
+func (rs *JSONModelResultSet) One() (*JSONModel, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -6394,167 +9574,165 @@ func (rs *ParentResultSet) One() (*Parent, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *JSONModelResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *ParentResultSet) Err() error {
+func (rs *JSONModelResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *ParentResultSet) Close() error {
+func (rs *JSONModelResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewParentNoPtr returns a new instance of ParentNoPtr.
-func NewParentNoPtr() (record *ParentNoPtr) {
-	return new(ParentNoPtr)
+// NewMultiKeySortFixture returns a new instance of MultiKeySortFixture.
+func NewMultiKeySortFixture() (record *MultiKeySortFixture) {
+	return newMultiKeySortFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *ParentNoPtr) GetID() kallax.Identifier {
-	return (*kallax.NumericID)(&r.ID)
+func (r *MultiKeySortFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *ParentNoPtr) ColumnAddress(col string) (interface{}, error) {
+func (r *MultiKeySortFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.NumericID)(&r.ID), nil
+		return (*kallax.ULID)(&r.ID), nil
 	case "name":
 		return &r.Name, nil
+	case "start":
+		return &r.Start, nil
+	case "_end":
+		return &r.End, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in ParentNoPtr: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in MultiKeySortFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *ParentNoPtr) Value(col string) (interface{}, error) {
+func (r *MultiKeySortFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
 	case "name":
 		return r.Name, nil
+	case "start":
+		return r.Start, nil
+	case "_end":
+		return r.End, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in ParentNoPtr: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in MultiKeySortFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *ParentNoPtr) NewRelationshipRecord(field string) (kallax.Record, error) {
-	switch field {
-	case "Children":
-		return new(Child), nil
-
-	}
-	return nil, fmt.Errorf("kallax: model ParentNoPtr has no relationship %s", field)
+func (r *MultiKeySortFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model MultiKeySortFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *ParentNoPtr) SetRelationship(field string, rel interface{}) error {
-	switch field {
-	case "Children":
-		records, ok := rel.([]kallax.Record)
-		if !ok {
-			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
-		}
-
-		r.Children = make([]Child, len(records))
-		for i, record := range records {
-			rel, ok := record.(*Child)
-			if !ok {
-				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
-			}
-			r.Children[i] = *rel
-		}
-		return nil
-
-	}
-	return fmt.Errorf("kallax: model ParentNoPtr has no relationship %s", field)
+func (r *MultiKeySortFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model MultiKeySortFixture has no relationships")
 }
 
-// ParentNoPtrStore is the entity to access the records of the type ParentNoPtr
+// MultiKeySortFixtureStore is the entity to access the records of the type MultiKeySortFixture
 // in the database.
-type ParentNoPtrStore struct {
+type MultiKeySortFixtureStore struct {
 	*kallax.Store
 }
 
-// NewParentNoPtrStore creates a new instance of ParentNoPtrStore
+// NewMultiKeySortFixtureStore creates a new instance of MultiKeySortFixtureStore
 // using a SQL database.
-func NewParentNoPtrStore(db *sql.DB) *ParentNoPtrStore {
-	return &ParentNoPtrStore{kallax.NewStore(db)}
+func NewMultiKeySortFixtureStore(db *sql.DB) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *ParentNoPtrStore) GenericStore() *kallax.Store {
+func (s *MultiKeySortFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *ParentNoPtrStore) SetGenericStore(store *kallax.Store) {
+func (s *MultiKeySortFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *ParentNoPtrStore) Debug() *ParentNoPtrStore {
-	return &ParentNoPtrStore{s.Store.Debug()}
+func (s *MultiKeySortFixtureStore) Debug() *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *ParentNoPtrStore) DebugWith(logger kallax.LoggerFunc) *ParentNoPtrStore {
-	return &ParentNoPtrStore{s.Store.DebugWith(logger)}
+func (s *MultiKeySortFixtureStore) DebugWith(logger kallax.LoggerFunc) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *ParentNoPtrStore) DisableCacher() *ParentNoPtrStore {
-	return &ParentNoPtrStore{s.Store.DisableCacher()}
+func (s *MultiKeySortFixtureStore) DisableCacher() *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.DisableCacher()}
 }
 
-func (s *ParentNoPtrStore) relationshipRecords(record *ParentNoPtr) []modelSaveFunc {
-	var result []modelSaveFunc
-
-	for i := range record.Children {
-		r := &record.Children[i]
-		if !r.IsSaving() {
-			r.AddVirtualColumn("parent_id", record.GetID())
-			result = append(result, func(store *kallax.Store) error {
-				_, err := (&ChildStore{store}).Save(r)
-				return err
-			})
-		}
-	}
-
-	return result
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *MultiKeySortFixtureStore) WithDialect(dialect kallax.Dialect) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.WithDialect(dialect)}
 }
 
-// Insert inserts a ParentNoPtr in the database. A non-persisted object is
+// Insert inserts a MultiKeySortFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *ParentNoPtrStore) Insert(record *ParentNoPtr) error {
+func (s *MultiKeySortFixtureStore) Insert(record *MultiKeySortFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *MultiKeySortFixtureStore) InsertContext(ctx context.Context, record *MultiKeySortFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	records := s.relationshipRecords(record)
+	record.Start = record.Start.Truncate(time.Microsecond)
+	record.End = record.End.Truncate(time.Microsecond)
 
-	if len(records) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			if err := s.Insert(Schema.ParentNoPtr.BaseSchema, record); err != nil {
-				return err
-			}
+	return s.Store.InsertContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record)
+}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// InsertMany inserts all the given MultiKeySortFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *MultiKeySortFixtureStore) InsertMany(records []*MultiKeySortFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-			return nil
-		})
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *MultiKeySortFixtureStore) InsertManyContext(ctx context.Context, records []*MultiKeySortFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+		record.Start = record.Start.Truncate(time.Microsecond)
+		record.End = record.End.Truncate(time.Microsecond)
+
+		recs[i] = record
 	}
 
-	return s.Store.Insert(Schema.ParentNoPtr.BaseSchema, record)
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.MultiKeySortFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -6563,45 +9741,36 @@ func (s *ParentNoPtrStore) Insert(record *ParentNoPtr) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *ParentNoPtrStore) Update(record *ParentNoPtr, cols ...kallax.SchemaField) (updated int64, err error) {
-	record.SetSaving(true)
-	defer record.SetSaving(false)
-
-	records := s.relationshipRecords(record)
-
-	if len(records) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			updated, err = s.Update(Schema.ParentNoPtr.BaseSchema, record, cols...)
-			if err != nil {
-				return err
-			}
-
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+func (s *MultiKeySortFixtureStore) Update(record *MultiKeySortFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
-			return nil
-		})
-		if err != nil {
-			return 0, err
-		}
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *MultiKeySortFixtureStore) UpdateContext(ctx context.Context, record *MultiKeySortFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.Start = record.Start.Truncate(time.Microsecond)
+	record.End = record.End.Truncate(time.Microsecond)
 
-		return updated, nil
-	}
+	record.SetSaving(true)
+	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.ParentNoPtr.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *ParentNoPtrStore) Save(record *ParentNoPtr) (updated bool, err error) {
+func (s *MultiKeySortFixtureStore) Save(record *MultiKeySortFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *MultiKeySortFixtureStore) SaveContext(ctx context.Context, record *MultiKeySortFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -6609,45 +9778,162 @@ func (s *ParentNoPtrStore) Save(record *ParentNoPtr) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *MultiKeySortFixtureStore) Upsert(record *MultiKeySortFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *MultiKeySortFixtureStore) UpsertContext(ctx context.Context, record *MultiKeySortFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	record.Start = record.Start.Truncate(time.Microsecond)
+	record.End = record.End.Truncate(time.Microsecond)
+
+	return s.Store.UpsertContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *ParentNoPtrStore) Delete(record *ParentNoPtr) error {
-	return s.Store.Delete(Schema.ParentNoPtr.BaseSchema, record)
+func (s *MultiKeySortFixtureStore) Delete(record *MultiKeySortFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *MultiKeySortFixtureStore) DeleteContext(ctx context.Context, record *MultiKeySortFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *MultiKeySortFixtureStore) UpdateWhere(q *MultiKeySortFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *MultiKeySortFixtureStore) UpdateWhereContext(ctx context.Context, q *MultiKeySortFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *MultiKeySortFixtureStore) UpdateWhereReturning(q *MultiKeySortFixtureQuery, values map[kallax.SchemaField]interface{}) (*MultiKeySortFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *MultiKeySortFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *MultiKeySortFixtureQuery, values map[kallax.SchemaField]interface{}) (*MultiKeySortFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMultiKeySortFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *MultiKeySortFixtureStore) DeleteWhere(q *MultiKeySortFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *MultiKeySortFixtureStore) DeleteWhereContext(ctx context.Context, q *MultiKeySortFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *MultiKeySortFixtureStore) DeleteWhereReturning(q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *MultiKeySortFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMultiKeySortFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *ParentNoPtrStore) Find(q *ParentNoPtrQuery) (*ParentNoPtrResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *MultiKeySortFixtureStore) Find(q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *MultiKeySortFixtureStore) FindContext(ctx context.Context, q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewParentNoPtrResultSet(rs), nil
+	return NewMultiKeySortFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *ParentNoPtrStore) MustFind(q *ParentNoPtrQuery) *ParentNoPtrResultSet {
-	return NewParentNoPtrResultSet(s.Store.MustFind(q))
+func (s *MultiKeySortFixtureStore) MustFind(q *MultiKeySortFixtureQuery) *MultiKeySortFixtureResultSet {
+	return NewMultiKeySortFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *MultiKeySortFixtureStore) MustFindContext(ctx context.Context, q *MultiKeySortFixtureQuery) *MultiKeySortFixtureResultSet {
+	return NewMultiKeySortFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *ParentNoPtrStore) Count(q *ParentNoPtrQuery) (int64, error) {
+func (s *MultiKeySortFixtureStore) Count(q *MultiKeySortFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *MultiKeySortFixtureStore) CountContext(ctx context.Context, q *MultiKeySortFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *ParentNoPtrStore) MustCount(q *ParentNoPtrQuery) int64 {
+func (s *MultiKeySortFixtureStore) MustCount(q *MultiKeySortFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *MultiKeySortFixtureStore) MustCountContext(ctx context.Context, q *MultiKeySortFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *ParentNoPtrStore) FindOne(q *ParentNoPtrQuery) (*ParentNoPtr, error) {
+func (s *MultiKeySortFixtureStore) FindOne(q *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *MultiKeySortFixtureStore) FindOneContext(ctx context.Context, q *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6669,8 +9955,14 @@ func (s *ParentNoPtrStore) FindOne(q *ParentNoPtrQuery) (*ParentNoPtr, error) {
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *ParentNoPtrStore) FindAll(q *ParentNoPtrQuery) ([]*ParentNoPtr, error) {
-	rs, err := s.Find(q)
+func (s *MultiKeySortFixtureStore) FindAll(q *MultiKeySortFixtureQuery) ([]*MultiKeySortFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *MultiKeySortFixtureStore) FindAllContext(ctx context.Context, q *MultiKeySortFixtureQuery) ([]*MultiKeySortFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6680,141 +9972,67 @@ func (s *ParentNoPtrStore) FindAll(q *ParentNoPtrQuery) ([]*ParentNoPtr, error)
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *ParentNoPtrStore) MustFindOne(q *ParentNoPtrQuery) *ParentNoPtr {
-	record, err := s.FindOne(q)
+func (s *MultiKeySortFixtureStore) MustFindOne(q *MultiKeySortFixtureQuery) *MultiKeySortFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *MultiKeySortFixtureStore) MustFindOneContext(ctx context.Context, q *MultiKeySortFixtureQuery) *MultiKeySortFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the ParentNoPtr with the data in the database and
+// Reload refreshes the MultiKeySortFixture with the data in the database and
 // makes it writable.
-func (s *ParentNoPtrStore) Reload(record *ParentNoPtr) error {
-	return s.Store.Reload(Schema.ParentNoPtr.BaseSchema, record)
+func (s *MultiKeySortFixtureStore) Reload(record *MultiKeySortFixture) error {
+	return s.Store.Reload(Schema.MultiKeySortFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *MultiKeySortFixtureStore) ReloadContext(ctx context.Context, record *MultiKeySortFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *ParentNoPtrStore) Transaction(callback func(*ParentNoPtrStore) error) error {
+func (s *MultiKeySortFixtureStore) Transaction(callback func(*MultiKeySortFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *MultiKeySortFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*MultiKeySortFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&ParentNoPtrStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&MultiKeySortFixtureStore{store})
 	})
 }
 
-// RemoveChildren removes the given items of the Children field of the
-// model. If no items are given, it removes all of them.
-// The items will also be removed from the passed record inside this method.
-// Note that is required that `Children` is not empty. This method clears the
-// the elements of Children in a model, it does not retrieve them to know
-// what relationships the model has.
-func (s *ParentNoPtrStore) RemoveChildren(record *ParentNoPtr, deleted ...Child) error {
-	var updated []Child
-	var clear bool
-	if len(deleted) == 0 {
-		clear = true
-		deleted = record.Children
-		if len(deleted) == 0 {
-			return nil
-		}
-	}
-
-	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
-			for _, d := range deleted {
-				var r kallax.Record = &d
-
-				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-					if err := beforeDeleter.BeforeDelete(); err != nil {
-						return err
-					}
-				}
-
-				if err := s.Delete(Schema.Child.BaseSchema, &d); err != nil {
-					return err
-				}
-
-				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-					if err := afterDeleter.AfterDelete(); err != nil {
-						return err
-					}
-				}
-			}
-			return nil
-		})
-
-		if err != nil {
-			return err
-		}
-
-		if clear {
-			record.Children = nil
-			return nil
-		}
-	} else {
-		var r kallax.Record = &deleted[0]
-		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-			if err := beforeDeleter.BeforeDelete(); err != nil {
-				return err
-			}
-		}
-
-		var err error
-		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.Child.BaseSchema, r)
-				if err != nil {
-					return err
-				}
-
-				return afterDeleter.AfterDelete()
-			})
-		} else {
-			err = s.Store.Delete(Schema.Child.BaseSchema, &deleted[0])
-		}
-
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, r := range record.Children {
-		var found bool
-		for _, d := range deleted {
-			if d.GetID().Equals(r.GetID()) {
-				found = true
-				break
-			}
-		}
-		if !found {
-			updated = append(updated, r)
-		}
-	}
-	record.Children = updated
-	return nil
-}
-
-// ParentNoPtrQuery is the object used to create queries for the ParentNoPtr
+// MultiKeySortFixtureQuery is the object used to create queries for the MultiKeySortFixture
 // entity.
-type ParentNoPtrQuery struct {
+type MultiKeySortFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewParentNoPtrQuery returns a new instance of ParentNoPtrQuery.
-func NewParentNoPtrQuery() *ParentNoPtrQuery {
-	return &ParentNoPtrQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.ParentNoPtr.BaseSchema),
+// NewMultiKeySortFixtureQuery returns a new instance of MultiKeySortFixtureQuery.
+func NewMultiKeySortFixtureQuery() *MultiKeySortFixtureQuery {
+	return &MultiKeySortFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.MultiKeySortFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *ParentNoPtrQuery) Select(columns ...kallax.SchemaField) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) Select(columns ...kallax.SchemaField) *MultiKeySortFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -6823,60 +10041,109 @@ func (q *ParentNoPtrQuery) Select(columns ...kallax.SchemaField) *ParentNoPtrQue
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *ParentNoPtrQuery) SelectNot(columns ...kallax.SchemaField) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) SelectNot(columns ...kallax.SchemaField) *MultiKeySortFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *ParentNoPtrQuery) Copy() *ParentNoPtrQuery {
-	return &ParentNoPtrQuery{
+func (q *MultiKeySortFixtureQuery) Copy() *MultiKeySortFixtureQuery {
+	return &MultiKeySortFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *ParentNoPtrQuery) Order(cols ...kallax.ColumnOrder) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) Order(cols ...kallax.ColumnOrder) *MultiKeySortFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *MultiKeySortFixtureQuery) After(cursor *kallax.Cursor) *MultiKeySortFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *MultiKeySortFixtureQuery) Before(cursor *kallax.Cursor) *MultiKeySortFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *ParentNoPtrQuery) BatchSize(size uint64) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) BatchSize(size uint64) *MultiKeySortFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *ParentNoPtrQuery) Limit(n uint64) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) Limit(n uint64) *MultiKeySortFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *ParentNoPtrQuery) Offset(n uint64) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) Offset(n uint64) *MultiKeySortFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *ParentNoPtrQuery) Where(cond kallax.Condition) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) Where(cond kallax.Condition) *MultiKeySortFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *ParentNoPtrQuery) WithChildren(cond kallax.Condition) *ParentNoPtrQuery {
-	q.AddRelation(Schema.Child.BaseSchema, "Children", kallax.OneToMany, cond)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *MultiKeySortFixtureQuery) GroupBy(cols ...kallax.SchemaField) *MultiKeySortFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *MultiKeySortFixtureQuery) Having(cond kallax.Condition) *MultiKeySortFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *MultiKeySortFixtureQuery) ForUpdate() *MultiKeySortFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *MultiKeySortFixtureQuery) ForShare() *MultiKeySortFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *MultiKeySortFixtureQuery) NoWait() *MultiKeySortFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *MultiKeySortFixtureQuery) SkipLocked() *MultiKeySortFixtureQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *ParentNoPtrQuery) FindByID(v ...int64) *ParentNoPtrQuery {
+func (q *MultiKeySortFixtureQuery) FindByID(v ...kallax.ULID) *MultiKeySortFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -6884,33 +10151,45 @@ func (q *ParentNoPtrQuery) FindByID(v ...int64) *ParentNoPtrQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.ParentNoPtr.ID, values...))
+	return q.Where(kallax.In(Schema.MultiKeySortFixture.ID, values...))
 }
 
 // FindByName adds a new filter to the query that will require that
 // the Name property is equal to the passed value.
-func (q *ParentNoPtrQuery) FindByName(v string) *ParentNoPtrQuery {
-	return q.Where(kallax.Eq(Schema.ParentNoPtr.Name, v))
+func (q *MultiKeySortFixtureQuery) FindByName(v string) *MultiKeySortFixtureQuery {
+	return q.Where(kallax.Eq(Schema.MultiKeySortFixture.Name, v))
 }
 
-// ParentNoPtrResultSet is the set of results returned by a query to the
+// FindByStart adds a new filter to the query that will require that
+// the Start property is equal to the passed value.
+func (q *MultiKeySortFixtureQuery) FindByStart(cond kallax.ScalarCond, v time.Time) *MultiKeySortFixtureQuery {
+	return q.Where(cond(Schema.MultiKeySortFixture.Start, v))
+}
+
+// FindByEnd adds a new filter to the query that will require that
+// the End property is equal to the passed value.
+func (q *MultiKeySortFixtureQuery) FindByEnd(cond kallax.ScalarCond, v time.Time) *MultiKeySortFixtureQuery {
+	return q.Where(cond(Schema.MultiKeySortFixture.End, v))
+}
+
+// MultiKeySortFixtureResultSet is the set of results returned by a query to the
 // database.
-type ParentNoPtrResultSet struct {
+type MultiKeySortFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *ParentNoPtr
+	last      *MultiKeySortFixture
 	lastErr   error
 }
 
-// NewParentNoPtrResultSet creates a new result set for rows of the type
-// ParentNoPtr.
-func NewParentNoPtrResultSet(rs kallax.ResultSet) *ParentNoPtrResultSet {
-	return &ParentNoPtrResultSet{ResultSet: rs}
+// NewMultiKeySortFixtureResultSet creates a new result set for rows of the type
+// MultiKeySortFixture.
+func NewMultiKeySortFixtureResultSet(rs kallax.ResultSet) *MultiKeySortFixtureResultSet {
+	return &MultiKeySortFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *ParentNoPtrResultSet) Next() bool {
+func (rs *MultiKeySortFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -6918,14 +10197,14 @@ func (rs *ParentNoPtrResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.ParentNoPtr.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.MultiKeySortFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*ParentNoPtr)
+		rs.last, ok = record.(*MultiKeySortFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *ParentNoPtr")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *MultiKeySortFixture")
 			rs.last = nil
 		}
 	}
@@ -6934,7 +10213,7 @@ func (rs *ParentNoPtrResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *ParentNoPtrResultSet) Get() (*ParentNoPtr, error) {
+func (rs *MultiKeySortFixtureResultSet) Get() (*MultiKeySortFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -6942,7 +10221,7 @@ func (rs *ParentNoPtrResultSet) Get() (*ParentNoPtr, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *ParentNoPtrResultSet) ForEach(fn func(*ParentNoPtr) error) error {
+func (rs *MultiKeySortFixtureResultSet) ForEach(fn func(*MultiKeySortFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -6961,8 +10240,8 @@ func (rs *ParentNoPtrResultSet) ForEach(fn func(*ParentNoPtr) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *ParentNoPtrResultSet) All() ([]*ParentNoPtr, error) {
-	var result []*ParentNoPtr
+func (rs *MultiKeySortFixtureResultSet) All() ([]*MultiKeySortFixture, error) {
+	var result []*MultiKeySortFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -6974,7 +10253,7 @@ func (rs *ParentNoPtrResultSet) All() ([]*ParentNoPtr, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *ParentNoPtrResultSet) One() (*ParentNoPtr, error) {
+func (rs *MultiKeySortFixtureResultSet) One() (*MultiKeySortFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -6991,206 +10270,182 @@ func (rs *ParentNoPtrResultSet) One() (*ParentNoPtr, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *MultiKeySortFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *ParentNoPtrResultSet) Err() error {
+func (rs *MultiKeySortFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *ParentNoPtrResultSet) Close() error {
+func (rs *MultiKeySortFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewPerson returns a new instance of Person.
-func NewPerson(name string) (record *Person) {
-	return newPerson(name)
+// NewNullable returns a new instance of Nullable.
+func NewNullable() (record *Nullable) {
+	return new(Nullable)
 }
 
 // GetID returns the primary key of the model.
-func (r *Person) GetID() kallax.Identifier {
+func (r *Nullable) GetID() kallax.Identifier {
 	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *Person) ColumnAddress(col string) (interface{}, error) {
+func (r *Nullable) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.NumericID)(&r.ID), nil
-	case "name":
-		return &r.Name, nil
+	case "t":
+		return types.Nullable(&r.T), nil
+	case "some_json":
+		if r.SomeJSON == nil {
+			r.SomeJSON = new(SomeJSON)
+		}
+		return types.JSON(r.SomeJSON), nil
+	case "scanner":
+		if r.Scanner == nil {
+			r.Scanner = new(kallax.ULID)
+		}
+		return types.Nullable(r.Scanner), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Person: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in Nullable: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *Person) Value(col string) (interface{}, error) {
+func (r *Nullable) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "name":
-		return r.Name, nil
+	case "t":
+		if r.T == (*time.Time)(nil) {
+			return nil, nil
+		}
+		return r.T, nil
+	case "some_json":
+		if r.SomeJSON == (*SomeJSON)(nil) {
+			return nil, nil
+		}
+		return types.JSON(r.SomeJSON), nil
+	case "scanner":
+		if r.Scanner == (*kallax.ULID)(nil) {
+			return nil, nil
+		}
+		return r.Scanner, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Person: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in Nullable: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *Person) NewRelationshipRecord(field string) (kallax.Record, error) {
-	switch field {
-	case "Pets":
-		return new(Pet), nil
-	case "Car":
-		return new(Car), nil
-
-	}
-	return nil, fmt.Errorf("kallax: model Person has no relationship %s", field)
+func (r *Nullable) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model Nullable has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *Person) SetRelationship(field string, rel interface{}) error {
-	switch field {
-	case "Pets":
-		records, ok := rel.([]kallax.Record)
-		if !ok {
-			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
-		}
-
-		r.Pets = make([]*Pet, len(records))
-		for i, record := range records {
-			rel, ok := record.(*Pet)
-			if !ok {
-				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
-			}
-			r.Pets[i] = rel
-		}
-		return nil
-	case "Car":
-		val, ok := rel.(*Car)
-		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Car", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Car = val
-		}
-
-		return nil
-
-	}
-	return fmt.Errorf("kallax: model Person has no relationship %s", field)
+func (r *Nullable) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model Nullable has no relationships")
 }
 
-// PersonStore is the entity to access the records of the type Person
+// NullableStore is the entity to access the records of the type Nullable
 // in the database.
-type PersonStore struct {
+type NullableStore struct {
 	*kallax.Store
 }
 
-// NewPersonStore creates a new instance of PersonStore
+// NewNullableStore creates a new instance of NullableStore
 // using a SQL database.
-func NewPersonStore(db *sql.DB) *PersonStore {
-	return &PersonStore{kallax.NewStore(db)}
+func NewNullableStore(db *sql.DB) *NullableStore {
+	return &NullableStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *PersonStore) GenericStore() *kallax.Store {
+func (s *NullableStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *PersonStore) SetGenericStore(store *kallax.Store) {
+func (s *NullableStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *PersonStore) Debug() *PersonStore {
-	return &PersonStore{s.Store.Debug()}
+func (s *NullableStore) Debug() *NullableStore {
+	return &NullableStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *PersonStore) DebugWith(logger kallax.LoggerFunc) *PersonStore {
-	return &PersonStore{s.Store.DebugWith(logger)}
+func (s *NullableStore) DebugWith(logger kallax.LoggerFunc) *NullableStore {
+	return &NullableStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *PersonStore) DisableCacher() *PersonStore {
-	return &PersonStore{s.Store.DisableCacher()}
+func (s *NullableStore) DisableCacher() *NullableStore {
+	return &NullableStore{s.Store.DisableCacher()}
 }
 
-func (s *PersonStore) relationshipRecords(record *Person) []modelSaveFunc {
-	var result []modelSaveFunc
-
-	for i := range record.Pets {
-		r := record.Pets[i]
-		if !r.IsSaving() {
-			r.AddVirtualColumn("owner_id", record.GetID())
-			result = append(result, func(store *kallax.Store) error {
-				_, err := (&PetStore{store}).Save(r)
-				return err
-			})
-		}
-	}
-
-	if record.Car != nil && !record.Car.IsSaving() {
-		r := record.Car
-		r.AddVirtualColumn("owner_id", record.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&CarStore{store}).Save(r)
-			return err
-		})
-	}
-
-	return result
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *NullableStore) WithDialect(dialect kallax.Dialect) *NullableStore {
+	return &NullableStore{s.Store.WithDialect(dialect)}
 }
 
-// Insert inserts a Person in the database. A non-persisted object is
+// Insert inserts a Nullable in the database. A non-persisted object is
 // required for this operation.
-func (s *PersonStore) Insert(record *Person) error {
+func (s *NullableStore) Insert(record *Nullable) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *NullableStore) InsertContext(ctx context.Context, record *Nullable) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeSave(); err != nil {
-		return err
+	if record.T != nil {
+		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
 	}
 
-	records := s.relationshipRecords(record)
-
-	if len(records) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			if err := s.Insert(Schema.Person.BaseSchema, record); err != nil {
-				return err
-			}
+	return s.Store.InsertContext(ctx, Schema.Nullable.BaseSchema, record)
+}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// InsertMany inserts all the given Nullable in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *NullableStore) InsertMany(records []*Nullable) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-			if err := record.AfterSave(); err != nil {
-				return err
-			}
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *NullableStore) InsertManyContext(ctx context.Context, records []*Nullable) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+		if record.T != nil {
+			record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
+		}
 
-			return nil
-		})
+		recs[i] = record
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.Person.BaseSchema, record); err != nil {
-			return err
-		}
-
-		if err := record.AfterSave(); err != nil {
-			return err
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
 		}
+	}()
 
-		return nil
-	})
+	return s.Store.InsertManyContext(ctx, Schema.Nullable.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -7199,126 +10454,201 @@ func (s *PersonStore) Insert(record *Person) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *NullableStore) Update(record *Nullable, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *NullableStore) UpdateContext(ctx context.Context, record *Nullable, cols ...kallax.SchemaField) (updated int64, err error) {
+	if record.T != nil {
+		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
+	}
+
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeSave(); err != nil {
-		return 0, err
-	}
+	return s.Store.UpdateContext(ctx, Schema.Nullable.BaseSchema, record, cols...)
+}
 
-	records := s.relationshipRecords(record)
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *NullableStore) Save(record *Nullable) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
 
-	if len(records) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			updated, err = s.Update(Schema.Person.BaseSchema, record, cols...)
-			if err != nil {
-				return err
-			}
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *NullableStore) SaveContext(ctx context.Context, record *Nullable) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
 
-			if err := record.AfterSave(); err != nil {
-				return err
-			}
+	return rowsUpdated > 0, nil
+}
 
-			return nil
-		})
-		if err != nil {
-			return 0, err
-		}
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *NullableStore) Upsert(record *Nullable, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
 
-		return updated, nil
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *NullableStore) UpsertContext(ctx context.Context, record *Nullable, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if record.T != nil {
+		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.Person.BaseSchema, record, cols...)
-		if err != nil {
-			return err
-		}
+	return s.Store.UpsertContext(ctx, Schema.Nullable.BaseSchema, record, conflictCols, updateCols...)
+}
 
-		if err := record.AfterSave(); err != nil {
-			return err
-		}
+// Delete removes the given record from the database.
+func (s *NullableStore) Delete(record *Nullable) error {
+	return s.DeleteContext(context.Background(), record)
+}
 
-		return nil
-	})
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *NullableStore) DeleteContext(ctx context.Context, record *Nullable) error {
+	return s.Store.DeleteContext(ctx, Schema.Nullable.BaseSchema, record)
+}
 
-	if err != nil {
-		return 0, err
-	}
-	return updated, nil
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *NullableStore) UpdateWhere(q *NullableQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
 }
 
-// Save inserts the object if the record is not persisted, otherwise it updates
-// it. Same rules of Update and Insert apply depending on the case.
-func (s *PersonStore) Save(record *Person) (updated bool, err error) {
-	if !record.IsPersisted() {
-		return false, s.Insert(record)
-	}
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *NullableStore) UpdateWhereContext(ctx context.Context, q *NullableQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *NullableStore) UpdateWhereReturning(q *NullableQuery, values map[kallax.SchemaField]interface{}) (*NullableResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
 
-	rowsUpdated, err := s.Update(record)
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *NullableStore) UpdateWhereReturningContext(ctx context.Context, q *NullableQuery, values map[kallax.SchemaField]interface{}) (*NullableResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return rowsUpdated > 0, nil
+	return NewNullableResultSet(rs), nil
 }
 
-// Delete removes the given record from the database.
-func (s *PersonStore) Delete(record *Person) error {
-	if err := record.BeforeDelete(); err != nil {
-		return err
-	}
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *NullableStore) DeleteWhere(q *NullableQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		err := s.Delete(Schema.Person.BaseSchema, record)
-		if err != nil {
-			return err
-		}
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *NullableStore) DeleteWhereContext(ctx context.Context, q *NullableQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
 
-		return record.AfterDelete()
-	})
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *NullableStore) DeleteWhereReturning(q *NullableQuery) (*NullableResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *NullableStore) DeleteWhereReturningContext(ctx context.Context, q *NullableQuery) (*NullableResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNullableResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *PersonStore) Find(q *PersonQuery) (*PersonResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *NullableStore) Find(q *NullableQuery) (*NullableResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *NullableStore) FindContext(ctx context.Context, q *NullableQuery) (*NullableResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewPersonResultSet(rs), nil
+	return NewNullableResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *PersonStore) MustFind(q *PersonQuery) *PersonResultSet {
-	return NewPersonResultSet(s.Store.MustFind(q))
+func (s *NullableStore) MustFind(q *NullableQuery) *NullableResultSet {
+	return NewNullableResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *NullableStore) MustFindContext(ctx context.Context, q *NullableQuery) *NullableResultSet {
+	return NewNullableResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *PersonStore) Count(q *PersonQuery) (int64, error) {
+func (s *NullableStore) Count(q *NullableQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *NullableStore) CountContext(ctx context.Context, q *NullableQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *PersonStore) MustCount(q *PersonQuery) int64 {
+func (s *NullableStore) MustCount(q *NullableQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *NullableStore) MustCountContext(ctx context.Context, q *NullableQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
+func (s *NullableStore) FindOne(q *NullableQuery) (*Nullable, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *NullableStore) FindOneContext(ctx context.Context, q *NullableQuery) (*Nullable, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7340,8 +10670,14 @@ func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *PersonStore) FindAll(q *PersonQuery) ([]*Person, error) {
-	rs, err := s.Find(q)
+func (s *NullableStore) FindAll(q *NullableQuery) ([]*Nullable, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *NullableStore) FindAllContext(ctx context.Context, q *NullableQuery) ([]*Nullable, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7351,172 +10687,67 @@ func (s *PersonStore) FindAll(q *PersonQuery) ([]*Person, error) {
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *PersonStore) MustFindOne(q *PersonQuery) *Person {
-	record, err := s.FindOne(q)
+func (s *NullableStore) MustFindOne(q *NullableQuery) *Nullable {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *NullableStore) MustFindOneContext(ctx context.Context, q *NullableQuery) *Nullable {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the Person with the data in the database and
+// Reload refreshes the Nullable with the data in the database and
 // makes it writable.
-func (s *PersonStore) Reload(record *Person) error {
-	return s.Store.Reload(Schema.Person.BaseSchema, record)
+func (s *NullableStore) Reload(record *Nullable) error {
+	return s.Store.Reload(Schema.Nullable.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *NullableStore) ReloadContext(ctx context.Context, record *Nullable) error {
+	return s.Store.ReloadContext(ctx, Schema.Nullable.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *PersonStore) Transaction(callback func(*PersonStore) error) error {
+func (s *NullableStore) Transaction(callback func(*NullableStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *NullableStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*NullableStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&PersonStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&NullableStore{store})
 	})
 }
 
-// RemovePets removes the given items of the Pets field of the
-// model. If no items are given, it removes all of them.
-// The items will also be removed from the passed record inside this method.
-// Note that is required that `Pets` is not empty. This method clears the
-// the elements of Pets in a model, it does not retrieve them to know
-// what relationships the model has.
-func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
-	var updated []*Pet
-	var clear bool
-	if len(deleted) == 0 {
-		clear = true
-		deleted = record.Pets
-		if len(deleted) == 0 {
-			return nil
-		}
-	}
-
-	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
-			for _, d := range deleted {
-				var r kallax.Record = d
-
-				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-					if err := beforeDeleter.BeforeDelete(); err != nil {
-						return err
-					}
-				}
-
-				if err := s.Delete(Schema.Pet.BaseSchema, d); err != nil {
-					return err
-				}
-
-				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-					if err := afterDeleter.AfterDelete(); err != nil {
-						return err
-					}
-				}
-			}
-			return nil
-		})
-
-		if err != nil {
-			return err
-		}
-
-		if clear {
-			record.Pets = nil
-			return nil
-		}
-	} else {
-		var r kallax.Record = deleted[0]
-		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-			if err := beforeDeleter.BeforeDelete(); err != nil {
-				return err
-			}
-		}
-
-		var err error
-		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.Pet.BaseSchema, r)
-				if err != nil {
-					return err
-				}
-
-				return afterDeleter.AfterDelete()
-			})
-		} else {
-			err = s.Store.Delete(Schema.Pet.BaseSchema, deleted[0])
-		}
-
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, r := range record.Pets {
-		var found bool
-		for _, d := range deleted {
-			if d.GetID().Equals(r.GetID()) {
-				found = true
-				break
-			}
-		}
-		if !found {
-			updated = append(updated, r)
-		}
-	}
-	record.Pets = updated
-	return nil
-}
-
-// RemoveCar removes from the database the given relationship of the
-// model. It also resets the field Car of the model.
-func (s *PersonStore) RemoveCar(record *Person) error {
-	var r kallax.Record = record.Car
-	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-		if err := beforeDeleter.BeforeDelete(); err != nil {
-			return err
-		}
-	}
-
-	var err error
-	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.Car.BaseSchema, r)
-			if err != nil {
-				return err
-			}
-
-			return afterDeleter.AfterDelete()
-		})
-	} else {
-		err = s.Store.Delete(Schema.Car.BaseSchema, r)
-	}
-	if err != nil {
-		return err
-	}
-
-	record.Car = nil
-	return nil
-}
-
-// PersonQuery is the object used to create queries for the Person
+// NullableQuery is the object used to create queries for the Nullable
 // entity.
-type PersonQuery struct {
+type NullableQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewPersonQuery returns a new instance of PersonQuery.
-func NewPersonQuery() *PersonQuery {
-	return &PersonQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.Person.BaseSchema),
+// NewNullableQuery returns a new instance of NullableQuery.
+func NewNullableQuery() *NullableQuery {
+	return &NullableQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.Nullable.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *PersonQuery) Select(columns ...kallax.SchemaField) *PersonQuery {
+func (q *NullableQuery) Select(columns ...kallax.SchemaField) *NullableQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -7525,65 +10756,109 @@ func (q *PersonQuery) Select(columns ...kallax.SchemaField) *PersonQuery {
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *PersonQuery) SelectNot(columns ...kallax.SchemaField) *PersonQuery {
+func (q *NullableQuery) SelectNot(columns ...kallax.SchemaField) *NullableQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *PersonQuery) Copy() *PersonQuery {
-	return &PersonQuery{
+func (q *NullableQuery) Copy() *NullableQuery {
+	return &NullableQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *PersonQuery) Order(cols ...kallax.ColumnOrder) *PersonQuery {
+func (q *NullableQuery) Order(cols ...kallax.ColumnOrder) *NullableQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *NullableQuery) After(cursor *kallax.Cursor) *NullableQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *NullableQuery) Before(cursor *kallax.Cursor) *NullableQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *PersonQuery) BatchSize(size uint64) *PersonQuery {
+func (q *NullableQuery) BatchSize(size uint64) *NullableQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *PersonQuery) Limit(n uint64) *PersonQuery {
+func (q *NullableQuery) Limit(n uint64) *NullableQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *PersonQuery) Offset(n uint64) *PersonQuery {
+func (q *NullableQuery) Offset(n uint64) *NullableQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *PersonQuery) Where(cond kallax.Condition) *PersonQuery {
+func (q *NullableQuery) Where(cond kallax.Condition) *NullableQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *PersonQuery) WithPets(cond kallax.Condition) *PersonQuery {
-	q.AddRelation(Schema.Pet.BaseSchema, "Pets", kallax.OneToMany, cond)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *NullableQuery) GroupBy(cols ...kallax.SchemaField) *NullableQuery {
+	q.BaseQuery.GroupBy(cols...)
 	return q
 }
 
-func (q *PersonQuery) WithCar() *PersonQuery {
-	q.AddRelation(Schema.Car.BaseSchema, "Car", kallax.OneToOne, nil)
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *NullableQuery) Having(cond kallax.Condition) *NullableQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *NullableQuery) ForUpdate() *NullableQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *NullableQuery) ForShare() *NullableQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *NullableQuery) NoWait() *NullableQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *NullableQuery) SkipLocked() *NullableQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *PersonQuery) FindByID(v ...int64) *PersonQuery {
+func (q *NullableQuery) FindByID(v ...int64) *NullableQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -7591,33 +10866,39 @@ func (q *PersonQuery) FindByID(v ...int64) *PersonQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.Person.ID, values...))
+	return q.Where(kallax.In(Schema.Nullable.ID, values...))
 }
 
-// FindByName adds a new filter to the query that will require that
-// the Name property is equal to the passed value.
-func (q *PersonQuery) FindByName(v string) *PersonQuery {
-	return q.Where(kallax.Eq(Schema.Person.Name, v))
+// FindByT adds a new filter to the query that will require that
+// the T property is equal to the passed value.
+func (q *NullableQuery) FindByT(cond kallax.ScalarCond, v time.Time) *NullableQuery {
+	return q.Where(cond(Schema.Nullable.T, v))
 }
 
-// PersonResultSet is the set of results returned by a query to the
+// FindByScanner adds a new filter to the query that will require that
+// the Scanner property is equal to the passed value.
+func (q *NullableQuery) FindByScanner(v kallax.ULID) *NullableQuery {
+	return q.Where(kallax.Eq(Schema.Nullable.Scanner, v))
+}
+
+// NullableResultSet is the set of results returned by a query to the
 // database.
-type PersonResultSet struct {
+type NullableResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *Person
+	last      *Nullable
 	lastErr   error
 }
 
-// NewPersonResultSet creates a new result set for rows of the type
-// Person.
-func NewPersonResultSet(rs kallax.ResultSet) *PersonResultSet {
-	return &PersonResultSet{ResultSet: rs}
+// NewNullableResultSet creates a new result set for rows of the type
+// Nullable.
+func NewNullableResultSet(rs kallax.ResultSet) *NullableResultSet {
+	return &NullableResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *PersonResultSet) Next() bool {
+func (rs *NullableResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -7625,14 +10906,14 @@ func (rs *PersonResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.Person.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.Nullable.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*Person)
+		rs.last, ok = record.(*Nullable)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Person")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Nullable")
 			rs.last = nil
 		}
 	}
@@ -7641,7 +10922,7 @@ func (rs *PersonResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *PersonResultSet) Get() (*Person, error) {
+func (rs *NullableResultSet) Get() (*Nullable, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -7649,7 +10930,7 @@ func (rs *PersonResultSet) Get() (*Person, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *PersonResultSet) ForEach(fn func(*Person) error) error {
+func (rs *NullableResultSet) ForEach(fn func(*Nullable) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -7668,8 +10949,8 @@ func (rs *PersonResultSet) ForEach(fn func(*Person) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *PersonResultSet) All() ([]*Person, error) {
-	var result []*Person
+func (rs *NullableResultSet) All() ([]*Nullable, error) {
+	var result []*Nullable
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -7681,7 +10962,7 @@ func (rs *PersonResultSet) All() ([]*Person, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *PersonResultSet) One() (*Person, error) {
+func (rs *NullableResultSet) One() (*Nullable, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -7698,189 +10979,231 @@ func (rs *PersonResultSet) One() (*Person, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *NullableResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *PersonResultSet) Err() error {
+func (rs *NullableResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *PersonResultSet) Close() error {
+func (rs *NullableResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewPet returns a new instance of Pet.
-func NewPet(name string, kind string, owner *Person) (record *Pet) {
-	return newPet(name, kind, owner)
+// NewParent returns a new instance of Parent.
+func NewParent() (record *Parent) {
+	return new(Parent)
 }
 
 // GetID returns the primary key of the model.
-func (r *Pet) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *Parent) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *Pet) ColumnAddress(col string) (interface{}, error) {
+func (r *Parent) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
+		return (*kallax.NumericID)(&r.ID), nil
 	case "name":
 		return &r.Name, nil
-	case "kind":
-		return &r.Kind, nil
-	case "owner_id":
-		return types.Nullable(kallax.VirtualColumn("owner_id", r, new(kallax.NumericID))), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Pet: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in Parent: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *Pet) Value(col string) (interface{}, error) {
+func (r *Parent) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
 	case "name":
 		return r.Name, nil
-	case "kind":
-		return r.Kind, nil
-	case "owner_id":
-		v := r.Model.VirtualColumn(col)
-		if v == nil {
-			return nil, kallax.ErrEmptyVirtualColumn
-		}
-		return v, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in Pet: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in Parent: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *Pet) NewRelationshipRecord(field string) (kallax.Record, error) {
+func (r *Parent) NewRelationshipRecord(field string) (kallax.Record, error) {
 	switch field {
-	case "Owner":
-		return new(Person), nil
+	case "Children":
+		return new(Child), nil
 
 	}
-	return nil, fmt.Errorf("kallax: model Pet has no relationship %s", field)
+	return nil, fmt.Errorf("kallax: model Parent has no relationship %s", field)
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *Pet) SetRelationship(field string, rel interface{}) error {
+func (r *Parent) SetRelationship(field string, rel interface{}) error {
 	switch field {
-	case "Owner":
-		val, ok := rel.(*Person)
+	case "Children":
+		records, ok := rel.([]kallax.Record)
 		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Owner", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Owner = val
+			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
 		}
 
+		r.Children = make([]*Child, len(records))
+		for i, record := range records {
+			rel, ok := record.(*Child)
+			if !ok {
+				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
+			}
+			r.Children[i] = rel
+		}
 		return nil
 
 	}
-	return fmt.Errorf("kallax: model Pet has no relationship %s", field)
+	return fmt.Errorf("kallax: model Parent has no relationship %s", field)
 }
 
-// PetStore is the entity to access the records of the type Pet
+// ParentStore is the entity to access the records of the type Parent
 // in the database.
-type PetStore struct {
+type ParentStore struct {
 	*kallax.Store
 }
 
-// NewPetStore creates a new instance of PetStore
+// NewParentStore creates a new instance of ParentStore
 // using a SQL database.
-func NewPetStore(db *sql.DB) *PetStore {
-	return &PetStore{kallax.NewStore(db)}
+func NewParentStore(db *sql.DB) *ParentStore {
+	return &ParentStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *PetStore) GenericStore() *kallax.Store {
+func (s *ParentStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *PetStore) SetGenericStore(store *kallax.Store) {
+func (s *ParentStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *PetStore) Debug() *PetStore {
-	return &PetStore{s.Store.Debug()}
+func (s *ParentStore) Debug() *ParentStore {
+	return &ParentStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *PetStore) DebugWith(logger kallax.LoggerFunc) *PetStore {
-	return &PetStore{s.Store.DebugWith(logger)}
+func (s *ParentStore) DebugWith(logger kallax.LoggerFunc) *ParentStore {
+	return &ParentStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *PetStore) DisableCacher() *PetStore {
-	return &PetStore{s.Store.DisableCacher()}
+func (s *ParentStore) DisableCacher() *ParentStore {
+	return &ParentStore{s.Store.DisableCacher()}
 }
 
-func (s *PetStore) inverseRecords(record *Pet) []modelSaveFunc {
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *ParentStore) WithDialect(dialect kallax.Dialect) *ParentStore {
+	return &ParentStore{s.Store.WithDialect(dialect)}
+}
+
+func (s *ParentStore) relationshipRecords(record *Parent) []modelSaveFunc {
 	var result []modelSaveFunc
 
-	if record.Owner != nil && !record.Owner.IsSaving() {
-		record.AddVirtualColumn("owner_id", record.Owner.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&PersonStore{store}).Save(record.Owner)
-			return err
-		})
+	for i := range record.Children {
+		r := record.Children[i]
+		if !r.IsSaving() {
+			r.AddVirtualColumn("parent_id", record.GetID())
+			result = append(result, func(ctx context.Context, store *kallax.Store) error {
+				_, err := (&ChildStore{store}).SaveContext(ctx, r)
+				return err
+			})
+		}
 	}
 
 	return result
 }
 
-// Insert inserts a Pet in the database. A non-persisted object is
+// Insert inserts a Parent in the database. A non-persisted object is
 // required for this operation.
-func (s *PetStore) Insert(record *Pet) error {
+func (s *ParentStore) Insert(record *Parent) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *ParentStore) InsertContext(ctx context.Context, record *Parent) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeSave(); err != nil {
-		return err
-	}
+	records := s.relationshipRecords(record)
 
-	inverseRecords := s.inverseRecords(record)
+	if len(records) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertContext(ctx, Schema.Parent.BaseSchema, record); err != nil {
+				return err
+			}
 
-	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			if err := s.Insert(Schema.Pet.BaseSchema, record); err != nil {
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.Parent.BaseSchema, record)
+}
+
+// InsertMany inserts all the given Parent in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *ParentStore) InsertMany(records []*Parent) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *ParentStore) InsertManyContext(ctx context.Context, records []*Parent) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertManyContext(ctx, Schema.Parent.BaseSchema, recs...); err != nil {
 				return err
 			}
 
-			if err := record.AfterSave(); err != nil {
-				return err
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
 			}
 
 			return nil
 		})
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.Pet.BaseSchema, record); err != nil {
-			return err
-		}
-
-		if err := record.AfterSave(); err != nil {
-			return err
-		}
-
-		return nil
-	})
+	return s.Store.InsertManyContext(ctx, Schema.Parent.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -7889,31 +11212,29 @@ func (s *PetStore) Insert(record *Pet) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *ParentStore) Update(record *Parent, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *ParentStore) UpdateContext(ctx context.Context, record *Parent, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	if err := record.BeforeSave(); err != nil {
-		return 0, err
-	}
-
-	inverseRecords := s.inverseRecords(record)
-
-	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+	records := s.relationshipRecords(record)
 
-			updated, err = s.Update(Schema.Pet.BaseSchema, record, cols...)
+	if len(records) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			updated, err = s.UpdateContext(ctx, Schema.Parent.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
 
-			if err := record.AfterSave(); err != nil {
-				return err
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
 			}
 
 			return nil
@@ -7925,33 +11246,23 @@ func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int6
 		return updated, nil
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.Pet.BaseSchema, record, cols...)
-		if err != nil {
-			return err
-		}
-
-		if err := record.AfterSave(); err != nil {
-			return err
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		return 0, err
-	}
-	return updated, nil
+	return s.Store.UpdateContext(ctx, Schema.Parent.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *PetStore) Save(record *Pet) (updated bool, err error) {
+func (s *ParentStore) Save(record *Parent) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *ParentStore) SaveContext(ctx context.Context, record *Parent) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -7959,56 +11270,159 @@ func (s *PetStore) Save(record *Pet) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *ParentStore) Upsert(record *Parent, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *ParentStore) UpsertContext(ctx context.Context, record *Parent, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.Parent.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *PetStore) Delete(record *Pet) error {
-	if err := record.BeforeDelete(); err != nil {
-		return err
+func (s *ParentStore) Delete(record *Parent) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *ParentStore) DeleteContext(ctx context.Context, record *Parent) error {
+	return s.Store.DeleteContext(ctx, Schema.Parent.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *ParentStore) UpdateWhere(q *ParentQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *ParentStore) UpdateWhereContext(ctx context.Context, q *ParentQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *ParentStore) UpdateWhereReturning(q *ParentQuery, values map[kallax.SchemaField]interface{}) (*ParentResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *ParentStore) UpdateWhereReturningContext(ctx context.Context, q *ParentQuery, values map[kallax.SchemaField]interface{}) (*ParentResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		err := s.Delete(Schema.Pet.BaseSchema, record)
-		if err != nil {
-			return err
-		}
+	return NewParentResultSet(rs), nil
+}
 
-		return record.AfterDelete()
-	})
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *ParentStore) DeleteWhere(q *ParentQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *ParentStore) DeleteWhereContext(ctx context.Context, q *ParentQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *ParentStore) DeleteWhereReturning(q *ParentQuery) (*ParentResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *ParentStore) DeleteWhereReturningContext(ctx context.Context, q *ParentQuery) (*ParentResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewParentResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *PetStore) Find(q *PetQuery) (*PetResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *ParentStore) Find(q *ParentQuery) (*ParentResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *ParentStore) FindContext(ctx context.Context, q *ParentQuery) (*ParentResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewPetResultSet(rs), nil
+	return NewParentResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *PetStore) MustFind(q *PetQuery) *PetResultSet {
-	return NewPetResultSet(s.Store.MustFind(q))
+func (s *ParentStore) MustFind(q *ParentQuery) *ParentResultSet {
+	return NewParentResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *ParentStore) MustFindContext(ctx context.Context, q *ParentQuery) *ParentResultSet {
+	return NewParentResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *PetStore) Count(q *PetQuery) (int64, error) {
+func (s *ParentStore) Count(q *ParentQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *ParentStore) CountContext(ctx context.Context, q *ParentQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *PetStore) MustCount(q *PetQuery) int64 {
+func (s *ParentStore) MustCount(q *ParentQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *ParentStore) MustCountContext(ctx context.Context, q *ParentQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
+func (s *ParentStore) FindOne(q *ParentQuery) (*Parent, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *ParentStore) FindOneContext(ctx context.Context, q *ParentQuery) (*Parent, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8030,8 +11444,14 @@ func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *PetStore) FindAll(q *PetQuery) ([]*Pet, error) {
-	rs, err := s.Find(q)
+func (s *ParentStore) FindAll(q *ParentQuery) ([]*Parent, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *ParentStore) FindAllContext(ctx context.Context, q *ParentQuery) ([]*Parent, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8041,49 +11461,165 @@ func (s *PetStore) FindAll(q *PetQuery) ([]*Pet, error) {
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *PetStore) MustFindOne(q *PetQuery) *Pet {
-	record, err := s.FindOne(q)
+func (s *ParentStore) MustFindOne(q *ParentQuery) *Parent {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *ParentStore) MustFindOneContext(ctx context.Context, q *ParentQuery) *Parent {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the Pet with the data in the database and
+// Reload refreshes the Parent with the data in the database and
 // makes it writable.
-func (s *PetStore) Reload(record *Pet) error {
-	return s.Store.Reload(Schema.Pet.BaseSchema, record)
+func (s *ParentStore) Reload(record *Parent) error {
+	return s.Store.Reload(Schema.Parent.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *ParentStore) ReloadContext(ctx context.Context, record *Parent) error {
+	return s.Store.ReloadContext(ctx, Schema.Parent.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *PetStore) Transaction(callback func(*PetStore) error) error {
+func (s *ParentStore) Transaction(callback func(*ParentStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *ParentStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*ParentStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&PetStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&ParentStore{store})
 	})
 }
 
-// PetQuery is the object used to create queries for the Pet
-// entity.
-type PetQuery struct {
-	*kallax.BaseQuery
+// RemoveChildren removes the given items of the Children field of the
+// model. If no items are given, it removes all of them.
+// The items will also be removed from the passed record inside this method.
+// Note that is required that `Children` is not empty. This method clears the
+// the elements of Children in a model, it does not retrieve them to know
+// what relationships the model has.
+func (s *ParentStore) RemoveChildren(record *Parent, deleted ...*Child) error {
+	return s.RemoveChildrenContext(context.Background(), record, deleted...)
 }
 
-// NewPetQuery returns a new instance of PetQuery.
-func NewPetQuery() *PetQuery {
-	return &PetQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.Pet.BaseSchema),
-	}
-}
+// RemoveChildrenContext is the same as RemoveChildren, but the given
+// context is used to run the queries.
+func (s *ParentStore) RemoveChildrenContext(ctx context.Context, record *Parent, deleted ...*Child) error {
+	var updated []*Child
+	var clear bool
+	if len(deleted) == 0 {
+		clear = true
+		deleted = record.Children
+		if len(deleted) == 0 {
+			return nil
+		}
+	}
+
+	if len(deleted) > 1 {
+		err := s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, d := range deleted {
+				var r kallax.Record = d
+
+				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+					if err := beforeDeleter.BeforeDelete(); err != nil {
+						return err
+					}
+				}
+
+				if err := s.DeleteContext(ctx, Schema.Child.BaseSchema, d); err != nil {
+					return err
+				}
+
+				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+					if err := afterDeleter.AfterDelete(); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if clear {
+			record.Children = nil
+			return nil
+		}
+	} else {
+		var r kallax.Record = deleted[0]
+		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+			if err := beforeDeleter.BeforeDelete(); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+			err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.Child.BaseSchema, r)
+				if err != nil {
+					return err
+				}
+
+				return afterDeleter.AfterDelete()
+			})
+		} else {
+			err = s.Store.DeleteContext(ctx, Schema.Child.BaseSchema, deleted[0])
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, r := range record.Children {
+		var found bool
+		for _, d := range deleted {
+			if d.GetID().Equals(r.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, r)
+		}
+	}
+	record.Children = updated
+	return nil
+}
+
+// ParentQuery is the object used to create queries for the Parent
+// entity.
+type ParentQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewParentQuery returns a new instance of ParentQuery.
+func NewParentQuery() *ParentQuery {
+	return &ParentQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.Parent.BaseSchema),
+	}
+}
 
 // Select adds columns to select in the query.
-func (q *PetQuery) Select(columns ...kallax.SchemaField) *PetQuery {
+func (q *ParentQuery) Select(columns ...kallax.SchemaField) *ParentQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -8092,60 +11628,121 @@ func (q *PetQuery) Select(columns ...kallax.SchemaField) *PetQuery {
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *PetQuery) SelectNot(columns ...kallax.SchemaField) *PetQuery {
+func (q *ParentQuery) SelectNot(columns ...kallax.SchemaField) *ParentQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *PetQuery) Copy() *PetQuery {
-	return &PetQuery{
+func (q *ParentQuery) Copy() *ParentQuery {
+	return &ParentQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *PetQuery) Order(cols ...kallax.ColumnOrder) *PetQuery {
+func (q *ParentQuery) Order(cols ...kallax.ColumnOrder) *ParentQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *ParentQuery) After(cursor *kallax.Cursor) *ParentQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *ParentQuery) Before(cursor *kallax.Cursor) *ParentQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *PetQuery) BatchSize(size uint64) *PetQuery {
+func (q *ParentQuery) BatchSize(size uint64) *ParentQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *PetQuery) Limit(n uint64) *PetQuery {
+func (q *ParentQuery) Limit(n uint64) *ParentQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *PetQuery) Offset(n uint64) *PetQuery {
+func (q *ParentQuery) Offset(n uint64) *ParentQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *PetQuery) Where(cond kallax.Condition) *PetQuery {
+func (q *ParentQuery) Where(cond kallax.Condition) *ParentQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *PetQuery) WithOwner() *PetQuery {
-	q.AddRelation(Schema.Person.BaseSchema, "Owner", kallax.OneToOne, nil)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *ParentQuery) GroupBy(cols ...kallax.SchemaField) *ParentQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *ParentQuery) Having(cond kallax.Condition) *ParentQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *ParentQuery) ForUpdate() *ParentQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *ParentQuery) ForShare() *ParentQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *ParentQuery) NoWait() *ParentQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *ParentQuery) SkipLocked() *ParentQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *ParentQuery) WithChildren(cond kallax.Condition) *ParentQuery {
+	q.AddRelation(Schema.Child.BaseSchema, "Children", kallax.OneToMany, cond)
+	return q
+}
+
+// WhereHasChildren filters the records whose Children relationship has any
+// record matching the given condition, which can be nil.
+func (q *ParentQuery) WhereHasChildren(cond kallax.Condition) *ParentQuery {
+	q.Where(kallax.Has(Schema.Child.BaseSchema, "Children", cond))
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *PetQuery) FindByID(v ...kallax.ULID) *PetQuery {
+func (q *ParentQuery) FindByID(v ...int64) *ParentQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -8153,45 +11750,33 @@ func (q *PetQuery) FindByID(v ...kallax.ULID) *PetQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.Pet.ID, values...))
+	return q.Where(kallax.In(Schema.Parent.ID, values...))
 }
 
 // FindByName adds a new filter to the query that will require that
 // the Name property is equal to the passed value.
-func (q *PetQuery) FindByName(v string) *PetQuery {
-	return q.Where(kallax.Eq(Schema.Pet.Name, v))
-}
-
-// FindByKind adds a new filter to the query that will require that
-// the Kind property is equal to the passed value.
-func (q *PetQuery) FindByKind(v string) *PetQuery {
-	return q.Where(kallax.Eq(Schema.Pet.Kind, v))
-}
-
-// FindByOwner adds a new filter to the query that will require that
-// the foreign key of Owner is equal to the passed value.
-func (q *PetQuery) FindByOwner(v int64) *PetQuery {
-	return q.Where(kallax.Eq(Schema.Pet.OwnerFK, v))
+func (q *ParentQuery) FindByName(v string) *ParentQuery {
+	return q.Where(kallax.Eq(Schema.Parent.Name, v))
 }
 
-// PetResultSet is the set of results returned by a query to the
+// ParentResultSet is the set of results returned by a query to the
 // database.
-type PetResultSet struct {
+type ParentResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *Pet
+	last      *Parent
 	lastErr   error
 }
 
-// NewPetResultSet creates a new result set for rows of the type
-// Pet.
-func NewPetResultSet(rs kallax.ResultSet) *PetResultSet {
-	return &PetResultSet{ResultSet: rs}
+// NewParentResultSet creates a new result set for rows of the type
+// Parent.
+func NewParentResultSet(rs kallax.ResultSet) *ParentResultSet {
+	return &ParentResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *PetResultSet) Next() bool {
+func (rs *ParentResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -8199,14 +11784,14 @@ func (rs *PetResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.Pet.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.Parent.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*Pet)
+		rs.last, ok = record.(*Parent)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Pet")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Parent")
 			rs.last = nil
 		}
 	}
@@ -8215,7 +11800,7 @@ func (rs *PetResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *PetResultSet) Get() (*Pet, error) {
+func (rs *ParentResultSet) Get() (*Parent, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -8223,7 +11808,7 @@ func (rs *PetResultSet) Get() (*Pet, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *PetResultSet) ForEach(fn func(*Pet) error) error {
+func (rs *ParentResultSet) ForEach(fn func(*Parent) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8242,8 +11827,8 @@ func (rs *PetResultSet) ForEach(fn func(*Pet) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *PetResultSet) All() ([]*Pet, error) {
-	var result []*Pet
+func (rs *ParentResultSet) All() ([]*Parent, error) {
+	var result []*Parent
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8255,7 +11840,7 @@ func (rs *PetResultSet) All() ([]*Pet, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *PetResultSet) One() (*Pet, error) {
+func (rs *ParentResultSet) One() (*Parent, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -8272,283 +11857,146 @@ func (rs *PetResultSet) One() (*Pet, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *ParentResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *PetResultSet) Err() error {
+func (rs *ParentResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *PetResultSet) Close() error {
+func (rs *ParentResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewQueryFixture returns a new instance of QueryFixture.
-func NewQueryFixture(f string) (record *QueryFixture) {
-	return newQueryFixture(f)
+// NewParentNoPtr returns a new instance of ParentNoPtr.
+func NewParentNoPtr() (record *ParentNoPtr) {
+	return new(ParentNoPtr)
 }
 
 // GetID returns the primary key of the model.
-func (r *QueryFixture) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *ParentNoPtr) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *QueryFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *ParentNoPtr) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
-	case "inverse_id":
-		return types.Nullable(kallax.VirtualColumn("inverse_id", r, new(kallax.ULID))), nil
-	case "embedded":
-		return types.JSON(&r.Embedded), nil
-	case "inline":
-		return &r.Inline.Inline, nil
-	case "map_of_string":
-		return types.JSON(&r.MapOfString), nil
-	case "map_of_interface":
-		return types.JSON(&r.MapOfInterface), nil
-	case "map_of_some_type":
-		return types.JSON(&r.MapOfSomeType), nil
-	case "foo":
-		return &r.Foo, nil
-	case "string_property":
-		return &r.StringProperty, nil
-	case "integer":
-		return &r.Integer, nil
-	case "integer64":
-		return &r.Integer64, nil
-	case "float32":
-		return &r.Float32, nil
-	case "boolean":
-		return &r.Boolean, nil
-	case "array_param":
-		return types.Array(&r.ArrayParam, 3), nil
-	case "slice_param":
-		return types.Slice(&r.SliceParam), nil
-	case "alias_array_param":
-		return types.Array(&r.AliasArrayParam, 3), nil
-	case "alias_slice_param":
-		return types.Slice((*[]string)(&r.AliasSliceParam)), nil
-	case "alias_string_param":
-		return (*string)(&r.AliasStringParam), nil
-	case "alias_int_param":
-		return (*int)(&r.AliasIntParam), nil
-	case "dummy_param":
-		return types.JSON(&r.DummyParam), nil
-	case "alias_dummy_param":
-		return types.JSON(&r.AliasDummyParam), nil
-	case "slice_dummy_param":
-		return types.JSON(&r.SliceDummyParam), nil
-	case "idproperty_param":
-		return &r.IDPropertyParam, nil
-	case "interface_prop_param":
-		return &r.InterfacePropParam, nil
-	case "urlparam":
-		return (*types.URL)(&r.URLParam), nil
-	case "time_param":
-		return &r.TimeParam, nil
-	case "alias_arr_alias_string_param":
-		return types.Slice(&r.AliasArrAliasStringParam), nil
-	case "alias_here_array_param":
-		return types.Array(&r.AliasHereArrayParam, 3), nil
-	case "array_alias_here_string_param":
-		return types.Slice(&r.ArrayAliasHereStringParam), nil
-	case "scanner_valuer_param":
-		return &r.ScannerValuerParam, nil
+		return (*kallax.NumericID)(&r.ID), nil
+	case "name":
+		return &r.Name, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in QueryFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in ParentNoPtr: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *QueryFixture) Value(col string) (interface{}, error) {
+func (r *ParentNoPtr) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "inverse_id":
-		v := r.Model.VirtualColumn(col)
-		if v == nil {
-			return nil, kallax.ErrEmptyVirtualColumn
-		}
-		return v, nil
-	case "embedded":
-		return types.JSON(r.Embedded), nil
-	case "inline":
-		return r.Inline.Inline, nil
-	case "map_of_string":
-		return types.JSON(r.MapOfString), nil
-	case "map_of_interface":
-		return types.JSON(r.MapOfInterface), nil
-	case "map_of_some_type":
-		return types.JSON(r.MapOfSomeType), nil
-	case "foo":
-		return r.Foo, nil
-	case "string_property":
-		return r.StringProperty, nil
-	case "integer":
-		return r.Integer, nil
-	case "integer64":
-		return r.Integer64, nil
-	case "float32":
-		return r.Float32, nil
-	case "boolean":
-		return r.Boolean, nil
-	case "array_param":
-		return types.Array(&r.ArrayParam, 3), nil
-	case "slice_param":
-		return types.Slice(r.SliceParam), nil
-	case "alias_array_param":
-		return types.Array(&r.AliasArrayParam, 3), nil
-	case "alias_slice_param":
-		return types.Slice(r.AliasSliceParam), nil
-	case "alias_string_param":
-		return (string)(r.AliasStringParam), nil
-	case "alias_int_param":
-		return (int)(r.AliasIntParam), nil
-	case "dummy_param":
-		return types.JSON(r.DummyParam), nil
-	case "alias_dummy_param":
-		return types.JSON(r.AliasDummyParam), nil
-	case "slice_dummy_param":
-		return types.JSON(r.SliceDummyParam), nil
-	case "idproperty_param":
-		return r.IDPropertyParam, nil
-	case "interface_prop_param":
-		return r.InterfacePropParam, nil
-	case "urlparam":
-		return (*types.URL)(&r.URLParam), nil
-	case "time_param":
-		return r.TimeParam, nil
-	case "alias_arr_alias_string_param":
-		return types.Slice(r.AliasArrAliasStringParam), nil
-	case "alias_here_array_param":
-		return types.Array(&r.AliasHereArrayParam, 3), nil
-	case "array_alias_here_string_param":
-		return types.Slice(r.ArrayAliasHereStringParam), nil
-	case "scanner_valuer_param":
-		return r.ScannerValuerParam, nil
+	case "name":
+		return r.Name, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in QueryFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in ParentNoPtr: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *QueryFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+func (r *ParentNoPtr) NewRelationshipRecord(field string) (kallax.Record, error) {
 	switch field {
-	case "Relation":
-		return new(QueryRelationFixture), nil
-	case "Inverse":
-		return new(QueryRelationFixture), nil
-	case "NRelation":
-		return new(QueryRelationFixture), nil
+	case "Children":
+		return new(Child), nil
 
 	}
-	return nil, fmt.Errorf("kallax: model QueryFixture has no relationship %s", field)
+	return nil, fmt.Errorf("kallax: model ParentNoPtr has no relationship %s", field)
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *QueryFixture) SetRelationship(field string, rel interface{}) error {
+func (r *ParentNoPtr) SetRelationship(field string, rel interface{}) error {
 	switch field {
-	case "Relation":
-		val, ok := rel.(*QueryRelationFixture)
-		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Relation", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Relation = val
-		}
-
-		return nil
-	case "Inverse":
-		val, ok := rel.(*QueryRelationFixture)
-		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Inverse", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Inverse = val
-		}
-
-		return nil
-	case "NRelation":
+	case "Children":
 		records, ok := rel.([]kallax.Record)
 		if !ok {
 			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
 		}
 
-		r.NRelation = make([]*QueryRelationFixture, len(records))
+		r.Children = make([]Child, len(records))
 		for i, record := range records {
-			rel, ok := record.(*QueryRelationFixture)
+			rel, ok := record.(*Child)
 			if !ok {
 				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
 			}
-			r.NRelation[i] = rel
+			r.Children[i] = *rel
 		}
 		return nil
 
 	}
-	return fmt.Errorf("kallax: model QueryFixture has no relationship %s", field)
+	return fmt.Errorf("kallax: model ParentNoPtr has no relationship %s", field)
 }
 
-// QueryFixtureStore is the entity to access the records of the type QueryFixture
+// ParentNoPtrStore is the entity to access the records of the type ParentNoPtr
 // in the database.
-type QueryFixtureStore struct {
+type ParentNoPtrStore struct {
 	*kallax.Store
 }
 
-// NewQueryFixtureStore creates a new instance of QueryFixtureStore
+// NewParentNoPtrStore creates a new instance of ParentNoPtrStore
 // using a SQL database.
-func NewQueryFixtureStore(db *sql.DB) *QueryFixtureStore {
-	return &QueryFixtureStore{kallax.NewStore(db)}
+func NewParentNoPtrStore(db *sql.DB) *ParentNoPtrStore {
+	return &ParentNoPtrStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *QueryFixtureStore) GenericStore() *kallax.Store {
+func (s *ParentNoPtrStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *QueryFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *ParentNoPtrStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *QueryFixtureStore) Debug() *QueryFixtureStore {
-	return &QueryFixtureStore{s.Store.Debug()}
+func (s *ParentNoPtrStore) Debug() *ParentNoPtrStore {
+	return &ParentNoPtrStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *QueryFixtureStore) DebugWith(logger kallax.LoggerFunc) *QueryFixtureStore {
-	return &QueryFixtureStore{s.Store.DebugWith(logger)}
+func (s *ParentNoPtrStore) DebugWith(logger kallax.LoggerFunc) *ParentNoPtrStore {
+	return &ParentNoPtrStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *QueryFixtureStore) DisableCacher() *QueryFixtureStore {
-	return &QueryFixtureStore{s.Store.DisableCacher()}
+func (s *ParentNoPtrStore) DisableCacher() *ParentNoPtrStore {
+	return &ParentNoPtrStore{s.Store.DisableCacher()}
 }
 
-func (s *QueryFixtureStore) relationshipRecords(record *QueryFixture) []modelSaveFunc {
-	var result []modelSaveFunc
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *ParentNoPtrStore) WithDialect(dialect kallax.Dialect) *ParentNoPtrStore {
+	return &ParentNoPtrStore{s.Store.WithDialect(dialect)}
+}
 
-	if record.Relation != nil && !record.Relation.IsSaving() {
-		r := record.Relation
-		r.AddVirtualColumn("owner_id", record.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&QueryRelationFixtureStore{store}).Save(r)
-			return err
-		})
-	}
+func (s *ParentNoPtrStore) relationshipRecords(record *ParentNoPtr) []modelSaveFunc {
+	var result []modelSaveFunc
 
-	for i := range record.NRelation {
-		r := record.NRelation[i]
+	for i := range record.Children {
+		r := &record.Children[i]
 		if !r.IsSaving() {
-			r.AddVirtualColumn("owner_id", record.GetID())
-			result = append(result, func(store *kallax.Store) error {
-				_, err := (&QueryRelationFixtureStore{store}).Save(r)
+			r.AddVirtualColumn("parent_id", record.GetID())
+			result = append(result, func(ctx context.Context, store *kallax.Store) error {
+				_, err := (&ChildStore{store}).SaveContext(ctx, r)
 				return err
 			})
 		}
@@ -8557,46 +12005,74 @@ func (s *QueryFixtureStore) relationshipRecords(record *QueryFixture) []modelSav
 	return result
 }
 
-func (s *QueryFixtureStore) inverseRecords(record *QueryFixture) []modelSaveFunc {
-	var result []modelSaveFunc
-
-	if record.Inverse != nil && !record.Inverse.IsSaving() {
-		record.AddVirtualColumn("inverse_id", record.Inverse.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&QueryRelationFixtureStore{store}).Save(record.Inverse)
-			return err
-		})
-	}
-
-	return result
+// Insert inserts a ParentNoPtr in the database. A non-persisted object is
+// required for this operation.
+func (s *ParentNoPtrStore) Insert(record *ParentNoPtr) error {
+	return s.InsertContext(context.Background(), record)
 }
 
-// Insert inserts a QueryFixture in the database. A non-persisted object is
-// required for this operation.
-func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *ParentNoPtrStore) InsertContext(ctx context.Context, record *ParentNoPtr) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
-
 	records := s.relationshipRecords(record)
 
-	inverseRecords := s.inverseRecords(record)
+	if len(records) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertContext(ctx, Schema.ParentNoPtr.BaseSchema, record); err != nil {
+				return err
+			}
 
-	if len(records) > 0 || len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
 
-			if err := s.Insert(Schema.QueryFixture.BaseSchema, record); err != nil {
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.ParentNoPtr.BaseSchema, record)
+}
+
+// InsertMany inserts all the given ParentNoPtr in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *ParentNoPtrStore) InsertMany(records []*ParentNoPtr) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *ParentNoPtrStore) InsertManyContext(ctx context.Context, records []*ParentNoPtr) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertManyContext(ctx, Schema.ParentNoPtr.BaseSchema, recs...); err != nil {
 				return err
 			}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
@@ -8605,7 +12081,7 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.QueryFixture.BaseSchema, record)
+	return s.Store.InsertManyContext(ctx, Schema.ParentNoPtr.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -8614,31 +12090,27 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaField) (updated int64, err error) {
-	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+func (s *ParentNoPtrStore) Update(record *ParentNoPtr, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *ParentNoPtrStore) UpdateContext(ctx context.Context, record *ParentNoPtr, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
 	records := s.relationshipRecords(record)
 
-	inverseRecords := s.inverseRecords(record)
-
-	if len(records) > 0 || len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
-
-			updated, err = s.Update(Schema.QueryFixture.BaseSchema, record, cols...)
+	if len(records) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			updated, err = s.UpdateContext(ctx, Schema.ParentNoPtr.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
 
 			for _, r := range records {
-				if err := r(s); err != nil {
+				if err := r(ctx, s); err != nil {
 					return err
 				}
 			}
@@ -8652,17 +12124,23 @@ func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaFi
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.QueryFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.ParentNoPtr.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *QueryFixtureStore) Save(record *QueryFixture) (updated bool, err error) {
+func (s *ParentNoPtrStore) Save(record *ParentNoPtr) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *ParentNoPtrStore) SaveContext(ctx context.Context, record *ParentNoPtr) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -8670,45 +12148,159 @@ func (s *QueryFixtureStore) Save(record *QueryFixture) (updated bool, err error)
 	return rowsUpdated > 0, nil
 }
 
-// Delete removes the given record from the database.
-func (s *QueryFixtureStore) Delete(record *QueryFixture) error {
-	return s.Store.Delete(Schema.QueryFixture.BaseSchema, record)
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *ParentNoPtrStore) Upsert(record *ParentNoPtr, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
 }
 
-// Find returns the set of results for the given query.
-func (s *QueryFixtureStore) Find(q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
-	if err != nil {
-		return nil, err
-	}
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *ParentNoPtrStore) UpsertContext(ctx context.Context, record *ParentNoPtr, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
 
-	return NewQueryFixtureResultSet(rs), nil
+	return s.Store.UpsertContext(ctx, Schema.ParentNoPtr.BaseSchema, record, conflictCols, updateCols...)
 }
 
-// MustFind returns the set of results for the given query, but panics if there
-// is any error.
-func (s *QueryFixtureStore) MustFind(q *QueryFixtureQuery) *QueryFixtureResultSet {
-	return NewQueryFixtureResultSet(s.Store.MustFind(q))
+// Delete removes the given record from the database.
+func (s *ParentNoPtrStore) Delete(record *ParentNoPtr) error {
+	return s.DeleteContext(context.Background(), record)
 }
 
-// Count returns the number of rows that would be retrieved with the given
-// query.
-func (s *QueryFixtureStore) Count(q *QueryFixtureQuery) (int64, error) {
-	return s.Store.Count(q)
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *ParentNoPtrStore) DeleteContext(ctx context.Context, record *ParentNoPtr) error {
+	return s.Store.DeleteContext(ctx, Schema.ParentNoPtr.BaseSchema, record)
 }
 
-// MustCount returns the number of rows that would be retrieved with the given
-// query, but panics if there is an error.
-func (s *QueryFixtureStore) MustCount(q *QueryFixtureQuery) int64 {
-	return s.Store.MustCount(q)
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *ParentNoPtrStore) UpdateWhere(q *ParentNoPtrQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *ParentNoPtrStore) UpdateWhereContext(ctx context.Context, q *ParentNoPtrQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *ParentNoPtrStore) UpdateWhereReturning(q *ParentNoPtrQuery, values map[kallax.SchemaField]interface{}) (*ParentNoPtrResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *ParentNoPtrStore) UpdateWhereReturningContext(ctx context.Context, q *ParentNoPtrQuery, values map[kallax.SchemaField]interface{}) (*ParentNoPtrResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewParentNoPtrResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *ParentNoPtrStore) DeleteWhere(q *ParentNoPtrQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *ParentNoPtrStore) DeleteWhereContext(ctx context.Context, q *ParentNoPtrQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *ParentNoPtrStore) DeleteWhereReturning(q *ParentNoPtrQuery) (*ParentNoPtrResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *ParentNoPtrStore) DeleteWhereReturningContext(ctx context.Context, q *ParentNoPtrQuery) (*ParentNoPtrResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewParentNoPtrResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *ParentNoPtrStore) Find(q *ParentNoPtrQuery) (*ParentNoPtrResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *ParentNoPtrStore) FindContext(ctx context.Context, q *ParentNoPtrQuery) (*ParentNoPtrResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewParentNoPtrResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *ParentNoPtrStore) MustFind(q *ParentNoPtrQuery) *ParentNoPtrResultSet {
+	return NewParentNoPtrResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *ParentNoPtrStore) MustFindContext(ctx context.Context, q *ParentNoPtrQuery) *ParentNoPtrResultSet {
+	return NewParentNoPtrResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *ParentNoPtrStore) Count(q *ParentNoPtrQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *ParentNoPtrStore) CountContext(ctx context.Context, q *ParentNoPtrQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *ParentNoPtrStore) MustCount(q *ParentNoPtrQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *ParentNoPtrStore) MustCountContext(ctx context.Context, q *ParentNoPtrQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
 }
 
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *QueryFixtureStore) FindOne(q *QueryFixtureQuery) (*QueryFixture, error) {
+func (s *ParentNoPtrStore) FindOne(q *ParentNoPtrQuery) (*ParentNoPtr, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *ParentNoPtrStore) FindOneContext(ctx context.Context, q *ParentNoPtrQuery) (*ParentNoPtr, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8730,8 +12322,14 @@ func (s *QueryFixtureStore) FindOne(q *QueryFixtureQuery) (*QueryFixture, error)
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *QueryFixtureStore) FindAll(q *QueryFixtureQuery) ([]*QueryFixture, error) {
-	rs, err := s.Find(q)
+func (s *ParentNoPtrStore) FindAll(q *ParentNoPtrQuery) ([]*ParentNoPtr, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *ParentNoPtrStore) FindAllContext(ctx context.Context, q *ParentNoPtrQuery) ([]*ParentNoPtr, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8741,86 +12339,79 @@ func (s *QueryFixtureStore) FindAll(q *QueryFixtureQuery) ([]*QueryFixture, erro
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *QueryFixtureStore) MustFindOne(q *QueryFixtureQuery) *QueryFixture {
-	record, err := s.FindOne(q)
+func (s *ParentNoPtrStore) MustFindOne(q *ParentNoPtrQuery) *ParentNoPtr {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *ParentNoPtrStore) MustFindOneContext(ctx context.Context, q *ParentNoPtrQuery) *ParentNoPtr {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the QueryFixture with the data in the database and
+// Reload refreshes the ParentNoPtr with the data in the database and
 // makes it writable.
-func (s *QueryFixtureStore) Reload(record *QueryFixture) error {
-	return s.Store.Reload(Schema.QueryFixture.BaseSchema, record)
+func (s *ParentNoPtrStore) Reload(record *ParentNoPtr) error {
+	return s.Store.Reload(Schema.ParentNoPtr.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *ParentNoPtrStore) ReloadContext(ctx context.Context, record *ParentNoPtr) error {
+	return s.Store.ReloadContext(ctx, Schema.ParentNoPtr.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *QueryFixtureStore) Transaction(callback func(*QueryFixtureStore) error) error {
+func (s *ParentNoPtrStore) Transaction(callback func(*ParentNoPtrStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *ParentNoPtrStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*ParentNoPtrStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&QueryFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&ParentNoPtrStore{store})
 	})
 }
 
-// RemoveRelation removes from the database the given relationship of the
-// model. It also resets the field Relation of the model.
-func (s *QueryFixtureStore) RemoveRelation(record *QueryFixture) error {
-	var r kallax.Record = record.Relation
-	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-		if err := beforeDeleter.BeforeDelete(); err != nil {
-			return err
-		}
-	}
-
-	var err error
-	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.QueryRelationFixture.BaseSchema, r)
-			if err != nil {
-				return err
-			}
-
-			return afterDeleter.AfterDelete()
-		})
-	} else {
-		err = s.Store.Delete(Schema.QueryRelationFixture.BaseSchema, r)
-	}
-	if err != nil {
-		return err
-	}
-
-	record.Relation = nil
-	return nil
-}
-
-// RemoveNRelation removes the given items of the NRelation field of the
+// RemoveChildren removes the given items of the Children field of the
 // model. If no items are given, it removes all of them.
 // The items will also be removed from the passed record inside this method.
-// Note that is required that `NRelation` is not empty. This method clears the
-// the elements of NRelation in a model, it does not retrieve them to know
+// Note that is required that `Children` is not empty. This method clears the
+// the elements of Children in a model, it does not retrieve them to know
 // what relationships the model has.
-func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*QueryRelationFixture) error {
-	var updated []*QueryRelationFixture
+func (s *ParentNoPtrStore) RemoveChildren(record *ParentNoPtr, deleted ...Child) error {
+	return s.RemoveChildrenContext(context.Background(), record, deleted...)
+}
+
+// RemoveChildrenContext is the same as RemoveChildren, but the given
+// context is used to run the queries.
+func (s *ParentNoPtrStore) RemoveChildrenContext(ctx context.Context, record *ParentNoPtr, deleted ...Child) error {
+	var updated []Child
 	var clear bool
 	if len(deleted) == 0 {
 		clear = true
-		deleted = record.NRelation
+		deleted = record.Children
 		if len(deleted) == 0 {
 			return nil
 		}
 	}
 
 	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
+		err := s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
 			for _, d := range deleted {
-				var r kallax.Record = d
+				var r kallax.Record = &d
 
 				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 					if err := beforeDeleter.BeforeDelete(); err != nil {
@@ -8828,7 +12419,7 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 					}
 				}
 
-				if err := s.Delete(Schema.QueryRelationFixture.BaseSchema, d); err != nil {
+				if err := s.DeleteContext(ctx, Schema.Child.BaseSchema, &d); err != nil {
 					return err
 				}
 
@@ -8846,11 +12437,11 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 		}
 
 		if clear {
-			record.NRelation = nil
+			record.Children = nil
 			return nil
 		}
 	} else {
-		var r kallax.Record = deleted[0]
+		var r kallax.Record = &deleted[0]
 		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 			if err := beforeDeleter.BeforeDelete(); err != nil {
 				return err
@@ -8859,8 +12450,8 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 
 		var err error
 		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.QueryRelationFixture.BaseSchema, r)
+			err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.Child.BaseSchema, r)
 				if err != nil {
 					return err
 				}
@@ -8868,7 +12459,7 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 				return afterDeleter.AfterDelete()
 			})
 		} else {
-			err = s.Store.Delete(Schema.QueryRelationFixture.BaseSchema, deleted[0])
+			err = s.Store.DeleteContext(ctx, Schema.Child.BaseSchema, &deleted[0])
 		}
 
 		if err != nil {
@@ -8876,7 +12467,7 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 		}
 	}
 
-	for _, r := range record.NRelation {
+	for _, r := range record.Children {
 		var found bool
 		for _, d := range deleted {
 			if d.GetID().Equals(r.GetID()) {
@@ -8888,25 +12479,25 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 			updated = append(updated, r)
 		}
 	}
-	record.NRelation = updated
+	record.Children = updated
 	return nil
 }
 
-// QueryFixtureQuery is the object used to create queries for the QueryFixture
+// ParentNoPtrQuery is the object used to create queries for the ParentNoPtr
 // entity.
-type QueryFixtureQuery struct {
+type ParentNoPtrQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewQueryFixtureQuery returns a new instance of QueryFixtureQuery.
-func NewQueryFixtureQuery() *QueryFixtureQuery {
-	return &QueryFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.QueryFixture.BaseSchema),
+// NewParentNoPtrQuery returns a new instance of ParentNoPtrQuery.
+func NewParentNoPtrQuery() *ParentNoPtrQuery {
+	return &ParentNoPtrQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.ParentNoPtr.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *QueryFixtureQuery) Select(columns ...kallax.SchemaField) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) Select(columns ...kallax.SchemaField) *ParentNoPtrQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -8915,132 +12506,121 @@ func (q *QueryFixtureQuery) Select(columns ...kallax.SchemaField) *QueryFixtureQ
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *QueryFixtureQuery) SelectNot(columns ...kallax.SchemaField) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) SelectNot(columns ...kallax.SchemaField) *ParentNoPtrQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *QueryFixtureQuery) Copy() *QueryFixtureQuery {
-	return &QueryFixtureQuery{
+func (q *ParentNoPtrQuery) Copy() *ParentNoPtrQuery {
+	return &ParentNoPtrQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *QueryFixtureQuery) Order(cols ...kallax.ColumnOrder) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) Order(cols ...kallax.ColumnOrder) *ParentNoPtrQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *ParentNoPtrQuery) After(cursor *kallax.Cursor) *ParentNoPtrQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *ParentNoPtrQuery) Before(cursor *kallax.Cursor) *ParentNoPtrQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *QueryFixtureQuery) BatchSize(size uint64) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) BatchSize(size uint64) *ParentNoPtrQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *QueryFixtureQuery) Limit(n uint64) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) Limit(n uint64) *ParentNoPtrQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *QueryFixtureQuery) Offset(n uint64) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) Offset(n uint64) *ParentNoPtrQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *QueryFixtureQuery) Where(cond kallax.Condition) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) Where(cond kallax.Condition) *ParentNoPtrQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *QueryFixtureQuery) WithRelation() *QueryFixtureQuery {
-	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "Relation", kallax.OneToOne, nil)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *ParentNoPtrQuery) GroupBy(cols ...kallax.SchemaField) *ParentNoPtrQuery {
+	q.BaseQuery.GroupBy(cols...)
 	return q
 }
 
-func (q *QueryFixtureQuery) WithInverse() *QueryFixtureQuery {
-	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "Inverse", kallax.OneToOne, nil)
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *ParentNoPtrQuery) Having(cond kallax.Condition) *ParentNoPtrQuery {
+	q.BaseQuery.Having(cond)
 	return q
 }
 
-func (q *QueryFixtureQuery) WithNRelation(cond kallax.Condition) *QueryFixtureQuery {
-	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "NRelation", kallax.OneToMany, cond)
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *ParentNoPtrQuery) ForUpdate() *ParentNoPtrQuery {
+	q.BaseQuery.ForUpdate()
 	return q
 }
 
-// FindByID adds a new filter to the query that will require that
-// the ID property is equal to one of the passed values; if no passed values,
-// it will do nothing.
-func (q *QueryFixtureQuery) FindByID(v ...kallax.ULID) *QueryFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.In(Schema.QueryFixture.ID, values...))
-}
-
-// FindByInverse adds a new filter to the query that will require that
-// the foreign key of Inverse is equal to the passed value.
-func (q *QueryFixtureQuery) FindByInverse(v kallax.ULID) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.InverseFK, v))
-}
-
-// FindByInline adds a new filter to the query that will require that
-// the Inline property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByInline(v string) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.Inline, v))
-}
-
-// FindByFoo adds a new filter to the query that will require that
-// the Foo property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByFoo(v string) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.Foo, v))
-}
-
-// FindByStringProperty adds a new filter to the query that will require that
-// the StringProperty property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByStringProperty(v string) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.StringProperty, v))
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *ParentNoPtrQuery) ForShare() *ParentNoPtrQuery {
+	q.BaseQuery.ForShare()
+	return q
 }
 
-// FindByInteger adds a new filter to the query that will require that
-// the Integer property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByInteger(cond kallax.ScalarCond, v int) *QueryFixtureQuery {
-	return q.Where(cond(Schema.QueryFixture.Integer, v))
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *ParentNoPtrQuery) NoWait() *ParentNoPtrQuery {
+	q.BaseQuery.NoWait()
+	return q
 }
 
-// FindByInteger64 adds a new filter to the query that will require that
-// the Integer64 property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByInteger64(cond kallax.ScalarCond, v int64) *QueryFixtureQuery {
-	return q.Where(cond(Schema.QueryFixture.Integer64, v))
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *ParentNoPtrQuery) SkipLocked() *ParentNoPtrQuery {
+	q.BaseQuery.SkipLocked()
+	return q
 }
 
-// FindByFloat32 adds a new filter to the query that will require that
-// the Float32 property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByFloat32(cond kallax.ScalarCond, v float32) *QueryFixtureQuery {
-	return q.Where(cond(Schema.QueryFixture.Float32, v))
+func (q *ParentNoPtrQuery) WithChildren(cond kallax.Condition) *ParentNoPtrQuery {
+	q.AddRelation(Schema.Child.BaseSchema, "Children", kallax.OneToMany, cond)
+	return q
 }
 
-// FindByBoolean adds a new filter to the query that will require that
-// the Boolean property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByBoolean(v bool) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.Boolean, v))
+// WhereHasChildren filters the records whose Children relationship has any
+// record matching the given condition, which can be nil.
+func (q *ParentNoPtrQuery) WhereHasChildren(cond kallax.Condition) *ParentNoPtrQuery {
+	q.Where(kallax.Has(Schema.Child.BaseSchema, "Children", cond))
+	return q
 }
 
-// FindByArrayParam adds a new filter to the query that will require that
-// the ArrayParam property contains all the passed values; if no passed values,
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *QueryFixtureQuery) FindByArrayParam(v ...string) *QueryFixtureQuery {
+func (q *ParentNoPtrQuery) FindByID(v ...int64) *ParentNoPtrQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -9048,119 +12628,6333 @@ func (q *QueryFixtureQuery) FindByArrayParam(v ...string) *QueryFixtureQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.ArrayParam, values...))
+	return q.Where(kallax.In(Schema.ParentNoPtr.ID, values...))
 }
 
-// FindBySliceParam adds a new filter to the query that will require that
-// the SliceParam property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *QueryFixtureQuery) FindBySliceParam(v ...string) *QueryFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
+// FindByName adds a new filter to the query that will require that
+// the Name property is equal to the passed value.
+func (q *ParentNoPtrQuery) FindByName(v string) *ParentNoPtrQuery {
+	return q.Where(kallax.Eq(Schema.ParentNoPtr.Name, v))
+}
+
+// ParentNoPtrResultSet is the set of results returned by a query to the
+// database.
+type ParentNoPtrResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *ParentNoPtr
+	lastErr   error
+}
+
+// NewParentNoPtrResultSet creates a new result set for rows of the type
+// ParentNoPtr.
+func NewParentNoPtrResultSet(rs kallax.ResultSet) *ParentNoPtrResultSet {
+	return &ParentNoPtrResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *ParentNoPtrResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.ParentNoPtr.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*ParentNoPtr)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *ParentNoPtr")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *ParentNoPtrResultSet) Get() (*ParentNoPtr, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *ParentNoPtrResultSet) ForEach(fn func(*ParentNoPtr) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *ParentNoPtrResultSet) All() ([]*ParentNoPtr, error) {
+	var result []*ParentNoPtr
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *ParentNoPtrResultSet) One() (*ParentNoPtr, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *ParentNoPtrResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *ParentNoPtrResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *ParentNoPtrResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewPerson returns a new instance of Person.
+func NewPerson(name string) (record *Person) {
+	return newPerson(name)
+}
+
+// GetID returns the primary key of the model.
+func (r *Person) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *Person) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.NumericID)(&r.ID), nil
+	case "name":
+		return &r.Name, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in Person: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *Person) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "name":
+		return r.Name, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in Person: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *Person) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Pets":
+		return new(Pet), nil
+	case "Car":
+		return new(Car), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model Person has no relationship %s", field)
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *Person) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Pets":
+		records, ok := rel.([]kallax.Record)
+		if !ok {
+			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
+		}
+
+		r.Pets = make([]*Pet, len(records))
+		for i, record := range records {
+			rel, ok := record.(*Pet)
+			if !ok {
+				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
+			}
+			r.Pets[i] = rel
+		}
+		return nil
+	case "Car":
+		val, ok := rel.(*Car)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Car", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Car = val
+		}
+
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model Person has no relationship %s", field)
+}
+
+// PersonStore is the entity to access the records of the type Person
+// in the database.
+type PersonStore struct {
+	*kallax.Store
+}
+
+// NewPersonStore creates a new instance of PersonStore
+// using a SQL database.
+func NewPersonStore(db *sql.DB) *PersonStore {
+	return &PersonStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *PersonStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *PersonStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *PersonStore) Debug() *PersonStore {
+	return &PersonStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *PersonStore) DebugWith(logger kallax.LoggerFunc) *PersonStore {
+	return &PersonStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *PersonStore) DisableCacher() *PersonStore {
+	return &PersonStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *PersonStore) WithDialect(dialect kallax.Dialect) *PersonStore {
+	return &PersonStore{s.Store.WithDialect(dialect)}
+}
+
+func (s *PersonStore) relationshipRecords(record *Person) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	for i := range record.Pets {
+		r := record.Pets[i]
+		if !r.IsSaving() {
+			r.AddVirtualColumn("owner_id", record.GetID())
+			result = append(result, func(ctx context.Context, store *kallax.Store) error {
+				_, err := (&PetStore{store}).SaveContext(ctx, r)
+				return err
+			})
+		}
+	}
+
+	if record.Car != nil && !record.Car.IsSaving() {
+		r := record.Car
+		r.AddVirtualColumn("owner_id", record.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&CarStore{store}).SaveContext(ctx, r)
+			return err
+		})
+	}
+
+	return result
+}
+
+// Insert inserts a Person in the database. A non-persisted object is
+// required for this operation.
+func (s *PersonStore) Insert(record *Person) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *PersonStore) InsertContext(ctx context.Context, record *Person) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	records := s.relationshipRecords(record)
+
+	if len(records) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertContext(ctx, Schema.Person.BaseSchema, record); err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.Person.BaseSchema, record); err != nil {
+			return err
+		}
+
+		if err := record.AfterSave(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// InsertMany inserts all the given Person in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *PersonStore) InsertMany(records []*Person) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *PersonStore) InsertManyContext(ctx context.Context, records []*Person) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			if err := s.InsertManyContext(ctx, Schema.Person.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.Person.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *PersonStore) UpdateContext(ctx context.Context, record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return 0, err
+	}
+
+	records := s.relationshipRecords(record)
+
+	if len(records) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			updated, err = s.UpdateContext(ctx, Schema.Person.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return updated, nil
+	}
+
+	err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.Person.BaseSchema, record, cols...)
+		if err != nil {
+			return err
+		}
+
+		if err := record.AfterSave(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *PersonStore) Save(record *Person) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *PersonStore) SaveContext(ctx context.Context, record *Person) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *PersonStore) Upsert(record *Person, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *PersonStore) UpsertContext(ctx context.Context, record *Person, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.Person.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		return record.AfterSave()
+	})
+}
+
+// Delete removes the given record from the database.
+func (s *PersonStore) Delete(record *Person) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *PersonStore) DeleteContext(ctx context.Context, record *Person) error {
+	if err := record.BeforeDelete(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		err := s.DeleteContext(ctx, Schema.Person.BaseSchema, record)
+		if err != nil {
+			return err
+		}
+
+		return record.AfterDelete()
+	})
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *PersonStore) UpdateWhere(q *PersonQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *PersonStore) UpdateWhereContext(ctx context.Context, q *PersonQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *PersonStore) UpdateWhereReturning(q *PersonQuery, values map[kallax.SchemaField]interface{}) (*PersonResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *PersonStore) UpdateWhereReturningContext(ctx context.Context, q *PersonQuery, values map[kallax.SchemaField]interface{}) (*PersonResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPersonResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *PersonStore) DeleteWhere(q *PersonQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *PersonStore) DeleteWhereContext(ctx context.Context, q *PersonQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *PersonStore) DeleteWhereReturning(q *PersonQuery) (*PersonResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *PersonStore) DeleteWhereReturningContext(ctx context.Context, q *PersonQuery) (*PersonResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPersonResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *PersonStore) Find(q *PersonQuery) (*PersonResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *PersonStore) FindContext(ctx context.Context, q *PersonQuery) (*PersonResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPersonResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *PersonStore) MustFind(q *PersonQuery) *PersonResultSet {
+	return NewPersonResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *PersonStore) MustFindContext(ctx context.Context, q *PersonQuery) *PersonResultSet {
+	return NewPersonResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *PersonStore) Count(q *PersonQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *PersonStore) CountContext(ctx context.Context, q *PersonQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *PersonStore) MustCount(q *PersonQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *PersonStore) MustCountContext(ctx context.Context, q *PersonQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *PersonStore) FindOneContext(ctx context.Context, q *PersonQuery) (*Person, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *PersonStore) FindAll(q *PersonQuery) ([]*Person, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *PersonStore) FindAllContext(ctx context.Context, q *PersonQuery) ([]*Person, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *PersonStore) MustFindOne(q *PersonQuery) *Person {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *PersonStore) MustFindOneContext(ctx context.Context, q *PersonQuery) *Person {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the Person with the data in the database and
+// makes it writable.
+func (s *PersonStore) Reload(record *Person) error {
+	return s.Store.Reload(Schema.Person.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *PersonStore) ReloadContext(ctx context.Context, record *Person) error {
+	return s.Store.ReloadContext(ctx, Schema.Person.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) Transaction(callback func(*PersonStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *PersonStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*PersonStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&PersonStore{store})
+	})
+}
+
+// RemovePets removes the given items of the Pets field of the
+// model. If no items are given, it removes all of them.
+// The items will also be removed from the passed record inside this method.
+// Note that is required that `Pets` is not empty. This method clears the
+// the elements of Pets in a model, it does not retrieve them to know
+// what relationships the model has.
+func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
+	return s.RemovePetsContext(context.Background(), record, deleted...)
+}
+
+// RemovePetsContext is the same as RemovePets, but the given
+// context is used to run the queries.
+func (s *PersonStore) RemovePetsContext(ctx context.Context, record *Person, deleted ...*Pet) error {
+	var updated []*Pet
+	var clear bool
+	if len(deleted) == 0 {
+		clear = true
+		deleted = record.Pets
+		if len(deleted) == 0 {
+			return nil
+		}
+	}
+
+	if len(deleted) > 1 {
+		err := s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, d := range deleted {
+				var r kallax.Record = d
+
+				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+					if err := beforeDeleter.BeforeDelete(); err != nil {
+						return err
+					}
+				}
+
+				if err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, d); err != nil {
+					return err
+				}
+
+				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+					if err := afterDeleter.AfterDelete(); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if clear {
+			record.Pets = nil
+			return nil
+		}
+	} else {
+		var r kallax.Record = deleted[0]
+		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+			if err := beforeDeleter.BeforeDelete(); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+			err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, r)
+				if err != nil {
+					return err
+				}
+
+				return afterDeleter.AfterDelete()
+			})
+		} else {
+			err = s.Store.DeleteContext(ctx, Schema.Pet.BaseSchema, deleted[0])
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, r := range record.Pets {
+		var found bool
+		for _, d := range deleted {
+			if d.GetID().Equals(r.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, r)
+		}
+	}
+	record.Pets = updated
+	return nil
+}
+
+// RemoveCar removes from the database the given relationship of the
+// model. It also resets the field Car of the model.
+func (s *PersonStore) RemoveCar(record *Person) error {
+	return s.RemoveCarContext(context.Background(), record)
+}
+
+// RemoveCarContext is the same as RemoveCar, but the given
+// context is used to run the queries.
+func (s *PersonStore) RemoveCarContext(ctx context.Context, record *Person) error {
+	var r kallax.Record = record.Car
+	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+		if err := beforeDeleter.BeforeDelete(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.Car.BaseSchema, r)
+			if err != nil {
+				return err
+			}
+
+			return afterDeleter.AfterDelete()
+		})
+	} else {
+		err = s.Store.DeleteContext(ctx, Schema.Car.BaseSchema, r)
+	}
+	if err != nil {
+		return err
+	}
+
+	record.Car = nil
+	return nil
+}
+
+// PersonQuery is the object used to create queries for the Person
+// entity.
+type PersonQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewPersonQuery returns a new instance of PersonQuery.
+func NewPersonQuery() *PersonQuery {
+	return &PersonQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.Person.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *PersonQuery) Select(columns ...kallax.SchemaField) *PersonQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *PersonQuery) SelectNot(columns ...kallax.SchemaField) *PersonQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *PersonQuery) Copy() *PersonQuery {
+	return &PersonQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *PersonQuery) Order(cols ...kallax.ColumnOrder) *PersonQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *PersonQuery) After(cursor *kallax.Cursor) *PersonQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *PersonQuery) Before(cursor *kallax.Cursor) *PersonQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *PersonQuery) BatchSize(size uint64) *PersonQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *PersonQuery) Limit(n uint64) *PersonQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *PersonQuery) Offset(n uint64) *PersonQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *PersonQuery) Where(cond kallax.Condition) *PersonQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *PersonQuery) GroupBy(cols ...kallax.SchemaField) *PersonQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *PersonQuery) Having(cond kallax.Condition) *PersonQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *PersonQuery) ForUpdate() *PersonQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *PersonQuery) ForShare() *PersonQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *PersonQuery) NoWait() *PersonQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *PersonQuery) SkipLocked() *PersonQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *PersonQuery) WithPets(cond kallax.Condition) *PersonQuery {
+	q.AddRelation(Schema.Pet.BaseSchema, "Pets", kallax.OneToMany, cond)
+	return q
+}
+
+// WhereHasPets filters the records whose Pets relationship has any
+// record matching the given condition, which can be nil.
+func (q *PersonQuery) WhereHasPets(cond kallax.Condition) *PersonQuery {
+	q.Where(kallax.Has(Schema.Pet.BaseSchema, "Pets", cond))
+	return q
+}
+
+func (q *PersonQuery) WithCar() *PersonQuery {
+	q.AddRelation(Schema.Car.BaseSchema, "Car", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasCar filters the records whose Car relationship has any
+// record matching the given condition, which can be nil.
+func (q *PersonQuery) WhereHasCar(cond kallax.Condition) *PersonQuery {
+	q.Where(kallax.Has(Schema.Car.BaseSchema, "Car", cond))
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *PersonQuery) FindByID(v ...int64) *PersonQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.Person.ID, values...))
+}
+
+// FindByName adds a new filter to the query that will require that
+// the Name property is equal to the passed value.
+func (q *PersonQuery) FindByName(v string) *PersonQuery {
+	return q.Where(kallax.Eq(Schema.Person.Name, v))
+}
+
+// PersonResultSet is the set of results returned by a query to the
+// database.
+type PersonResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *Person
+	lastErr   error
+}
+
+// NewPersonResultSet creates a new result set for rows of the type
+// Person.
+func NewPersonResultSet(rs kallax.ResultSet) *PersonResultSet {
+	return &PersonResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *PersonResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.Person.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*Person)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Person")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *PersonResultSet) Get() (*Person, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *PersonResultSet) ForEach(fn func(*Person) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *PersonResultSet) All() ([]*Person, error) {
+	var result []*Person
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *PersonResultSet) One() (*Person, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *PersonResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *PersonResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *PersonResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewPet returns a new instance of Pet.
+func NewPet(name string, kind string, owner *Person) (record *Pet) {
+	return newPet(name, kind, owner)
+}
+
+// GetID returns the primary key of the model.
+func (r *Pet) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *Pet) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "name":
+		return &r.Name, nil
+	case "kind":
+		return &r.Kind, nil
+	case "owner_id":
+		return types.Nullable(kallax.VirtualColumn("owner_id", r, new(kallax.NumericID))), nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in Pet: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *Pet) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "name":
+		return r.Name, nil
+	case "kind":
+		return r.Kind, nil
+	case "owner_id":
+		v := r.Model.VirtualColumn(col)
+		if v == nil {
+			return nil, kallax.ErrEmptyVirtualColumn
+		}
+		return v, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in Pet: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *Pet) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Owner":
+		return new(Person), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model Pet has no relationship %s", field)
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *Pet) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Owner":
+		val, ok := rel.(*Person)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Owner", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Owner = val
+		}
+
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model Pet has no relationship %s", field)
+}
+
+// PetStore is the entity to access the records of the type Pet
+// in the database.
+type PetStore struct {
+	*kallax.Store
+}
+
+// NewPetStore creates a new instance of PetStore
+// using a SQL database.
+func NewPetStore(db *sql.DB) *PetStore {
+	return &PetStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *PetStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *PetStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *PetStore) Debug() *PetStore {
+	return &PetStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *PetStore) DebugWith(logger kallax.LoggerFunc) *PetStore {
+	return &PetStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *PetStore) DisableCacher() *PetStore {
+	return &PetStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *PetStore) WithDialect(dialect kallax.Dialect) *PetStore {
+	return &PetStore{s.Store.WithDialect(dialect)}
+}
+
+func (s *PetStore) inverseRecords(record *Pet) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	if record.Owner != nil && !record.Owner.IsSaving() {
+		record.AddVirtualColumn("owner_id", record.Owner.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&PersonStore{store}).SaveContext(ctx, record.Owner)
+			return err
+		})
+	}
+
+	return result
+}
+
+// Insert inserts a Pet in the database. A non-persisted object is
+// required for this operation.
+func (s *PetStore) Insert(record *Pet) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *PetStore) InsertContext(ctx context.Context, record *Pet) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertContext(ctx, Schema.Pet.BaseSchema, record); err != nil {
+				return err
+			}
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.Pet.BaseSchema, record); err != nil {
+			return err
+		}
+
+		if err := record.AfterSave(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// InsertMany inserts all the given Pet in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *PetStore) InsertMany(records []*Pet) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *PetStore) InsertManyContext(ctx context.Context, records []*Pet) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	if len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertManyContext(ctx, Schema.Pet.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.Pet.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *PetStore) UpdateContext(ctx context.Context, record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return 0, err
+	}
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(inverseRecords) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			updated, err = s.UpdateContext(ctx, Schema.Pet.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return updated, nil
+	}
+
+	err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.Pet.BaseSchema, record, cols...)
+		if err != nil {
+			return err
+		}
+
+		if err := record.AfterSave(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *PetStore) Save(record *Pet) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *PetStore) SaveContext(ctx context.Context, record *Pet) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *PetStore) Upsert(record *Pet, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *PetStore) UpsertContext(ctx context.Context, record *Pet, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.Pet.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		return record.AfterSave()
+	})
+}
+
+// Delete removes the given record from the database.
+func (s *PetStore) Delete(record *Pet) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *PetStore) DeleteContext(ctx context.Context, record *Pet) error {
+	if err := record.BeforeDelete(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, record)
+		if err != nil {
+			return err
+		}
+
+		return record.AfterDelete()
+	})
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *PetStore) UpdateWhere(q *PetQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *PetStore) UpdateWhereContext(ctx context.Context, q *PetQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *PetStore) UpdateWhereReturning(q *PetQuery, values map[kallax.SchemaField]interface{}) (*PetResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *PetStore) UpdateWhereReturningContext(ctx context.Context, q *PetQuery, values map[kallax.SchemaField]interface{}) (*PetResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPetResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *PetStore) DeleteWhere(q *PetQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *PetStore) DeleteWhereContext(ctx context.Context, q *PetQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *PetStore) DeleteWhereReturning(q *PetQuery) (*PetResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *PetStore) DeleteWhereReturningContext(ctx context.Context, q *PetQuery) (*PetResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPetResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *PetStore) Find(q *PetQuery) (*PetResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *PetStore) FindContext(ctx context.Context, q *PetQuery) (*PetResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPetResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *PetStore) MustFind(q *PetQuery) *PetResultSet {
+	return NewPetResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *PetStore) MustFindContext(ctx context.Context, q *PetQuery) *PetResultSet {
+	return NewPetResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *PetStore) Count(q *PetQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *PetStore) CountContext(ctx context.Context, q *PetQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *PetStore) MustCount(q *PetQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *PetStore) MustCountContext(ctx context.Context, q *PetQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *PetStore) FindOneContext(ctx context.Context, q *PetQuery) (*Pet, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *PetStore) FindAll(q *PetQuery) ([]*Pet, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *PetStore) FindAllContext(ctx context.Context, q *PetQuery) ([]*Pet, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *PetStore) MustFindOne(q *PetQuery) *Pet {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *PetStore) MustFindOneContext(ctx context.Context, q *PetQuery) *Pet {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the Pet with the data in the database and
+// makes it writable.
+func (s *PetStore) Reload(record *Pet) error {
+	return s.Store.Reload(Schema.Pet.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *PetStore) ReloadContext(ctx context.Context, record *Pet) error {
+	return s.Store.ReloadContext(ctx, Schema.Pet.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) Transaction(callback func(*PetStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *PetStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*PetStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&PetStore{store})
+	})
+}
+
+// PetQuery is the object used to create queries for the Pet
+// entity.
+type PetQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewPetQuery returns a new instance of PetQuery.
+func NewPetQuery() *PetQuery {
+	return &PetQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.Pet.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *PetQuery) Select(columns ...kallax.SchemaField) *PetQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *PetQuery) SelectNot(columns ...kallax.SchemaField) *PetQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *PetQuery) Copy() *PetQuery {
+	return &PetQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *PetQuery) Order(cols ...kallax.ColumnOrder) *PetQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *PetQuery) After(cursor *kallax.Cursor) *PetQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *PetQuery) Before(cursor *kallax.Cursor) *PetQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *PetQuery) BatchSize(size uint64) *PetQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *PetQuery) Limit(n uint64) *PetQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *PetQuery) Offset(n uint64) *PetQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *PetQuery) Where(cond kallax.Condition) *PetQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *PetQuery) GroupBy(cols ...kallax.SchemaField) *PetQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *PetQuery) Having(cond kallax.Condition) *PetQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *PetQuery) ForUpdate() *PetQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *PetQuery) ForShare() *PetQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *PetQuery) NoWait() *PetQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *PetQuery) SkipLocked() *PetQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *PetQuery) WithOwner() *PetQuery {
+	q.AddRelation(Schema.Person.BaseSchema, "Owner", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasOwner filters the records whose Owner relationship has any
+// record matching the given condition, which can be nil.
+func (q *PetQuery) WhereHasOwner(cond kallax.Condition) *PetQuery {
+	q.Where(kallax.Has(Schema.Person.BaseSchema, "Owner", cond))
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *PetQuery) FindByID(v ...kallax.ULID) *PetQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.Pet.ID, values...))
+}
+
+// FindByName adds a new filter to the query that will require that
+// the Name property is equal to the passed value.
+func (q *PetQuery) FindByName(v string) *PetQuery {
+	return q.Where(kallax.Eq(Schema.Pet.Name, v))
+}
+
+// FindByKind adds a new filter to the query that will require that
+// the Kind property is equal to the passed value.
+func (q *PetQuery) FindByKind(v string) *PetQuery {
+	return q.Where(kallax.Eq(Schema.Pet.Kind, v))
+}
+
+// FindByOwner adds a new filter to the query that will require that
+// the foreign key of Owner is equal to the passed value.
+func (q *PetQuery) FindByOwner(v int64) *PetQuery {
+	return q.Where(kallax.Eq(Schema.Pet.OwnerFK, v))
+}
+
+// PetResultSet is the set of results returned by a query to the
+// database.
+type PetResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *Pet
+	lastErr   error
+}
+
+// NewPetResultSet creates a new result set for rows of the type
+// Pet.
+func NewPetResultSet(rs kallax.ResultSet) *PetResultSet {
+	return &PetResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *PetResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.Pet.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*Pet)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Pet")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *PetResultSet) Get() (*Pet, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *PetResultSet) ForEach(fn func(*Pet) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *PetResultSet) All() ([]*Pet, error) {
+	var result []*Pet
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *PetResultSet) One() (*Pet, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *PetResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *PetResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *PetResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewPost returns a new instance of Post.
+func NewPost(title string) (record *Post) {
+	return newPost(title)
+}
+
+// GetID returns the primary key of the model.
+func (r *Post) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *Post) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.NumericID)(&r.ID), nil
+	case "title":
+		return &r.Title, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in Post: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *Post) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "title":
+		return r.Title, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in Post: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *Post) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Tags":
+		return new(Tag), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model Post has no relationship %s", field)
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *Post) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Tags":
+		records, ok := rel.([]kallax.Record)
+		if !ok {
+			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
+		}
+
+		r.Tags = make([]*Tag, len(records))
+		for i, record := range records {
+			rel, ok := record.(*Tag)
+			if !ok {
+				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
+			}
+			r.Tags[i] = rel
+		}
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model Post has no relationship %s", field)
+}
+
+// PostStore is the entity to access the records of the type Post
+// in the database.
+type PostStore struct {
+	*kallax.Store
+}
+
+// NewPostStore creates a new instance of PostStore
+// using a SQL database.
+func NewPostStore(db *sql.DB) *PostStore {
+	return &PostStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *PostStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *PostStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *PostStore) Debug() *PostStore {
+	return &PostStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *PostStore) DebugWith(logger kallax.LoggerFunc) *PostStore {
+	return &PostStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *PostStore) DisableCacher() *PostStore {
+	return &PostStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *PostStore) WithDialect(dialect kallax.Dialect) *PostStore {
+	return &PostStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a Post in the database. A non-persisted object is
+// required for this operation.
+func (s *PostStore) Insert(record *Post) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *PostStore) InsertContext(ctx context.Context, record *Post) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.InsertContext(ctx, Schema.Post.BaseSchema, record)
+}
+
+// InsertMany inserts all the given Post in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *PostStore) InsertMany(records []*Post) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *PostStore) InsertManyContext(ctx context.Context, records []*Post) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.Post.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *PostStore) Update(record *Post, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *PostStore) UpdateContext(ctx context.Context, record *Post, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpdateContext(ctx, Schema.Post.BaseSchema, record, cols...)
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *PostStore) Save(record *Post) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *PostStore) SaveContext(ctx context.Context, record *Post) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *PostStore) Upsert(record *Post, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *PostStore) UpsertContext(ctx context.Context, record *Post, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.Post.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *PostStore) Delete(record *Post) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *PostStore) DeleteContext(ctx context.Context, record *Post) error {
+	return s.Store.DeleteContext(ctx, Schema.Post.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *PostStore) UpdateWhere(q *PostQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *PostStore) UpdateWhereContext(ctx context.Context, q *PostQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *PostStore) UpdateWhereReturning(q *PostQuery, values map[kallax.SchemaField]interface{}) (*PostResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *PostStore) UpdateWhereReturningContext(ctx context.Context, q *PostQuery, values map[kallax.SchemaField]interface{}) (*PostResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPostResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *PostStore) DeleteWhere(q *PostQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *PostStore) DeleteWhereContext(ctx context.Context, q *PostQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *PostStore) DeleteWhereReturning(q *PostQuery) (*PostResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *PostStore) DeleteWhereReturningContext(ctx context.Context, q *PostQuery) (*PostResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPostResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *PostStore) Find(q *PostQuery) (*PostResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *PostStore) FindContext(ctx context.Context, q *PostQuery) (*PostResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPostResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *PostStore) MustFind(q *PostQuery) *PostResultSet {
+	return NewPostResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *PostStore) MustFindContext(ctx context.Context, q *PostQuery) *PostResultSet {
+	return NewPostResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *PostStore) Count(q *PostQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *PostStore) CountContext(ctx context.Context, q *PostQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *PostStore) MustCount(q *PostQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *PostStore) MustCountContext(ctx context.Context, q *PostQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *PostStore) FindOne(q *PostQuery) (*Post, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *PostStore) FindOneContext(ctx context.Context, q *PostQuery) (*Post, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *PostStore) FindAll(q *PostQuery) ([]*Post, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *PostStore) FindAllContext(ctx context.Context, q *PostQuery) ([]*Post, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *PostStore) MustFindOne(q *PostQuery) *Post {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *PostStore) MustFindOneContext(ctx context.Context, q *PostQuery) *Post {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the Post with the data in the database and
+// makes it writable.
+func (s *PostStore) Reload(record *Post) error {
+	return s.Store.Reload(Schema.Post.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *PostStore) ReloadContext(ctx context.Context, record *Post) error {
+	return s.Store.ReloadContext(ctx, Schema.Post.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PostStore) Transaction(callback func(*PostStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *PostStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*PostStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&PostStore{store})
+	})
+}
+
+// AddTags adds the given items to the Tags many to many
+// relationship of the model, inserting the rows of the join table
+// posts_tags. The items that are not persisted yet are inserted as well.
+// The items that are not in the Tags field of the passed record yet will
+// also be appended to it.
+func (s *PostStore) AddTags(record *Post, added ...*Tag) error {
+	return s.AddTagsContext(context.Background(), record, added...)
+}
+
+// AddTagsContext is the same as AddTags, but the given context is
+// used to run the queries.
+func (s *PostStore) AddTagsContext(ctx context.Context, record *Post, added ...*Tag) error {
+	if len(added) == 0 {
+		return nil
+	}
+
+	err := s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		var related = make([]kallax.Record, len(added))
+		for i := range added {
+			r := added[i]
+			if !r.IsPersisted() {
+				if _, err := (&TagStore{s}).SaveContext(ctx, r); err != nil {
+					return err
+				}
+			}
+			related[i] = r
+		}
+
+		return s.LinkContext(ctx, Schema.Post.BaseSchema, "Tags", record, related...)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range added {
+		var found bool
+		for _, r := range record.Tags {
+			if r.GetID().Equals(a.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			record.Tags = append(record.Tags, a)
+		}
+	}
+	return nil
+}
+
+// RemoveTags removes the given items from the Tags many to many
+// relationship of the model, deleting the rows of the join table
+// posts_tags. If no items are given, the model is unlinked from all of
+// them. The items themselves are not deleted from the database.
+// The items will also be removed from the passed record inside this method.
+func (s *PostStore) RemoveTags(record *Post, removed ...*Tag) error {
+	return s.RemoveTagsContext(context.Background(), record, removed...)
+}
+
+// RemoveTagsContext is the same as RemoveTags, but the given
+// context is used to run the queries.
+func (s *PostStore) RemoveTagsContext(ctx context.Context, record *Post, removed ...*Tag) error {
+	var related = make([]kallax.Record, len(removed))
+	for i := range removed {
+		related[i] = removed[i]
+	}
+
+	if err := s.Store.UnlinkContext(ctx, Schema.Post.BaseSchema, "Tags", record, related...); err != nil {
+		return err
+	}
+
+	if len(removed) == 0 {
+		record.Tags = nil
+		return nil
+	}
+
+	var updated []*Tag
+	for _, r := range record.Tags {
+		var found bool
+		for _, d := range removed {
+			if d.GetID().Equals(r.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, r)
+		}
+	}
+	record.Tags = updated
+	return nil
+}
+
+// PostQuery is the object used to create queries for the Post
+// entity.
+type PostQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewPostQuery returns a new instance of PostQuery.
+func NewPostQuery() *PostQuery {
+	return &PostQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.Post.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *PostQuery) Select(columns ...kallax.SchemaField) *PostQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *PostQuery) SelectNot(columns ...kallax.SchemaField) *PostQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *PostQuery) Copy() *PostQuery {
+	return &PostQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *PostQuery) Order(cols ...kallax.ColumnOrder) *PostQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *PostQuery) After(cursor *kallax.Cursor) *PostQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *PostQuery) Before(cursor *kallax.Cursor) *PostQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *PostQuery) BatchSize(size uint64) *PostQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *PostQuery) Limit(n uint64) *PostQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *PostQuery) Offset(n uint64) *PostQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *PostQuery) Where(cond kallax.Condition) *PostQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *PostQuery) GroupBy(cols ...kallax.SchemaField) *PostQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *PostQuery) Having(cond kallax.Condition) *PostQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *PostQuery) ForUpdate() *PostQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *PostQuery) ForShare() *PostQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *PostQuery) NoWait() *PostQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *PostQuery) SkipLocked() *PostQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *PostQuery) WithTags(cond kallax.Condition) *PostQuery {
+	q.AddRelation(Schema.Tag.BaseSchema, "Tags", kallax.ManyToMany, cond)
+	return q
+}
+
+// WhereHasTags filters the records whose Tags relationship has any
+// record matching the given condition, which can be nil.
+func (q *PostQuery) WhereHasTags(cond kallax.Condition) *PostQuery {
+	q.Where(kallax.Has(Schema.Tag.BaseSchema, "Tags", cond))
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *PostQuery) FindByID(v ...int64) *PostQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.Post.ID, values...))
+}
+
+// FindByTitle adds a new filter to the query that will require that
+// the Title property is equal to the passed value.
+func (q *PostQuery) FindByTitle(v string) *PostQuery {
+	return q.Where(kallax.Eq(Schema.Post.Title, v))
+}
+
+// PostResultSet is the set of results returned by a query to the
+// database.
+type PostResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *Post
+	lastErr   error
+}
+
+// NewPostResultSet creates a new result set for rows of the type
+// Post.
+func NewPostResultSet(rs kallax.ResultSet) *PostResultSet {
+	return &PostResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *PostResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.Post.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*Post)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Post")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *PostResultSet) Get() (*Post, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *PostResultSet) ForEach(fn func(*Post) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *PostResultSet) All() ([]*Post, error) {
+	var result []*Post
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *PostResultSet) One() (*Post, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *PostResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *PostResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *PostResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewQueryFixture returns a new instance of QueryFixture.
+func NewQueryFixture(f string) (record *QueryFixture) {
+	return newQueryFixture(f)
+}
+
+// GetID returns the primary key of the model.
+func (r *QueryFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *QueryFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "inverse_id":
+		return types.Nullable(kallax.VirtualColumn("inverse_id", r, new(kallax.ULID))), nil
+	case "embedded":
+		return types.JSON(&r.Embedded), nil
+	case "inline":
+		return &r.Inline.Inline, nil
+	case "map_of_string":
+		return types.JSON(&r.MapOfString), nil
+	case "map_of_interface":
+		return types.JSON(&r.MapOfInterface), nil
+	case "map_of_some_type":
+		return types.JSON(&r.MapOfSomeType), nil
+	case "foo":
+		return &r.Foo, nil
+	case "string_property":
+		return &r.StringProperty, nil
+	case "integer":
+		return &r.Integer, nil
+	case "integer64":
+		return &r.Integer64, nil
+	case "float32":
+		return &r.Float32, nil
+	case "boolean":
+		return &r.Boolean, nil
+	case "array_param":
+		return types.Array(&r.ArrayParam, 3), nil
+	case "slice_param":
+		return types.Slice(&r.SliceParam), nil
+	case "alias_array_param":
+		return types.Array(&r.AliasArrayParam, 3), nil
+	case "alias_slice_param":
+		return types.Slice((*[]string)(&r.AliasSliceParam)), nil
+	case "alias_string_param":
+		return (*string)(&r.AliasStringParam), nil
+	case "alias_int_param":
+		return (*int)(&r.AliasIntParam), nil
+	case "dummy_param":
+		return types.JSON(&r.DummyParam), nil
+	case "alias_dummy_param":
+		return types.JSON(&r.AliasDummyParam), nil
+	case "slice_dummy_param":
+		return types.JSON(&r.SliceDummyParam), nil
+	case "idproperty_param":
+		return &r.IDPropertyParam, nil
+	case "interface_prop_param":
+		return &r.InterfacePropParam, nil
+	case "urlparam":
+		return (*types.URL)(&r.URLParam), nil
+	case "time_param":
+		return &r.TimeParam, nil
+	case "alias_arr_alias_string_param":
+		return types.Slice(&r.AliasArrAliasStringParam), nil
+	case "alias_here_array_param":
+		return types.Array(&r.AliasHereArrayParam, 3), nil
+	case "array_alias_here_string_param":
+		return types.Slice(&r.ArrayAliasHereStringParam), nil
+	case "scanner_valuer_param":
+		return &r.ScannerValuerParam, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in QueryFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *QueryFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "inverse_id":
+		v := r.Model.VirtualColumn(col)
+		if v == nil {
+			return nil, kallax.ErrEmptyVirtualColumn
+		}
+		return v, nil
+	case "embedded":
+		return types.JSON(r.Embedded), nil
+	case "inline":
+		return r.Inline.Inline, nil
+	case "map_of_string":
+		return types.JSON(r.MapOfString), nil
+	case "map_of_interface":
+		return types.JSON(r.MapOfInterface), nil
+	case "map_of_some_type":
+		return types.JSON(r.MapOfSomeType), nil
+	case "foo":
+		return r.Foo, nil
+	case "string_property":
+		return r.StringProperty, nil
+	case "integer":
+		return r.Integer, nil
+	case "integer64":
+		return r.Integer64, nil
+	case "float32":
+		return r.Float32, nil
+	case "boolean":
+		return r.Boolean, nil
+	case "array_param":
+		return types.Array(&r.ArrayParam, 3), nil
+	case "slice_param":
+		return types.Slice(r.SliceParam), nil
+	case "alias_array_param":
+		return types.Array(&r.AliasArrayParam, 3), nil
+	case "alias_slice_param":
+		return types.Slice(r.AliasSliceParam), nil
+	case "alias_string_param":
+		return (string)(r.AliasStringParam), nil
+	case "alias_int_param":
+		return (int)(r.AliasIntParam), nil
+	case "dummy_param":
+		return types.JSON(r.DummyParam), nil
+	case "alias_dummy_param":
+		return types.JSON(r.AliasDummyParam), nil
+	case "slice_dummy_param":
+		return types.JSON(r.SliceDummyParam), nil
+	case "idproperty_param":
+		return r.IDPropertyParam, nil
+	case "interface_prop_param":
+		return r.InterfacePropParam, nil
+	case "urlparam":
+		return (*types.URL)(&r.URLParam), nil
+	case "time_param":
+		return r.TimeParam, nil
+	case "alias_arr_alias_string_param":
+		return types.Slice(r.AliasArrAliasStringParam), nil
+	case "alias_here_array_param":
+		return types.Array(&r.AliasHereArrayParam, 3), nil
+	case "array_alias_here_string_param":
+		return types.Slice(r.ArrayAliasHereStringParam), nil
+	case "scanner_valuer_param":
+		return r.ScannerValuerParam, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in QueryFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *QueryFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Relation":
+		return new(QueryRelationFixture), nil
+	case "Inverse":
+		return new(QueryRelationFixture), nil
+	case "NRelation":
+		return new(QueryRelationFixture), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model QueryFixture has no relationship %s", field)
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *QueryFixture) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Relation":
+		val, ok := rel.(*QueryRelationFixture)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Relation", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Relation = val
+		}
+
+		return nil
+	case "Inverse":
+		val, ok := rel.(*QueryRelationFixture)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Inverse", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Inverse = val
+		}
+
+		return nil
+	case "NRelation":
+		records, ok := rel.([]kallax.Record)
+		if !ok {
+			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
+		}
+
+		r.NRelation = make([]*QueryRelationFixture, len(records))
+		for i, record := range records {
+			rel, ok := record.(*QueryRelationFixture)
+			if !ok {
+				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
+			}
+			r.NRelation[i] = rel
+		}
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model QueryFixture has no relationship %s", field)
+}
+
+// QueryFixtureStore is the entity to access the records of the type QueryFixture
+// in the database.
+type QueryFixtureStore struct {
+	*kallax.Store
+}
+
+// NewQueryFixtureStore creates a new instance of QueryFixtureStore
+// using a SQL database.
+func NewQueryFixtureStore(db *sql.DB) *QueryFixtureStore {
+	return &QueryFixtureStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *QueryFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *QueryFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *QueryFixtureStore) Debug() *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *QueryFixtureStore) DebugWith(logger kallax.LoggerFunc) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *QueryFixtureStore) DisableCacher() *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *QueryFixtureStore) WithDialect(dialect kallax.Dialect) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+func (s *QueryFixtureStore) relationshipRecords(record *QueryFixture) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	if record.Relation != nil && !record.Relation.IsSaving() {
+		r := record.Relation
+		r.AddVirtualColumn("owner_id", record.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&QueryRelationFixtureStore{store}).SaveContext(ctx, r)
+			return err
+		})
+	}
+
+	for i := range record.NRelation {
+		r := record.NRelation[i]
+		if !r.IsSaving() {
+			r.AddVirtualColumn("owner_id", record.GetID())
+			result = append(result, func(ctx context.Context, store *kallax.Store) error {
+				_, err := (&QueryRelationFixtureStore{store}).SaveContext(ctx, r)
+				return err
+			})
+		}
+	}
+
+	return result
+}
+
+func (s *QueryFixtureStore) inverseRecords(record *QueryFixture) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	if record.Inverse != nil && !record.Inverse.IsSaving() {
+		record.AddVirtualColumn("inverse_id", record.Inverse.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&QueryRelationFixtureStore{store}).SaveContext(ctx, record.Inverse)
+			return err
+		})
+	}
+
+	return result
+}
+
+// Insert inserts a QueryFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *QueryFixtureStore) InsertContext(ctx context.Context, record *QueryFixture) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+
+	records := s.relationshipRecords(record)
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(records) > 0 || len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertContext(ctx, Schema.QueryFixture.BaseSchema, record); err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.QueryFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given QueryFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *QueryFixtureStore) InsertMany(records []*QueryFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *QueryFixtureStore) InsertManyContext(ctx context.Context, records []*QueryFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+		record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 || len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertManyContext(ctx, Schema.QueryFixture.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.QueryFixture.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *QueryFixtureStore) UpdateContext(ctx context.Context, record *QueryFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	records := s.relationshipRecords(record)
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(records) > 0 || len(inverseRecords) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			updated, err = s.UpdateContext(ctx, Schema.QueryFixture.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return updated, nil
+	}
+
+	return s.Store.UpdateContext(ctx, Schema.QueryFixture.BaseSchema, record, cols...)
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *QueryFixtureStore) Save(record *QueryFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *QueryFixtureStore) SaveContext(ctx context.Context, record *QueryFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *QueryFixtureStore) Upsert(record *QueryFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *QueryFixtureStore) UpsertContext(ctx context.Context, record *QueryFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+
+	return s.Store.UpsertContext(ctx, Schema.QueryFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *QueryFixtureStore) Delete(record *QueryFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *QueryFixtureStore) DeleteContext(ctx context.Context, record *QueryFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.QueryFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *QueryFixtureStore) UpdateWhere(q *QueryFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *QueryFixtureStore) UpdateWhereContext(ctx context.Context, q *QueryFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *QueryFixtureStore) UpdateWhereReturning(q *QueryFixtureQuery, values map[kallax.SchemaField]interface{}) (*QueryFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *QueryFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *QueryFixtureQuery, values map[kallax.SchemaField]interface{}) (*QueryFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *QueryFixtureStore) DeleteWhere(q *QueryFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *QueryFixtureStore) DeleteWhereContext(ctx context.Context, q *QueryFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *QueryFixtureStore) DeleteWhereReturning(q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *QueryFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryFixtureResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *QueryFixtureStore) Find(q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *QueryFixtureStore) FindContext(ctx context.Context, q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *QueryFixtureStore) MustFind(q *QueryFixtureQuery) *QueryFixtureResultSet {
+	return NewQueryFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *QueryFixtureStore) MustFindContext(ctx context.Context, q *QueryFixtureQuery) *QueryFixtureResultSet {
+	return NewQueryFixtureResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *QueryFixtureStore) Count(q *QueryFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *QueryFixtureStore) CountContext(ctx context.Context, q *QueryFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *QueryFixtureStore) MustCount(q *QueryFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *QueryFixtureStore) MustCountContext(ctx context.Context, q *QueryFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *QueryFixtureStore) FindOne(q *QueryFixtureQuery) (*QueryFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *QueryFixtureStore) FindOneContext(ctx context.Context, q *QueryFixtureQuery) (*QueryFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *QueryFixtureStore) FindAll(q *QueryFixtureQuery) ([]*QueryFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *QueryFixtureStore) FindAllContext(ctx context.Context, q *QueryFixtureQuery) ([]*QueryFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *QueryFixtureStore) MustFindOne(q *QueryFixtureQuery) *QueryFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *QueryFixtureStore) MustFindOneContext(ctx context.Context, q *QueryFixtureQuery) *QueryFixture {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the QueryFixture with the data in the database and
+// makes it writable.
+func (s *QueryFixtureStore) Reload(record *QueryFixture) error {
+	return s.Store.Reload(Schema.QueryFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *QueryFixtureStore) ReloadContext(ctx context.Context, record *QueryFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.QueryFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryFixtureStore) Transaction(callback func(*QueryFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *QueryFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*QueryFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&QueryFixtureStore{store})
+	})
+}
+
+// RemoveRelation removes from the database the given relationship of the
+// model. It also resets the field Relation of the model.
+func (s *QueryFixtureStore) RemoveRelation(record *QueryFixture) error {
+	return s.RemoveRelationContext(context.Background(), record)
+}
+
+// RemoveRelationContext is the same as RemoveRelation, but the given
+// context is used to run the queries.
+func (s *QueryFixtureStore) RemoveRelationContext(ctx context.Context, record *QueryFixture) error {
+	var r kallax.Record = record.Relation
+	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+		if err := beforeDeleter.BeforeDelete(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, r)
+			if err != nil {
+				return err
+			}
+
+			return afterDeleter.AfterDelete()
+		})
+	} else {
+		err = s.Store.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, r)
+	}
+	if err != nil {
+		return err
+	}
+
+	record.Relation = nil
+	return nil
+}
+
+// RemoveNRelation removes the given items of the NRelation field of the
+// model. If no items are given, it removes all of them.
+// The items will also be removed from the passed record inside this method.
+// Note that is required that `NRelation` is not empty. This method clears the
+// the elements of NRelation in a model, it does not retrieve them to know
+// what relationships the model has.
+func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*QueryRelationFixture) error {
+	return s.RemoveNRelationContext(context.Background(), record, deleted...)
+}
+
+// RemoveNRelationContext is the same as RemoveNRelation, but the given
+// context is used to run the queries.
+func (s *QueryFixtureStore) RemoveNRelationContext(ctx context.Context, record *QueryFixture, deleted ...*QueryRelationFixture) error {
+	var updated []*QueryRelationFixture
+	var clear bool
+	if len(deleted) == 0 {
+		clear = true
+		deleted = record.NRelation
+		if len(deleted) == 0 {
+			return nil
+		}
+	}
+
+	if len(deleted) > 1 {
+		err := s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, d := range deleted {
+				var r kallax.Record = d
+
+				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+					if err := beforeDeleter.BeforeDelete(); err != nil {
+						return err
+					}
+				}
+
+				if err := s.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, d); err != nil {
+					return err
+				}
+
+				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+					if err := afterDeleter.AfterDelete(); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if clear {
+			record.NRelation = nil
+			return nil
+		}
+	} else {
+		var r kallax.Record = deleted[0]
+		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+			if err := beforeDeleter.BeforeDelete(); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+			err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, r)
+				if err != nil {
+					return err
+				}
+
+				return afterDeleter.AfterDelete()
+			})
+		} else {
+			err = s.Store.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, deleted[0])
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, r := range record.NRelation {
+		var found bool
+		for _, d := range deleted {
+			if d.GetID().Equals(r.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, r)
+		}
+	}
+	record.NRelation = updated
+	return nil
+}
+
+// QueryFixtureQuery is the object used to create queries for the QueryFixture
+// entity.
+type QueryFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewQueryFixtureQuery returns a new instance of QueryFixtureQuery.
+func NewQueryFixtureQuery() *QueryFixtureQuery {
+	return &QueryFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.QueryFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *QueryFixtureQuery) Select(columns ...kallax.SchemaField) *QueryFixtureQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *QueryFixtureQuery) SelectNot(columns ...kallax.SchemaField) *QueryFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *QueryFixtureQuery) Copy() *QueryFixtureQuery {
+	return &QueryFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *QueryFixtureQuery) Order(cols ...kallax.ColumnOrder) *QueryFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *QueryFixtureQuery) After(cursor *kallax.Cursor) *QueryFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *QueryFixtureQuery) Before(cursor *kallax.Cursor) *QueryFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *QueryFixtureQuery) BatchSize(size uint64) *QueryFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *QueryFixtureQuery) Limit(n uint64) *QueryFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *QueryFixtureQuery) Offset(n uint64) *QueryFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *QueryFixtureQuery) Where(cond kallax.Condition) *QueryFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *QueryFixtureQuery) GroupBy(cols ...kallax.SchemaField) *QueryFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *QueryFixtureQuery) Having(cond kallax.Condition) *QueryFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *QueryFixtureQuery) ForUpdate() *QueryFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *QueryFixtureQuery) ForShare() *QueryFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *QueryFixtureQuery) NoWait() *QueryFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *QueryFixtureQuery) SkipLocked() *QueryFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *QueryFixtureQuery) WithRelation() *QueryFixtureQuery {
+	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "Relation", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasRelation filters the records whose Relation relationship has any
+// record matching the given condition, which can be nil.
+func (q *QueryFixtureQuery) WhereHasRelation(cond kallax.Condition) *QueryFixtureQuery {
+	q.Where(kallax.Has(Schema.QueryRelationFixture.BaseSchema, "Relation", cond))
+	return q
+}
+
+func (q *QueryFixtureQuery) WithInverse() *QueryFixtureQuery {
+	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "Inverse", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasInverse filters the records whose Inverse relationship has any
+// record matching the given condition, which can be nil.
+func (q *QueryFixtureQuery) WhereHasInverse(cond kallax.Condition) *QueryFixtureQuery {
+	q.Where(kallax.Has(Schema.QueryRelationFixture.BaseSchema, "Inverse", cond))
+	return q
+}
+
+func (q *QueryFixtureQuery) WithNRelation(cond kallax.Condition) *QueryFixtureQuery {
+	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "NRelation", kallax.OneToMany, cond)
+	return q
+}
+
+// WhereHasNRelation filters the records whose NRelation relationship has any
+// record matching the given condition, which can be nil.
+func (q *QueryFixtureQuery) WhereHasNRelation(cond kallax.Condition) *QueryFixtureQuery {
+	q.Where(kallax.Has(Schema.QueryRelationFixture.BaseSchema, "NRelation", cond))
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByID(v ...kallax.ULID) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.QueryFixture.ID, values...))
+}
+
+// FindByInverse adds a new filter to the query that will require that
+// the foreign key of Inverse is equal to the passed value.
+func (q *QueryFixtureQuery) FindByInverse(v kallax.ULID) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.InverseFK, v))
+}
+
+// FindByInline adds a new filter to the query that will require that
+// the Inline property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByInline(v string) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.Inline, v))
+}
+
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByFoo(v string) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.Foo, v))
+}
+
+// FindByStringProperty adds a new filter to the query that will require that
+// the StringProperty property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByStringProperty(v string) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.StringProperty, v))
+}
+
+// FindByInteger adds a new filter to the query that will require that
+// the Integer property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByInteger(cond kallax.ScalarCond, v int) *QueryFixtureQuery {
+	return q.Where(cond(Schema.QueryFixture.Integer, v))
+}
+
+// FindByInteger64 adds a new filter to the query that will require that
+// the Integer64 property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByInteger64(cond kallax.ScalarCond, v int64) *QueryFixtureQuery {
+	return q.Where(cond(Schema.QueryFixture.Integer64, v))
+}
+
+// FindByFloat32 adds a new filter to the query that will require that
+// the Float32 property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByFloat32(cond kallax.ScalarCond, v float32) *QueryFixtureQuery {
+	return q.Where(cond(Schema.QueryFixture.Float32, v))
+}
+
+// FindByBoolean adds a new filter to the query that will require that
+// the Boolean property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByBoolean(v bool) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.Boolean, v))
+}
+
+// FindByArrayParam adds a new filter to the query that will require that
+// the ArrayParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByArrayParam(v ...string) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.ArrayParam, values...))
+}
+
+// FindBySliceParam adds a new filter to the query that will require that
+// the SliceParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindBySliceParam(v ...string) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.SliceParam, values...))
+}
+
+// FindByAliasArrayParam adds a new filter to the query that will require that
+// the AliasArrayParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByAliasArrayParam(v ...string) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasArrayParam, values...))
+}
+
+// FindByAliasSliceParam adds a new filter to the query that will require that
+// the AliasSliceParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByAliasSliceParam(v ...string) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasSliceParam, values...))
+}
+
+// FindByAliasStringParam adds a new filter to the query that will require that
+// the AliasStringParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByAliasStringParam(v fixtures.AliasString) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.AliasStringParam, v))
+}
+
+// FindByAliasIntParam adds a new filter to the query that will require that
+// the AliasIntParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByAliasIntParam(cond kallax.ScalarCond, v fixtures.AliasInt) *QueryFixtureQuery {
+	return q.Where(cond(Schema.QueryFixture.AliasIntParam, v))
+}
+
+// FindByIDPropertyParam adds a new filter to the query that will require that
+// the IDPropertyParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByIDPropertyParam(v kallax.ULID) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.IDPropertyParam, v))
+}
+
+// FindByInterfacePropParam adds a new filter to the query that will require that
+// the InterfacePropParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByInterfacePropParam(v fixtures.InterfaceImplementation) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.InterfacePropParam, v))
+}
+
+// FindByURLParam adds a new filter to the query that will require that
+// the URLParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByURLParam(v url.URL) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.URLParam, v))
+}
+
+// FindByTimeParam adds a new filter to the query that will require that
+// the TimeParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByTimeParam(cond kallax.ScalarCond, v time.Time) *QueryFixtureQuery {
+	return q.Where(cond(Schema.QueryFixture.TimeParam, v))
+}
+
+// FindByAliasArrAliasStringParam adds a new filter to the query that will require that
+// the AliasArrAliasStringParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByAliasArrAliasStringParam(v ...fixtures.AliasString) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasArrAliasStringParam, values...))
+}
+
+// FindByAliasHereArrayParam adds a new filter to the query that will require that
+// the AliasHereArrayParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByAliasHereArrayParam(v ...string) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasHereArrayParam, values...))
+}
+
+// FindByArrayAliasHereStringParam adds a new filter to the query that will require that
+// the ArrayAliasHereStringParam property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryFixtureQuery) FindByArrayAliasHereStringParam(v ...AliasHereString) *QueryFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.QueryFixture.ArrayAliasHereStringParam, values...))
+}
+
+// FindByScannerValuerParam adds a new filter to the query that will require that
+// the ScannerValuerParam property is equal to the passed value.
+func (q *QueryFixtureQuery) FindByScannerValuerParam(v ScannerValuer) *QueryFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryFixture.ScannerValuerParam, v))
+}
+
+// QueryFixtureResultSet is the set of results returned by a query to the
+// database.
+type QueryFixtureResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *QueryFixture
+	lastErr   error
+}
+
+// NewQueryFixtureResultSet creates a new result set for rows of the type
+// QueryFixture.
+func NewQueryFixtureResultSet(rs kallax.ResultSet) *QueryFixtureResultSet {
+	return &QueryFixtureResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *QueryFixtureResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.QueryFixture.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*QueryFixture)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *QueryFixture")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *QueryFixtureResultSet) Get() (*QueryFixture, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *QueryFixtureResultSet) ForEach(fn func(*QueryFixture) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *QueryFixtureResultSet) All() ([]*QueryFixture, error) {
+	var result []*QueryFixture
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *QueryFixtureResultSet) One() (*QueryFixture, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *QueryFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *QueryFixtureResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *QueryFixtureResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewQueryRelationFixture returns a new instance of QueryRelationFixture.
+func NewQueryRelationFixture() (record *QueryRelationFixture) {
+	return new(QueryRelationFixture)
+}
+
+// GetID returns the primary key of the model.
+func (r *QueryRelationFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *QueryRelationFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "name":
+		return &r.Name, nil
+	case "owner_id":
+		return types.Nullable(kallax.VirtualColumn("owner_id", r, new(kallax.ULID))), nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in QueryRelationFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *QueryRelationFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "name":
+		return r.Name, nil
+	case "owner_id":
+		v := r.Model.VirtualColumn(col)
+		if v == nil {
+			return nil, kallax.ErrEmptyVirtualColumn
+		}
+		return v, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in QueryRelationFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *QueryRelationFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Owner":
+		return new(QueryFixture), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model QueryRelationFixture has no relationship %s", field)
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *QueryRelationFixture) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Owner":
+		val, ok := rel.(*QueryFixture)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Owner", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Owner = val
+		}
+
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model QueryRelationFixture has no relationship %s", field)
+}
+
+// QueryRelationFixtureStore is the entity to access the records of the type QueryRelationFixture
+// in the database.
+type QueryRelationFixtureStore struct {
+	*kallax.Store
+}
+
+// NewQueryRelationFixtureStore creates a new instance of QueryRelationFixtureStore
+// using a SQL database.
+func NewQueryRelationFixtureStore(db *sql.DB) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *QueryRelationFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *QueryRelationFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *QueryRelationFixtureStore) Debug() *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *QueryRelationFixtureStore) DebugWith(logger kallax.LoggerFunc) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *QueryRelationFixtureStore) DisableCacher() *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *QueryRelationFixtureStore) WithDialect(dialect kallax.Dialect) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+func (s *QueryRelationFixtureStore) inverseRecords(record *QueryRelationFixture) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	if record.Owner != nil && !record.Owner.IsSaving() {
+		record.AddVirtualColumn("owner_id", record.Owner.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&QueryFixtureStore{store}).SaveContext(ctx, record.Owner)
+			return err
+		})
+	}
+
+	return result
+}
+
+// Insert inserts a QueryRelationFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *QueryRelationFixtureStore) InsertContext(ctx context.Context, record *QueryRelationFixture) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertContext(ctx, Schema.QueryRelationFixture.BaseSchema, record); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.QueryRelationFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given QueryRelationFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *QueryRelationFixtureStore) InsertMany(records []*QueryRelationFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *QueryRelationFixtureStore) InsertManyContext(ctx context.Context, records []*QueryRelationFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	if len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertManyContext(ctx, Schema.QueryRelationFixture.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.QueryRelationFixture.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *QueryRelationFixtureStore) Update(record *QueryRelationFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *QueryRelationFixtureStore) UpdateContext(ctx context.Context, record *QueryRelationFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(inverseRecords) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			updated, err = s.UpdateContext(ctx, Schema.QueryRelationFixture.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return updated, nil
+	}
+
+	return s.Store.UpdateContext(ctx, Schema.QueryRelationFixture.BaseSchema, record, cols...)
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *QueryRelationFixtureStore) Save(record *QueryRelationFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *QueryRelationFixtureStore) SaveContext(ctx context.Context, record *QueryRelationFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *QueryRelationFixtureStore) Upsert(record *QueryRelationFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *QueryRelationFixtureStore) UpsertContext(ctx context.Context, record *QueryRelationFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.QueryRelationFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *QueryRelationFixtureStore) Delete(record *QueryRelationFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *QueryRelationFixtureStore) DeleteContext(ctx context.Context, record *QueryRelationFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *QueryRelationFixtureStore) UpdateWhere(q *QueryRelationFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *QueryRelationFixtureStore) UpdateWhereContext(ctx context.Context, q *QueryRelationFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *QueryRelationFixtureStore) UpdateWhereReturning(q *QueryRelationFixtureQuery, values map[kallax.SchemaField]interface{}) (*QueryRelationFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *QueryRelationFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *QueryRelationFixtureQuery, values map[kallax.SchemaField]interface{}) (*QueryRelationFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryRelationFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *QueryRelationFixtureStore) DeleteWhere(q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *QueryRelationFixtureStore) DeleteWhereContext(ctx context.Context, q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *QueryRelationFixtureStore) DeleteWhereReturning(q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *QueryRelationFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryRelationFixtureResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *QueryRelationFixtureStore) Find(q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *QueryRelationFixtureStore) FindContext(ctx context.Context, q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryRelationFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *QueryRelationFixtureStore) MustFind(q *QueryRelationFixtureQuery) *QueryRelationFixtureResultSet {
+	return NewQueryRelationFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *QueryRelationFixtureStore) MustFindContext(ctx context.Context, q *QueryRelationFixtureQuery) *QueryRelationFixtureResultSet {
+	return NewQueryRelationFixtureResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *QueryRelationFixtureStore) Count(q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *QueryRelationFixtureStore) CountContext(ctx context.Context, q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *QueryRelationFixtureStore) MustCount(q *QueryRelationFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *QueryRelationFixtureStore) MustCountContext(ctx context.Context, q *QueryRelationFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *QueryRelationFixtureStore) FindOne(q *QueryRelationFixtureQuery) (*QueryRelationFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *QueryRelationFixtureStore) FindOneContext(ctx context.Context, q *QueryRelationFixtureQuery) (*QueryRelationFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *QueryRelationFixtureStore) FindAll(q *QueryRelationFixtureQuery) ([]*QueryRelationFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *QueryRelationFixtureStore) FindAllContext(ctx context.Context, q *QueryRelationFixtureQuery) ([]*QueryRelationFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *QueryRelationFixtureStore) MustFindOne(q *QueryRelationFixtureQuery) *QueryRelationFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *QueryRelationFixtureStore) MustFindOneContext(ctx context.Context, q *QueryRelationFixtureQuery) *QueryRelationFixture {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the QueryRelationFixture with the data in the database and
+// makes it writable.
+func (s *QueryRelationFixtureStore) Reload(record *QueryRelationFixture) error {
+	return s.Store.Reload(Schema.QueryRelationFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *QueryRelationFixtureStore) ReloadContext(ctx context.Context, record *QueryRelationFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.QueryRelationFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryRelationFixtureStore) Transaction(callback func(*QueryRelationFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *QueryRelationFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*QueryRelationFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&QueryRelationFixtureStore{store})
+	})
+}
+
+// QueryRelationFixtureQuery is the object used to create queries for the QueryRelationFixture
+// entity.
+type QueryRelationFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewQueryRelationFixtureQuery returns a new instance of QueryRelationFixtureQuery.
+func NewQueryRelationFixtureQuery() *QueryRelationFixtureQuery {
+	return &QueryRelationFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.QueryRelationFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *QueryRelationFixtureQuery) Select(columns ...kallax.SchemaField) *QueryRelationFixtureQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *QueryRelationFixtureQuery) SelectNot(columns ...kallax.SchemaField) *QueryRelationFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *QueryRelationFixtureQuery) Copy() *QueryRelationFixtureQuery {
+	return &QueryRelationFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *QueryRelationFixtureQuery) Order(cols ...kallax.ColumnOrder) *QueryRelationFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *QueryRelationFixtureQuery) After(cursor *kallax.Cursor) *QueryRelationFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *QueryRelationFixtureQuery) Before(cursor *kallax.Cursor) *QueryRelationFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *QueryRelationFixtureQuery) BatchSize(size uint64) *QueryRelationFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *QueryRelationFixtureQuery) Limit(n uint64) *QueryRelationFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *QueryRelationFixtureQuery) Offset(n uint64) *QueryRelationFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *QueryRelationFixtureQuery) Where(cond kallax.Condition) *QueryRelationFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *QueryRelationFixtureQuery) GroupBy(cols ...kallax.SchemaField) *QueryRelationFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *QueryRelationFixtureQuery) Having(cond kallax.Condition) *QueryRelationFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *QueryRelationFixtureQuery) ForUpdate() *QueryRelationFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *QueryRelationFixtureQuery) ForShare() *QueryRelationFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *QueryRelationFixtureQuery) NoWait() *QueryRelationFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *QueryRelationFixtureQuery) SkipLocked() *QueryRelationFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *QueryRelationFixtureQuery) WithOwner() *QueryRelationFixtureQuery {
+	q.AddRelation(Schema.QueryFixture.BaseSchema, "Owner", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasOwner filters the records whose Owner relationship has any
+// record matching the given condition, which can be nil.
+func (q *QueryRelationFixtureQuery) WhereHasOwner(cond kallax.Condition) *QueryRelationFixtureQuery {
+	q.Where(kallax.Has(Schema.QueryFixture.BaseSchema, "Owner", cond))
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *QueryRelationFixtureQuery) FindByID(v ...kallax.ULID) *QueryRelationFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.QueryRelationFixture.ID, values...))
+}
+
+// FindByName adds a new filter to the query that will require that
+// the Name property is equal to the passed value.
+func (q *QueryRelationFixtureQuery) FindByName(v string) *QueryRelationFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryRelationFixture.Name, v))
+}
+
+// FindByOwner adds a new filter to the query that will require that
+// the foreign key of Owner is equal to the passed value.
+func (q *QueryRelationFixtureQuery) FindByOwner(v kallax.ULID) *QueryRelationFixtureQuery {
+	return q.Where(kallax.Eq(Schema.QueryRelationFixture.OwnerFK, v))
+}
+
+// QueryRelationFixtureResultSet is the set of results returned by a query to the
+// database.
+type QueryRelationFixtureResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *QueryRelationFixture
+	lastErr   error
+}
+
+// NewQueryRelationFixtureResultSet creates a new result set for rows of the type
+// QueryRelationFixture.
+func NewQueryRelationFixtureResultSet(rs kallax.ResultSet) *QueryRelationFixtureResultSet {
+	return &QueryRelationFixtureResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *QueryRelationFixtureResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.QueryRelationFixture.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*QueryRelationFixture)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *QueryRelationFixture")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *QueryRelationFixtureResultSet) Get() (*QueryRelationFixture, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *QueryRelationFixtureResultSet) ForEach(fn func(*QueryRelationFixture) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *QueryRelationFixtureResultSet) All() ([]*QueryRelationFixture, error) {
+	var result []*QueryRelationFixture
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *QueryRelationFixtureResultSet) One() (*QueryRelationFixture, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *QueryRelationFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *QueryRelationFixtureResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *QueryRelationFixtureResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewResultSetFixture returns a new instance of ResultSetFixture.
+func NewResultSetFixture(f string) (record *ResultSetFixture) {
+	return newResultSetFixture(f)
+}
+
+// GetID returns the primary key of the model.
+func (r *ResultSetFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *ResultSetFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "foo":
+		return &r.Foo, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in ResultSetFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *ResultSetFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "foo":
+		return r.Foo, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in ResultSetFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *ResultSetFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model ResultSetFixture has no relationships")
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *ResultSetFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model ResultSetFixture has no relationships")
+}
+
+// ResultSetFixtureStore is the entity to access the records of the type ResultSetFixture
+// in the database.
+type ResultSetFixtureStore struct {
+	*kallax.Store
+}
+
+// NewResultSetFixtureStore creates a new instance of ResultSetFixtureStore
+// using a SQL database.
+func NewResultSetFixtureStore(db *sql.DB) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *ResultSetFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *ResultSetFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *ResultSetFixtureStore) Debug() *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *ResultSetFixtureStore) DebugWith(logger kallax.LoggerFunc) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *ResultSetFixtureStore) DisableCacher() *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *ResultSetFixtureStore) WithDialect(dialect kallax.Dialect) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a ResultSetFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *ResultSetFixtureStore) Insert(record *ResultSetFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *ResultSetFixtureStore) InsertContext(ctx context.Context, record *ResultSetFixture) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.InsertContext(ctx, Schema.ResultSetFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given ResultSetFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *ResultSetFixtureStore) InsertMany(records []*ResultSetFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *ResultSetFixtureStore) InsertManyContext(ctx context.Context, records []*ResultSetFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.ResultSetFixture.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *ResultSetFixtureStore) Update(record *ResultSetFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *ResultSetFixtureStore) UpdateContext(ctx context.Context, record *ResultSetFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpdateContext(ctx, Schema.ResultSetFixture.BaseSchema, record, cols...)
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *ResultSetFixtureStore) Save(record *ResultSetFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *ResultSetFixtureStore) SaveContext(ctx context.Context, record *ResultSetFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *ResultSetFixtureStore) Upsert(record *ResultSetFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *ResultSetFixtureStore) UpsertContext(ctx context.Context, record *ResultSetFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.ResultSetFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *ResultSetFixtureStore) Delete(record *ResultSetFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *ResultSetFixtureStore) DeleteContext(ctx context.Context, record *ResultSetFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.ResultSetFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *ResultSetFixtureStore) UpdateWhere(q *ResultSetFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *ResultSetFixtureStore) UpdateWhereContext(ctx context.Context, q *ResultSetFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *ResultSetFixtureStore) UpdateWhereReturning(q *ResultSetFixtureQuery, values map[kallax.SchemaField]interface{}) (*ResultSetFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *ResultSetFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *ResultSetFixtureQuery, values map[kallax.SchemaField]interface{}) (*ResultSetFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResultSetFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *ResultSetFixtureStore) DeleteWhere(q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *ResultSetFixtureStore) DeleteWhereContext(ctx context.Context, q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *ResultSetFixtureStore) DeleteWhereReturning(q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *ResultSetFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResultSetFixtureResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *ResultSetFixtureStore) Find(q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *ResultSetFixtureStore) FindContext(ctx context.Context, q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResultSetFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *ResultSetFixtureStore) MustFind(q *ResultSetFixtureQuery) *ResultSetFixtureResultSet {
+	return NewResultSetFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *ResultSetFixtureStore) MustFindContext(ctx context.Context, q *ResultSetFixtureQuery) *ResultSetFixtureResultSet {
+	return NewResultSetFixtureResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *ResultSetFixtureStore) Count(q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *ResultSetFixtureStore) CountContext(ctx context.Context, q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *ResultSetFixtureStore) MustCount(q *ResultSetFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *ResultSetFixtureStore) MustCountContext(ctx context.Context, q *ResultSetFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *ResultSetFixtureStore) FindOne(q *ResultSetFixtureQuery) (*ResultSetFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *ResultSetFixtureStore) FindOneContext(ctx context.Context, q *ResultSetFixtureQuery) (*ResultSetFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *ResultSetFixtureStore) FindAll(q *ResultSetFixtureQuery) ([]*ResultSetFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *ResultSetFixtureStore) FindAllContext(ctx context.Context, q *ResultSetFixtureQuery) ([]*ResultSetFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *ResultSetFixtureStore) MustFindOne(q *ResultSetFixtureQuery) *ResultSetFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *ResultSetFixtureStore) MustFindOneContext(ctx context.Context, q *ResultSetFixtureQuery) *ResultSetFixture {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the ResultSetFixture with the data in the database and
+// makes it writable.
+func (s *ResultSetFixtureStore) Reload(record *ResultSetFixture) error {
+	return s.Store.Reload(Schema.ResultSetFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *ResultSetFixtureStore) ReloadContext(ctx context.Context, record *ResultSetFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.ResultSetFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *ResultSetFixtureStore) Transaction(callback func(*ResultSetFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *ResultSetFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*ResultSetFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&ResultSetFixtureStore{store})
+	})
+}
+
+// ResultSetFixtureQuery is the object used to create queries for the ResultSetFixture
+// entity.
+type ResultSetFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewResultSetFixtureQuery returns a new instance of ResultSetFixtureQuery.
+func NewResultSetFixtureQuery() *ResultSetFixtureQuery {
+	return &ResultSetFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.ResultSetFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *ResultSetFixtureQuery) Select(columns ...kallax.SchemaField) *ResultSetFixtureQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *ResultSetFixtureQuery) SelectNot(columns ...kallax.SchemaField) *ResultSetFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *ResultSetFixtureQuery) Copy() *ResultSetFixtureQuery {
+	return &ResultSetFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *ResultSetFixtureQuery) Order(cols ...kallax.ColumnOrder) *ResultSetFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *ResultSetFixtureQuery) After(cursor *kallax.Cursor) *ResultSetFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *ResultSetFixtureQuery) Before(cursor *kallax.Cursor) *ResultSetFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *ResultSetFixtureQuery) BatchSize(size uint64) *ResultSetFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *ResultSetFixtureQuery) Limit(n uint64) *ResultSetFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *ResultSetFixtureQuery) Offset(n uint64) *ResultSetFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *ResultSetFixtureQuery) Where(cond kallax.Condition) *ResultSetFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *ResultSetFixtureQuery) GroupBy(cols ...kallax.SchemaField) *ResultSetFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *ResultSetFixtureQuery) Having(cond kallax.Condition) *ResultSetFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *ResultSetFixtureQuery) ForUpdate() *ResultSetFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *ResultSetFixtureQuery) ForShare() *ResultSetFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *ResultSetFixtureQuery) NoWait() *ResultSetFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *ResultSetFixtureQuery) SkipLocked() *ResultSetFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *ResultSetFixtureQuery) FindByID(v ...kallax.ULID) *ResultSetFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.SliceParam, values...))
+	return q.Where(kallax.In(Schema.ResultSetFixture.ID, values...))
+}
+
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *ResultSetFixtureQuery) FindByFoo(v string) *ResultSetFixtureQuery {
+	return q.Where(kallax.Eq(Schema.ResultSetFixture.Foo, v))
+}
+
+// ResultSetFixtureResultSet is the set of results returned by a query to the
+// database.
+type ResultSetFixtureResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *ResultSetFixture
+	lastErr   error
+}
+
+// NewResultSetFixtureResultSet creates a new result set for rows of the type
+// ResultSetFixture.
+func NewResultSetFixtureResultSet(rs kallax.ResultSet) *ResultSetFixtureResultSet {
+	return &ResultSetFixtureResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *ResultSetFixtureResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.ResultSetFixture.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*ResultSetFixture)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *ResultSetFixture")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *ResultSetFixtureResultSet) Get() (*ResultSetFixture, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *ResultSetFixtureResultSet) ForEach(fn func(*ResultSetFixture) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *ResultSetFixtureResultSet) All() ([]*ResultSetFixture, error) {
+	var result []*ResultSetFixture
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *ResultSetFixtureResultSet) One() (*ResultSetFixture, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *ResultSetFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
+// Err returns the last error occurred.
+func (rs *ResultSetFixtureResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Close closes the result set.
+func (rs *ResultSetFixtureResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewSchemaFixture returns a new instance of SchemaFixture.
+func NewSchemaFixture() (record *SchemaFixture) {
+	return newSchemaFixture()
+}
+
+// GetID returns the primary key of the model.
+func (r *SchemaFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *SchemaFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "string":
+		return &r.String, nil
+	case "int":
+		return &r.Int, nil
+	case "inline":
+		return &r.Inline.Inline, nil
+	case "map_of_string":
+		return types.JSON(&r.MapOfString), nil
+	case "map_of_interface":
+		return types.JSON(&r.MapOfInterface), nil
+	case "map_of_some_type":
+		return types.JSON(&r.MapOfSomeType), nil
+	case "rel_id":
+		return types.Nullable(kallax.VirtualColumn("rel_id", r, new(kallax.ULID))), nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in SchemaFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *SchemaFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "string":
+		return r.String, nil
+	case "int":
+		return r.Int, nil
+	case "inline":
+		return r.Inline.Inline, nil
+	case "map_of_string":
+		return types.JSON(r.MapOfString), nil
+	case "map_of_interface":
+		return types.JSON(r.MapOfInterface), nil
+	case "map_of_some_type":
+		return types.JSON(r.MapOfSomeType), nil
+	case "rel_id":
+		v := r.Model.VirtualColumn(col)
+		if v == nil {
+			return nil, kallax.ErrEmptyVirtualColumn
+		}
+		return v, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in SchemaFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *SchemaFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Nested":
+		return new(SchemaFixture), nil
+	case "Inverse":
+		return new(SchemaRelationshipFixture), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model SchemaFixture has no relationship %s", field)
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *SchemaFixture) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Nested":
+		val, ok := rel.(*SchemaFixture)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Nested", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Nested = val
+		}
+
+		return nil
+	case "Inverse":
+		val, ok := rel.(*SchemaRelationshipFixture)
+		if !ok {
+			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Inverse", rel)
+		}
+		if !val.GetID().IsEmpty() {
+			r.Inverse = val
+		}
+
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model SchemaFixture has no relationship %s", field)
+}
+
+// SchemaFixtureStore is the entity to access the records of the type SchemaFixture
+// in the database.
+type SchemaFixtureStore struct {
+	*kallax.Store
+}
+
+// NewSchemaFixtureStore creates a new instance of SchemaFixtureStore
+// using a SQL database.
+func NewSchemaFixtureStore(db *sql.DB) *SchemaFixtureStore {
+	return &SchemaFixtureStore{kallax.NewStore(db)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *SchemaFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *SchemaFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *SchemaFixtureStore) Debug() *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *SchemaFixtureStore) DebugWith(logger kallax.LoggerFunc) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// DisableCacher turns off prepared statements, which can be useful in some scenarios.
+func (s *SchemaFixtureStore) DisableCacher() *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.DisableCacher()}
+}
+
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *SchemaFixtureStore) WithDialect(dialect kallax.Dialect) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+func (s *SchemaFixtureStore) relationshipRecords(record *SchemaFixture) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	if record.Nested != nil && !record.Nested.IsSaving() {
+		r := record.Nested
+		r.AddVirtualColumn("schema_fixture_id", record.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&SchemaFixtureStore{store}).SaveContext(ctx, r)
+			return err
+		})
+	}
+
+	return result
+}
+
+func (s *SchemaFixtureStore) inverseRecords(record *SchemaFixture) []modelSaveFunc {
+	var result []modelSaveFunc
+
+	if record.Inverse != nil && !record.Inverse.IsSaving() {
+		record.AddVirtualColumn("rel_id", record.Inverse.GetID())
+		result = append(result, func(ctx context.Context, store *kallax.Store) error {
+			_, err := (&SchemaRelationshipFixtureStore{store}).SaveContext(ctx, record.Inverse)
+			return err
+		})
+	}
+
+	return result
+}
+
+// Insert inserts a SchemaFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *SchemaFixtureStore) InsertContext(ctx context.Context, record *SchemaFixture) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	records := s.relationshipRecords(record)
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(records) > 0 || len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertContext(ctx, Schema.SchemaFixture.BaseSchema, record); err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.SchemaFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given SchemaFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *SchemaFixtureStore) InsertMany(records []*SchemaFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *SchemaFixtureStore) InsertManyContext(ctx context.Context, records []*SchemaFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	var inverseRecords []modelSaveFunc
+	for _, record := range records {
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+	}
+
+	var relRecords []modelSaveFunc
+	for _, record := range records {
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+	}
+
+	if len(relRecords) > 0 || len(inverseRecords) > 0 {
+		return s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			if err := s.InsertManyContext(ctx, Schema.SchemaFixture.BaseSchema, recs...); err != nil {
+				return err
+			}
+
+			for _, r := range relRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.SchemaFixture.BaseSchema, recs...)
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *SchemaFixtureStore) UpdateContext(ctx context.Context, record *SchemaFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	records := s.relationshipRecords(record)
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(records) > 0 || len(inverseRecords) > 0 {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			for _, r := range inverseRecords {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			updated, err = s.UpdateContext(ctx, Schema.SchemaFixture.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := r(ctx, s); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return updated, nil
+	}
+
+	return s.Store.UpdateContext(ctx, Schema.SchemaFixture.BaseSchema, record, cols...)
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *SchemaFixtureStore) Save(record *SchemaFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *SchemaFixtureStore) SaveContext(ctx context.Context, record *SchemaFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *SchemaFixtureStore) Upsert(record *SchemaFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *SchemaFixtureStore) UpsertContext(ctx context.Context, record *SchemaFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.SchemaFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
+// Delete removes the given record from the database.
+func (s *SchemaFixtureStore) Delete(record *SchemaFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *SchemaFixtureStore) DeleteContext(ctx context.Context, record *SchemaFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.SchemaFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *SchemaFixtureStore) UpdateWhere(q *SchemaFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *SchemaFixtureStore) UpdateWhereContext(ctx context.Context, q *SchemaFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *SchemaFixtureStore) UpdateWhereReturning(q *SchemaFixtureQuery, values map[kallax.SchemaField]interface{}) (*SchemaFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *SchemaFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *SchemaFixtureQuery, values map[kallax.SchemaField]interface{}) (*SchemaFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchemaFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *SchemaFixtureStore) DeleteWhere(q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *SchemaFixtureStore) DeleteWhereContext(ctx context.Context, q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *SchemaFixtureStore) DeleteWhereReturning(q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *SchemaFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchemaFixtureResultSet(rs), nil
+}
+
+// Find returns the set of results for the given query.
+func (s *SchemaFixtureStore) Find(q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *SchemaFixtureStore) FindContext(ctx context.Context, q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchemaFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *SchemaFixtureStore) MustFind(q *SchemaFixtureQuery) *SchemaFixtureResultSet {
+	return NewSchemaFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *SchemaFixtureStore) MustFindContext(ctx context.Context, q *SchemaFixtureQuery) *SchemaFixtureResultSet {
+	return NewSchemaFixtureResultSet(s.Store.MustFindContext(ctx, q))
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *SchemaFixtureStore) Count(q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *SchemaFixtureStore) CountContext(ctx context.Context, q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *SchemaFixtureStore) MustCount(q *SchemaFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *SchemaFixtureStore) MustCountContext(ctx context.Context, q *SchemaFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *SchemaFixtureStore) FindOne(q *SchemaFixtureQuery) (*SchemaFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *SchemaFixtureStore) FindOneContext(ctx context.Context, q *SchemaFixtureQuery) (*SchemaFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *SchemaFixtureStore) FindAll(q *SchemaFixtureQuery) ([]*SchemaFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *SchemaFixtureStore) FindAllContext(ctx context.Context, q *SchemaFixtureQuery) ([]*SchemaFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *SchemaFixtureStore) MustFindOne(q *SchemaFixtureQuery) *SchemaFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *SchemaFixtureStore) MustFindOneContext(ctx context.Context, q *SchemaFixtureQuery) *SchemaFixture {
+	record, err := s.FindOneContext(ctx, q)
+	if err != nil {
+		panic(err)
+	}
+	return record
 }
 
-// FindByAliasArrayParam adds a new filter to the query that will require that
-// the AliasArrayParam property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *QueryFixtureQuery) FindByAliasArrayParam(v ...string) *QueryFixtureQuery {
-	if len(v) == 0 {
-		return q
+// Reload refreshes the SchemaFixture with the data in the database and
+// makes it writable.
+func (s *SchemaFixtureStore) Reload(record *SchemaFixture) error {
+	return s.Store.Reload(Schema.SchemaFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *SchemaFixtureStore) ReloadContext(ctx context.Context, record *SchemaFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SchemaFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaFixtureStore) Transaction(callback func(*SchemaFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *SchemaFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*SchemaFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
 	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
+
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&SchemaFixtureStore{store})
+	})
+}
+
+// RemoveNested removes from the database the given relationship of the
+// model. It also resets the field Nested of the model.
+func (s *SchemaFixtureStore) RemoveNested(record *SchemaFixture) error {
+	return s.RemoveNestedContext(context.Background(), record)
+}
+
+// RemoveNestedContext is the same as RemoveNested, but the given
+// context is used to run the queries.
+func (s *SchemaFixtureStore) RemoveNestedContext(ctx context.Context, record *SchemaFixture) error {
+	var r kallax.Record = record.Nested
+	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+		if err := beforeDeleter.BeforeDelete(); err != nil {
+			return err
+		}
 	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasArrayParam, values...))
+
+	var err error
+	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+		err = s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.SchemaFixture.BaseSchema, r)
+			if err != nil {
+				return err
+			}
+
+			return afterDeleter.AfterDelete()
+		})
+	} else {
+		err = s.Store.DeleteContext(ctx, Schema.SchemaFixture.BaseSchema, r)
+	}
+	if err != nil {
+		return err
+	}
+
+	record.Nested = nil
+	return nil
 }
 
-// FindByAliasSliceParam adds a new filter to the query that will require that
-// the AliasSliceParam property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *QueryFixtureQuery) FindByAliasSliceParam(v ...string) *QueryFixtureQuery {
-	if len(v) == 0 {
+// SchemaFixtureQuery is the object used to create queries for the SchemaFixture
+// entity.
+type SchemaFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewSchemaFixtureQuery returns a new instance of SchemaFixtureQuery.
+func NewSchemaFixtureQuery() *SchemaFixtureQuery {
+	return &SchemaFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.SchemaFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *SchemaFixtureQuery) Select(columns ...kallax.SchemaField) *SchemaFixtureQuery {
+	if len(columns) == 0 {
 		return q
 	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *SchemaFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SchemaFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *SchemaFixtureQuery) Copy() *SchemaFixtureQuery {
+	return &SchemaFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
 	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasSliceParam, values...))
 }
 
-// FindByAliasStringParam adds a new filter to the query that will require that
-// the AliasStringParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByAliasStringParam(v fixtures.AliasString) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.AliasStringParam, v))
+// Order adds order clauses to the query for the given columns.
+func (q *SchemaFixtureQuery) Order(cols ...kallax.ColumnOrder) *SchemaFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
 }
 
-// FindByAliasIntParam adds a new filter to the query that will require that
-// the AliasIntParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByAliasIntParam(cond kallax.ScalarCond, v fixtures.AliasInt) *QueryFixtureQuery {
-	return q.Where(cond(Schema.QueryFixture.AliasIntParam, v))
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *SchemaFixtureQuery) After(cursor *kallax.Cursor) *SchemaFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
 }
 
-// FindByIDPropertyParam adds a new filter to the query that will require that
-// the IDPropertyParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByIDPropertyParam(v kallax.ULID) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.IDPropertyParam, v))
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *SchemaFixtureQuery) Before(cursor *kallax.Cursor) *SchemaFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
 }
 
-// FindByInterfacePropParam adds a new filter to the query that will require that
-// the InterfacePropParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByInterfacePropParam(v fixtures.InterfaceImplementation) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.InterfacePropParam, v))
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *SchemaFixtureQuery) BatchSize(size uint64) *SchemaFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
 }
 
-// FindByURLParam adds a new filter to the query that will require that
-// the URLParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByURLParam(v url.URL) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.URLParam, v))
+// Limit sets the max number of items to retrieve.
+func (q *SchemaFixtureQuery) Limit(n uint64) *SchemaFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
 }
 
-// FindByTimeParam adds a new filter to the query that will require that
-// the TimeParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByTimeParam(cond kallax.ScalarCond, v time.Time) *QueryFixtureQuery {
-	return q.Where(cond(Schema.QueryFixture.TimeParam, v))
+// Offset sets the number of items to skip from the result set of items.
+func (q *SchemaFixtureQuery) Offset(n uint64) *SchemaFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
 }
 
-// FindByAliasArrAliasStringParam adds a new filter to the query that will require that
-// the AliasArrAliasStringParam property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *QueryFixtureQuery) FindByAliasArrAliasStringParam(v ...fixtures.AliasString) *QueryFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasArrAliasStringParam, values...))
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *SchemaFixtureQuery) Where(cond kallax.Condition) *SchemaFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
 }
 
-// FindByAliasHereArrayParam adds a new filter to the query that will require that
-// the AliasHereArrayParam property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *QueryFixtureQuery) FindByAliasHereArrayParam(v ...string) *QueryFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.AliasHereArrayParam, values...))
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *SchemaFixtureQuery) GroupBy(cols ...kallax.SchemaField) *SchemaFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
 }
 
-// FindByArrayAliasHereStringParam adds a new filter to the query that will require that
-// the ArrayAliasHereStringParam property contains all the passed values; if no passed values,
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *SchemaFixtureQuery) Having(cond kallax.Condition) *SchemaFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *SchemaFixtureQuery) ForUpdate() *SchemaFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *SchemaFixtureQuery) ForShare() *SchemaFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *SchemaFixtureQuery) NoWait() *SchemaFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *SchemaFixtureQuery) SkipLocked() *SchemaFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *SchemaFixtureQuery) WithNested() *SchemaFixtureQuery {
+	q.AddRelation(Schema.SchemaFixture.BaseSchema, "Nested", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasNested filters the records whose Nested relationship has any
+// record matching the given condition, which can be nil.
+func (q *SchemaFixtureQuery) WhereHasNested(cond kallax.Condition) *SchemaFixtureQuery {
+	q.Where(kallax.Has(Schema.SchemaFixture.BaseSchema, "Nested", cond))
+	return q
+}
+
+func (q *SchemaFixtureQuery) WithInverse() *SchemaFixtureQuery {
+	q.AddRelation(Schema.SchemaRelationshipFixture.BaseSchema, "Inverse", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereHasInverse filters the records whose Inverse relationship has any
+// record matching the given condition, which can be nil.
+func (q *SchemaFixtureQuery) WhereHasInverse(cond kallax.Condition) *SchemaFixtureQuery {
+	q.Where(kallax.Has(Schema.SchemaRelationshipFixture.BaseSchema, "Inverse", cond))
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *QueryFixtureQuery) FindByArrayAliasHereStringParam(v ...AliasHereString) *QueryFixtureQuery {
+func (q *SchemaFixtureQuery) FindByID(v ...kallax.ULID) *SchemaFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -9168,33 +18962,51 @@ func (q *QueryFixtureQuery) FindByArrayAliasHereStringParam(v ...AliasHereString
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.ArrayContains(Schema.QueryFixture.ArrayAliasHereStringParam, values...))
+	return q.Where(kallax.In(Schema.SchemaFixture.ID, values...))
 }
 
-// FindByScannerValuerParam adds a new filter to the query that will require that
-// the ScannerValuerParam property is equal to the passed value.
-func (q *QueryFixtureQuery) FindByScannerValuerParam(v ScannerValuer) *QueryFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryFixture.ScannerValuerParam, v))
+// FindByString adds a new filter to the query that will require that
+// the String property is equal to the passed value.
+func (q *SchemaFixtureQuery) FindByString(v string) *SchemaFixtureQuery {
+	return q.Where(kallax.Eq(Schema.SchemaFixture.String, v))
 }
 
-// QueryFixtureResultSet is the set of results returned by a query to the
+// FindByInt adds a new filter to the query that will require that
+// the Int property is equal to the passed value.
+func (q *SchemaFixtureQuery) FindByInt(cond kallax.ScalarCond, v int) *SchemaFixtureQuery {
+	return q.Where(cond(Schema.SchemaFixture.Int, v))
+}
+
+// FindByInline adds a new filter to the query that will require that
+// the Inline property is equal to the passed value.
+func (q *SchemaFixtureQuery) FindByInline(v string) *SchemaFixtureQuery {
+	return q.Where(kallax.Eq(Schema.SchemaFixture.Inline, v))
+}
+
+// FindByInverse adds a new filter to the query that will require that
+// the foreign key of Inverse is equal to the passed value.
+func (q *SchemaFixtureQuery) FindByInverse(v kallax.ULID) *SchemaFixtureQuery {
+	return q.Where(kallax.Eq(Schema.SchemaFixture.InverseFK, v))
+}
+
+// SchemaFixtureResultSet is the set of results returned by a query to the
 // database.
-type QueryFixtureResultSet struct {
+type SchemaFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *QueryFixture
+	last      *SchemaFixture
 	lastErr   error
 }
 
-// NewQueryFixtureResultSet creates a new result set for rows of the type
-// QueryFixture.
-func NewQueryFixtureResultSet(rs kallax.ResultSet) *QueryFixtureResultSet {
-	return &QueryFixtureResultSet{ResultSet: rs}
+// NewSchemaFixtureResultSet creates a new result set for rows of the type
+// SchemaFixture.
+func NewSchemaFixtureResultSet(rs kallax.ResultSet) *SchemaFixtureResultSet {
+	return &SchemaFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *QueryFixtureResultSet) Next() bool {
+func (rs *SchemaFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -9202,14 +19014,14 @@ func (rs *QueryFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.QueryFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.SchemaFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*QueryFixture)
+		rs.last, ok = record.(*SchemaFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *QueryFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SchemaFixture")
 			rs.last = nil
 		}
 	}
@@ -9218,7 +19030,7 @@ func (rs *QueryFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *QueryFixtureResultSet) Get() (*QueryFixture, error) {
+func (rs *SchemaFixtureResultSet) Get() (*SchemaFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -9226,7 +19038,7 @@ func (rs *QueryFixtureResultSet) Get() (*QueryFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *QueryFixtureResultSet) ForEach(fn func(*QueryFixture) error) error {
+func (rs *SchemaFixtureResultSet) ForEach(fn func(*SchemaFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -9245,8 +19057,8 @@ func (rs *QueryFixtureResultSet) ForEach(fn func(*QueryFixture) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *QueryFixtureResultSet) All() ([]*QueryFixture, error) {
-	var result []*QueryFixture
+func (rs *SchemaFixtureResultSet) All() ([]*SchemaFixture, error) {
+	var result []*SchemaFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -9258,7 +19070,7 @@ func (rs *QueryFixtureResultSet) All() ([]*QueryFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *QueryFixtureResultSet) One() (*QueryFixture, error) {
+func (rs *SchemaFixtureResultSet) One() (*SchemaFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -9275,167 +19087,148 @@ func (rs *QueryFixtureResultSet) One() (*QueryFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *SchemaFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *QueryFixtureResultSet) Err() error {
+func (rs *SchemaFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *QueryFixtureResultSet) Close() error {
+func (rs *SchemaFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewQueryRelationFixture returns a new instance of QueryRelationFixture.
-func NewQueryRelationFixture() (record *QueryRelationFixture) {
-	return new(QueryRelationFixture)
+// NewSchemaRelationshipFixture returns a new instance of SchemaRelationshipFixture.
+func NewSchemaRelationshipFixture() (record *SchemaRelationshipFixture) {
+	return new(SchemaRelationshipFixture)
 }
 
 // GetID returns the primary key of the model.
-func (r *QueryRelationFixture) GetID() kallax.Identifier {
+func (r *SchemaRelationshipFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *QueryRelationFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *SchemaRelationshipFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
-	case "name":
-		return &r.Name, nil
-	case "owner_id":
-		return types.Nullable(kallax.VirtualColumn("owner_id", r, new(kallax.ULID))), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in QueryRelationFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in SchemaRelationshipFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *QueryRelationFixture) Value(col string) (interface{}, error) {
+func (r *SchemaRelationshipFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "name":
-		return r.Name, nil
-	case "owner_id":
-		v := r.Model.VirtualColumn(col)
-		if v == nil {
-			return nil, kallax.ErrEmptyVirtualColumn
-		}
-		return v, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in QueryRelationFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in SchemaRelationshipFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *QueryRelationFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	switch field {
-	case "Owner":
-		return new(QueryFixture), nil
-
-	}
-	return nil, fmt.Errorf("kallax: model QueryRelationFixture has no relationship %s", field)
+func (r *SchemaRelationshipFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model SchemaRelationshipFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *QueryRelationFixture) SetRelationship(field string, rel interface{}) error {
-	switch field {
-	case "Owner":
-		val, ok := rel.(*QueryFixture)
-		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Owner", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Owner = val
-		}
-
-		return nil
-
-	}
-	return fmt.Errorf("kallax: model QueryRelationFixture has no relationship %s", field)
+func (r *SchemaRelationshipFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model SchemaRelationshipFixture has no relationships")
 }
 
-// QueryRelationFixtureStore is the entity to access the records of the type QueryRelationFixture
+// SchemaRelationshipFixtureStore is the entity to access the records of the type SchemaRelationshipFixture
 // in the database.
-type QueryRelationFixtureStore struct {
+type SchemaRelationshipFixtureStore struct {
 	*kallax.Store
 }
 
-// NewQueryRelationFixtureStore creates a new instance of QueryRelationFixtureStore
+// NewSchemaRelationshipFixtureStore creates a new instance of SchemaRelationshipFixtureStore
 // using a SQL database.
-func NewQueryRelationFixtureStore(db *sql.DB) *QueryRelationFixtureStore {
-	return &QueryRelationFixtureStore{kallax.NewStore(db)}
+func NewSchemaRelationshipFixtureStore(db *sql.DB) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *QueryRelationFixtureStore) GenericStore() *kallax.Store {
+func (s *SchemaRelationshipFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *QueryRelationFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *SchemaRelationshipFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *QueryRelationFixtureStore) Debug() *QueryRelationFixtureStore {
-	return &QueryRelationFixtureStore{s.Store.Debug()}
+func (s *SchemaRelationshipFixtureStore) Debug() *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *QueryRelationFixtureStore) DebugWith(logger kallax.LoggerFunc) *QueryRelationFixtureStore {
-	return &QueryRelationFixtureStore{s.Store.DebugWith(logger)}
+func (s *SchemaRelationshipFixtureStore) DebugWith(logger kallax.LoggerFunc) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *QueryRelationFixtureStore) DisableCacher() *QueryRelationFixtureStore {
-	return &QueryRelationFixtureStore{s.Store.DisableCacher()}
+func (s *SchemaRelationshipFixtureStore) DisableCacher() *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.DisableCacher()}
 }
 
-func (s *QueryRelationFixtureStore) inverseRecords(record *QueryRelationFixture) []modelSaveFunc {
-	var result []modelSaveFunc
-
-	if record.Owner != nil && !record.Owner.IsSaving() {
-		record.AddVirtualColumn("owner_id", record.Owner.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&QueryFixtureStore{store}).Save(record.Owner)
-			return err
-		})
-	}
-
-	return result
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *SchemaRelationshipFixtureStore) WithDialect(dialect kallax.Dialect) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.WithDialect(dialect)}
 }
 
-// Insert inserts a QueryRelationFixture in the database. A non-persisted object is
+// Insert inserts a SchemaRelationshipFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
+func (s *SchemaRelationshipFixtureStore) Insert(record *SchemaRelationshipFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *SchemaRelationshipFixtureStore) InsertContext(ctx context.Context, record *SchemaRelationshipFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	inverseRecords := s.inverseRecords(record)
+	return s.Store.InsertContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record)
+}
 
-	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// InsertMany inserts all the given SchemaRelationshipFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *SchemaRelationshipFixtureStore) InsertMany(records []*SchemaRelationshipFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-			if err := s.Insert(Schema.QueryRelationFixture.BaseSchema, record); err != nil {
-				return err
-			}
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *SchemaRelationshipFixtureStore) InsertManyContext(ctx context.Context, records []*SchemaRelationshipFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
 
-			return nil
-		})
+		recs[i] = record
 	}
 
-	return s.Store.Insert(Schema.QueryRelationFixture.BaseSchema, record)
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -9444,45 +19237,33 @@ func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *QueryRelationFixtureStore) Update(record *QueryRelationFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *SchemaRelationshipFixtureStore) Update(record *SchemaRelationshipFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *SchemaRelationshipFixtureStore) UpdateContext(ctx context.Context, record *SchemaRelationshipFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	inverseRecords := s.inverseRecords(record)
-
-	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
-
-			updated, err = s.Update(Schema.QueryRelationFixture.BaseSchema, record, cols...)
-			if err != nil {
-				return err
-			}
-
-			return nil
-		})
-		if err != nil {
-			return 0, err
-		}
-
-		return updated, nil
-	}
-
-	return s.Store.Update(Schema.QueryRelationFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *QueryRelationFixtureStore) Save(record *QueryRelationFixture) (updated bool, err error) {
+func (s *SchemaRelationshipFixtureStore) Save(record *SchemaRelationshipFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *SchemaRelationshipFixtureStore) SaveContext(ctx context.Context, record *SchemaRelationshipFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -9490,45 +19271,159 @@ func (s *QueryRelationFixtureStore) Save(record *QueryRelationFixture) (updated
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *SchemaRelationshipFixtureStore) Upsert(record *SchemaRelationshipFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *SchemaRelationshipFixtureStore) UpsertContext(ctx context.Context, record *SchemaRelationshipFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *QueryRelationFixtureStore) Delete(record *QueryRelationFixture) error {
-	return s.Store.Delete(Schema.QueryRelationFixture.BaseSchema, record)
+func (s *SchemaRelationshipFixtureStore) Delete(record *SchemaRelationshipFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *SchemaRelationshipFixtureStore) DeleteContext(ctx context.Context, record *SchemaRelationshipFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *SchemaRelationshipFixtureStore) UpdateWhere(q *SchemaRelationshipFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *SchemaRelationshipFixtureStore) UpdateWhereContext(ctx context.Context, q *SchemaRelationshipFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *SchemaRelationshipFixtureStore) UpdateWhereReturning(q *SchemaRelationshipFixtureQuery, values map[kallax.SchemaField]interface{}) (*SchemaRelationshipFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *SchemaRelationshipFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *SchemaRelationshipFixtureQuery, values map[kallax.SchemaField]interface{}) (*SchemaRelationshipFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchemaRelationshipFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *SchemaRelationshipFixtureStore) DeleteWhere(q *SchemaRelationshipFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *SchemaRelationshipFixtureStore) DeleteWhereContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *SchemaRelationshipFixtureStore) DeleteWhereReturning(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *SchemaRelationshipFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchemaRelationshipFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *QueryRelationFixtureStore) Find(q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *SchemaRelationshipFixtureStore) Find(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *SchemaRelationshipFixtureStore) FindContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewQueryRelationFixtureResultSet(rs), nil
+	return NewSchemaRelationshipFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *QueryRelationFixtureStore) MustFind(q *QueryRelationFixtureQuery) *QueryRelationFixtureResultSet {
-	return NewQueryRelationFixtureResultSet(s.Store.MustFind(q))
+func (s *SchemaRelationshipFixtureStore) MustFind(q *SchemaRelationshipFixtureQuery) *SchemaRelationshipFixtureResultSet {
+	return NewSchemaRelationshipFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *SchemaRelationshipFixtureStore) MustFindContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) *SchemaRelationshipFixtureResultSet {
+	return NewSchemaRelationshipFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *QueryRelationFixtureStore) Count(q *QueryRelationFixtureQuery) (int64, error) {
+func (s *SchemaRelationshipFixtureStore) Count(q *SchemaRelationshipFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *SchemaRelationshipFixtureStore) CountContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *QueryRelationFixtureStore) MustCount(q *QueryRelationFixtureQuery) int64 {
+func (s *SchemaRelationshipFixtureStore) MustCount(q *SchemaRelationshipFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *SchemaRelationshipFixtureStore) MustCountContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *QueryRelationFixtureStore) FindOne(q *QueryRelationFixtureQuery) (*QueryRelationFixture, error) {
+func (s *SchemaRelationshipFixtureStore) FindOne(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *SchemaRelationshipFixtureStore) FindOneContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -9550,8 +19445,14 @@ func (s *QueryRelationFixtureStore) FindOne(q *QueryRelationFixtureQuery) (*Quer
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *QueryRelationFixtureStore) FindAll(q *QueryRelationFixtureQuery) ([]*QueryRelationFixture, error) {
-	rs, err := s.Find(q)
+func (s *SchemaRelationshipFixtureStore) FindAll(q *SchemaRelationshipFixtureQuery) ([]*SchemaRelationshipFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *SchemaRelationshipFixtureStore) FindAllContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) ([]*SchemaRelationshipFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -9561,49 +19462,67 @@ func (s *QueryRelationFixtureStore) FindAll(q *QueryRelationFixtureQuery) ([]*Qu
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *QueryRelationFixtureStore) MustFindOne(q *QueryRelationFixtureQuery) *QueryRelationFixture {
-	record, err := s.FindOne(q)
+func (s *SchemaRelationshipFixtureStore) MustFindOne(q *SchemaRelationshipFixtureQuery) *SchemaRelationshipFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *SchemaRelationshipFixtureStore) MustFindOneContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) *SchemaRelationshipFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the QueryRelationFixture with the data in the database and
+// Reload refreshes the SchemaRelationshipFixture with the data in the database and
 // makes it writable.
-func (s *QueryRelationFixtureStore) Reload(record *QueryRelationFixture) error {
-	return s.Store.Reload(Schema.QueryRelationFixture.BaseSchema, record)
+func (s *SchemaRelationshipFixtureStore) Reload(record *SchemaRelationshipFixture) error {
+	return s.Store.Reload(Schema.SchemaRelationshipFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *SchemaRelationshipFixtureStore) ReloadContext(ctx context.Context, record *SchemaRelationshipFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *QueryRelationFixtureStore) Transaction(callback func(*QueryRelationFixtureStore) error) error {
+func (s *SchemaRelationshipFixtureStore) Transaction(callback func(*SchemaRelationshipFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *SchemaRelationshipFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*SchemaRelationshipFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&QueryRelationFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&SchemaRelationshipFixtureStore{store})
 	})
 }
 
-// QueryRelationFixtureQuery is the object used to create queries for the QueryRelationFixture
+// SchemaRelationshipFixtureQuery is the object used to create queries for the SchemaRelationshipFixture
 // entity.
-type QueryRelationFixtureQuery struct {
-	*kallax.BaseQuery
-}
-
-// NewQueryRelationFixtureQuery returns a new instance of QueryRelationFixtureQuery.
-func NewQueryRelationFixtureQuery() *QueryRelationFixtureQuery {
-	return &QueryRelationFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.QueryRelationFixture.BaseSchema),
+type SchemaRelationshipFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewSchemaRelationshipFixtureQuery returns a new instance of SchemaRelationshipFixtureQuery.
+func NewSchemaRelationshipFixtureQuery() *SchemaRelationshipFixtureQuery {
+	return &SchemaRelationshipFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.SchemaRelationshipFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *QueryRelationFixtureQuery) Select(columns ...kallax.SchemaField) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) Select(columns ...kallax.SchemaField) *SchemaRelationshipFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -9612,60 +19531,109 @@ func (q *QueryRelationFixtureQuery) Select(columns ...kallax.SchemaField) *Query
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *QueryRelationFixtureQuery) SelectNot(columns ...kallax.SchemaField) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *QueryRelationFixtureQuery) Copy() *QueryRelationFixtureQuery {
-	return &QueryRelationFixtureQuery{
+func (q *SchemaRelationshipFixtureQuery) Copy() *SchemaRelationshipFixtureQuery {
+	return &SchemaRelationshipFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *QueryRelationFixtureQuery) Order(cols ...kallax.ColumnOrder) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) Order(cols ...kallax.ColumnOrder) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *SchemaRelationshipFixtureQuery) After(cursor *kallax.Cursor) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *SchemaRelationshipFixtureQuery) Before(cursor *kallax.Cursor) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *QueryRelationFixtureQuery) BatchSize(size uint64) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) BatchSize(size uint64) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *QueryRelationFixtureQuery) Limit(n uint64) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) Limit(n uint64) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *QueryRelationFixtureQuery) Offset(n uint64) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) Offset(n uint64) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *QueryRelationFixtureQuery) Where(cond kallax.Condition) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) Where(cond kallax.Condition) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *QueryRelationFixtureQuery) WithOwner() *QueryRelationFixtureQuery {
-	q.AddRelation(Schema.QueryFixture.BaseSchema, "Owner", kallax.OneToOne, nil)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *SchemaRelationshipFixtureQuery) GroupBy(cols ...kallax.SchemaField) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *SchemaRelationshipFixtureQuery) Having(cond kallax.Condition) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *SchemaRelationshipFixtureQuery) ForUpdate() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *SchemaRelationshipFixtureQuery) ForShare() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *SchemaRelationshipFixtureQuery) NoWait() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *SchemaRelationshipFixtureQuery) SkipLocked() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *QueryRelationFixtureQuery) FindByID(v ...kallax.ULID) *QueryRelationFixtureQuery {
+func (q *SchemaRelationshipFixtureQuery) FindByID(v ...kallax.ULID) *SchemaRelationshipFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -9673,39 +19641,27 @@ func (q *QueryRelationFixtureQuery) FindByID(v ...kallax.ULID) *QueryRelationFix
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.QueryRelationFixture.ID, values...))
-}
-
-// FindByName adds a new filter to the query that will require that
-// the Name property is equal to the passed value.
-func (q *QueryRelationFixtureQuery) FindByName(v string) *QueryRelationFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryRelationFixture.Name, v))
-}
-
-// FindByOwner adds a new filter to the query that will require that
-// the foreign key of Owner is equal to the passed value.
-func (q *QueryRelationFixtureQuery) FindByOwner(v kallax.ULID) *QueryRelationFixtureQuery {
-	return q.Where(kallax.Eq(Schema.QueryRelationFixture.OwnerFK, v))
+	return q.Where(kallax.In(Schema.SchemaRelationshipFixture.ID, values...))
 }
 
-// QueryRelationFixtureResultSet is the set of results returned by a query to the
+// SchemaRelationshipFixtureResultSet is the set of results returned by a query to the
 // database.
-type QueryRelationFixtureResultSet struct {
+type SchemaRelationshipFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *QueryRelationFixture
+	last      *SchemaRelationshipFixture
 	lastErr   error
 }
 
-// NewQueryRelationFixtureResultSet creates a new result set for rows of the type
-// QueryRelationFixture.
-func NewQueryRelationFixtureResultSet(rs kallax.ResultSet) *QueryRelationFixtureResultSet {
-	return &QueryRelationFixtureResultSet{ResultSet: rs}
+// NewSchemaRelationshipFixtureResultSet creates a new result set for rows of the type
+// SchemaRelationshipFixture.
+func NewSchemaRelationshipFixtureResultSet(rs kallax.ResultSet) *SchemaRelationshipFixtureResultSet {
+	return &SchemaRelationshipFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *QueryRelationFixtureResultSet) Next() bool {
+func (rs *SchemaRelationshipFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -9713,14 +19669,14 @@ func (rs *QueryRelationFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.QueryRelationFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.SchemaRelationshipFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*QueryRelationFixture)
+		rs.last, ok = record.(*SchemaRelationshipFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *QueryRelationFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SchemaRelationshipFixture")
 			rs.last = nil
 		}
 	}
@@ -9729,7 +19685,7 @@ func (rs *QueryRelationFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *QueryRelationFixtureResultSet) Get() (*QueryRelationFixture, error) {
+func (rs *SchemaRelationshipFixtureResultSet) Get() (*SchemaRelationshipFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -9737,7 +19693,7 @@ func (rs *QueryRelationFixtureResultSet) Get() (*QueryRelationFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *QueryRelationFixtureResultSet) ForEach(fn func(*QueryRelationFixture) error) error {
+func (rs *SchemaRelationshipFixtureResultSet) ForEach(fn func(*SchemaRelationshipFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -9756,8 +19712,8 @@ func (rs *QueryRelationFixtureResultSet) ForEach(fn func(*QueryRelationFixture)
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *QueryRelationFixtureResultSet) All() ([]*QueryRelationFixture, error) {
-	var result []*QueryRelationFixture
+func (rs *SchemaRelationshipFixtureResultSet) All() ([]*SchemaRelationshipFixture, error) {
+	var result []*SchemaRelationshipFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -9769,7 +19725,7 @@ func (rs *QueryRelationFixtureResultSet) All() ([]*QueryRelationFixture, error)
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *QueryRelationFixtureResultSet) One() (*QueryRelationFixture, error) {
+func (rs *SchemaRelationshipFixtureResultSet) One() (*SchemaRelationshipFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -9786,109 +19742,166 @@ func (rs *QueryRelationFixtureResultSet) One() (*QueryRelationFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *SchemaRelationshipFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *QueryRelationFixtureResultSet) Err() error {
+func (rs *SchemaRelationshipFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *QueryRelationFixtureResultSet) Close() error {
+func (rs *SchemaRelationshipFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewResultSetFixture returns a new instance of ResultSetFixture.
-func NewResultSetFixture(f string) (record *ResultSetFixture) {
-	return newResultSetFixture(f)
+// NewSoftDeleteFixture returns a new instance of SoftDeleteFixture.
+func NewSoftDeleteFixture(foo string) (record *SoftDeleteFixture) {
+	return newSoftDeleteFixture(foo)
 }
 
 // GetID returns the primary key of the model.
-func (r *ResultSetFixture) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *SoftDeleteFixture) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *ResultSetFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *SoftDeleteFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
+		return (*kallax.NumericID)(&r.ID), nil
+	case "deleted_at":
+		return types.Nullable(&r.SoftDeletes.DeletedAt), nil
 	case "foo":
 		return &r.Foo, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in ResultSetFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in SoftDeleteFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *ResultSetFixture) Value(col string) (interface{}, error) {
+func (r *SoftDeleteFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
+	case "deleted_at":
+		if r.SoftDeletes.DeletedAt == (*time.Time)(nil) {
+			return nil, nil
+		}
+		return r.SoftDeletes.DeletedAt, nil
 	case "foo":
 		return r.Foo, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in ResultSetFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in SoftDeleteFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *ResultSetFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model ResultSetFixture has no relationships")
+func (r *SoftDeleteFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model SoftDeleteFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *ResultSetFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model ResultSetFixture has no relationships")
+func (r *SoftDeleteFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model SoftDeleteFixture has no relationships")
 }
 
-// ResultSetFixtureStore is the entity to access the records of the type ResultSetFixture
+// SoftDeleteFixtureStore is the entity to access the records of the type SoftDeleteFixture
 // in the database.
-type ResultSetFixtureStore struct {
+type SoftDeleteFixtureStore struct {
 	*kallax.Store
 }
 
-// NewResultSetFixtureStore creates a new instance of ResultSetFixtureStore
+// NewSoftDeleteFixtureStore creates a new instance of SoftDeleteFixtureStore
 // using a SQL database.
-func NewResultSetFixtureStore(db *sql.DB) *ResultSetFixtureStore {
-	return &ResultSetFixtureStore{kallax.NewStore(db)}
+func NewSoftDeleteFixtureStore(db *sql.DB) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *ResultSetFixtureStore) GenericStore() *kallax.Store {
+func (s *SoftDeleteFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *ResultSetFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *SoftDeleteFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *ResultSetFixtureStore) Debug() *ResultSetFixtureStore {
-	return &ResultSetFixtureStore{s.Store.Debug()}
+func (s *SoftDeleteFixtureStore) Debug() *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *ResultSetFixtureStore) DebugWith(logger kallax.LoggerFunc) *ResultSetFixtureStore {
-	return &ResultSetFixtureStore{s.Store.DebugWith(logger)}
+func (s *SoftDeleteFixtureStore) DebugWith(logger kallax.LoggerFunc) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *ResultSetFixtureStore) DisableCacher() *ResultSetFixtureStore {
-	return &ResultSetFixtureStore{s.Store.DisableCacher()}
+func (s *SoftDeleteFixtureStore) DisableCacher() *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a ResultSetFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *SoftDeleteFixtureStore) WithDialect(dialect kallax.Dialect) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a SoftDeleteFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *ResultSetFixtureStore) Insert(record *ResultSetFixture) error {
+func (s *SoftDeleteFixtureStore) Insert(record *SoftDeleteFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *SoftDeleteFixtureStore) InsertContext(ctx context.Context, record *SoftDeleteFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.ResultSetFixture.BaseSchema, record)
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	return s.Store.InsertContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given SoftDeleteFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *SoftDeleteFixtureStore) InsertMany(records []*SoftDeleteFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *SoftDeleteFixtureStore) InsertManyContext(ctx context.Context, records []*SoftDeleteFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+		if record.DeletedAt != nil {
+			record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+		}
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.SoftDeleteFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -9897,21 +19910,37 @@ func (s *ResultSetFixtureStore) Insert(record *ResultSetFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *ResultSetFixtureStore) Update(record *ResultSetFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *SoftDeleteFixtureStore) Update(record *SoftDeleteFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *SoftDeleteFixtureStore) UpdateContext(ctx context.Context, record *SoftDeleteFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.ResultSetFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *ResultSetFixtureStore) Save(record *ResultSetFixture) (updated bool, err error) {
+func (s *SoftDeleteFixtureStore) Save(record *SoftDeleteFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *SoftDeleteFixtureStore) SaveContext(ctx context.Context, record *SoftDeleteFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -9919,45 +19948,217 @@ func (s *ResultSetFixtureStore) Save(record *ResultSetFixture) (updated bool, er
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *SoftDeleteFixtureStore) Upsert(record *SoftDeleteFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *SoftDeleteFixtureStore) UpsertContext(ctx context.Context, record *SoftDeleteFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	return s.Store.UpsertContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *ResultSetFixtureStore) Delete(record *ResultSetFixture) error {
-	return s.Store.Delete(Schema.ResultSetFixture.BaseSchema, record)
+func (s *SoftDeleteFixtureStore) Delete(record *SoftDeleteFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *SoftDeleteFixtureStore) DeleteContext(ctx context.Context, record *SoftDeleteFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// ForceDelete removes the given record from the database, instead of setting
+// its deletion time.
+func (s *SoftDeleteFixtureStore) ForceDelete(record *SoftDeleteFixture) error {
+	return s.ForceDeleteContext(context.Background(), record)
+}
+
+// ForceDeleteContext is the same as ForceDelete, but the given context is used
+// to run the queries.
+func (s *SoftDeleteFixtureStore) ForceDeleteContext(ctx context.Context, record *SoftDeleteFixture) error {
+	return s.Store.ForceDeleteContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// Restore undoes the deletion of the given record.
+func (s *SoftDeleteFixtureStore) Restore(record *SoftDeleteFixture) error {
+	return s.RestoreContext(context.Background(), record)
+}
+
+// RestoreContext is the same as Restore, but the given context is used to run
+// the queries.
+func (s *SoftDeleteFixtureStore) RestoreContext(ctx context.Context, record *SoftDeleteFixture) error {
+	return s.Store.RestoreContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *SoftDeleteFixtureStore) UpdateWhere(q *SoftDeleteFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *SoftDeleteFixtureStore) UpdateWhereContext(ctx context.Context, q *SoftDeleteFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *SoftDeleteFixtureStore) UpdateWhereReturning(q *SoftDeleteFixtureQuery, values map[kallax.SchemaField]interface{}) (*SoftDeleteFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *SoftDeleteFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *SoftDeleteFixtureQuery, values map[kallax.SchemaField]interface{}) (*SoftDeleteFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftDeleteFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *SoftDeleteFixtureStore) DeleteWhere(q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *SoftDeleteFixtureStore) DeleteWhereContext(ctx context.Context, q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *SoftDeleteFixtureStore) DeleteWhereReturning(q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *SoftDeleteFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftDeleteFixtureResultSet(rs), nil
+}
+
+// ForceDeleteWhere removes all the records matching the given query from the
+// database, instead of setting their deletion time, and returns the number of
+// removed records. Only the conditions of the query are used. No events are
+// run for the removed records.
+func (s *SoftDeleteFixtureStore) ForceDeleteWhere(q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.ForceDeleteWhere(q)
+}
+
+// ForceDeleteWhereContext is the same as ForceDeleteWhere, but the given
+// context is used to run the query.
+func (s *SoftDeleteFixtureStore) ForceDeleteWhereContext(ctx context.Context, q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.ForceDeleteWhereContext(ctx, q)
+}
+
+// ForceDeleteWhereReturning is the same as ForceDeleteWhere, but it returns
+// the removed records instead of the number of records.
+func (s *SoftDeleteFixtureStore) ForceDeleteWhereReturning(q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	return s.ForceDeleteWhereReturningContext(context.Background(), q)
+}
+
+// ForceDeleteWhereReturningContext is the same as ForceDeleteWhereReturning,
+// but the given context is used to run the query.
+func (s *SoftDeleteFixtureStore) ForceDeleteWhereReturningContext(ctx context.Context, q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	rs, err := s.Store.ForceDeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftDeleteFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *ResultSetFixtureStore) Find(q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *SoftDeleteFixtureStore) Find(q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *SoftDeleteFixtureStore) FindContext(ctx context.Context, q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewResultSetFixtureResultSet(rs), nil
+	return NewSoftDeleteFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *ResultSetFixtureStore) MustFind(q *ResultSetFixtureQuery) *ResultSetFixtureResultSet {
-	return NewResultSetFixtureResultSet(s.Store.MustFind(q))
+func (s *SoftDeleteFixtureStore) MustFind(q *SoftDeleteFixtureQuery) *SoftDeleteFixtureResultSet {
+	return NewSoftDeleteFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *SoftDeleteFixtureStore) MustFindContext(ctx context.Context, q *SoftDeleteFixtureQuery) *SoftDeleteFixtureResultSet {
+	return NewSoftDeleteFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *ResultSetFixtureStore) Count(q *ResultSetFixtureQuery) (int64, error) {
+func (s *SoftDeleteFixtureStore) Count(q *SoftDeleteFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *SoftDeleteFixtureStore) CountContext(ctx context.Context, q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *ResultSetFixtureStore) MustCount(q *ResultSetFixtureQuery) int64 {
+func (s *SoftDeleteFixtureStore) MustCount(q *SoftDeleteFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *SoftDeleteFixtureStore) MustCountContext(ctx context.Context, q *SoftDeleteFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *ResultSetFixtureStore) FindOne(q *ResultSetFixtureQuery) (*ResultSetFixture, error) {
+func (s *SoftDeleteFixtureStore) FindOne(q *SoftDeleteFixtureQuery) (*SoftDeleteFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *SoftDeleteFixtureStore) FindOneContext(ctx context.Context, q *SoftDeleteFixtureQuery) (*SoftDeleteFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -9979,8 +20180,14 @@ func (s *ResultSetFixtureStore) FindOne(q *ResultSetFixtureQuery) (*ResultSetFix
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *ResultSetFixtureStore) FindAll(q *ResultSetFixtureQuery) ([]*ResultSetFixture, error) {
-	rs, err := s.Find(q)
+func (s *SoftDeleteFixtureStore) FindAll(q *SoftDeleteFixtureQuery) ([]*SoftDeleteFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *SoftDeleteFixtureStore) FindAllContext(ctx context.Context, q *SoftDeleteFixtureQuery) ([]*SoftDeleteFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -9990,49 +20197,67 @@ func (s *ResultSetFixtureStore) FindAll(q *ResultSetFixtureQuery) ([]*ResultSetF
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *ResultSetFixtureStore) MustFindOne(q *ResultSetFixtureQuery) *ResultSetFixture {
-	record, err := s.FindOne(q)
+func (s *SoftDeleteFixtureStore) MustFindOne(q *SoftDeleteFixtureQuery) *SoftDeleteFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *SoftDeleteFixtureStore) MustFindOneContext(ctx context.Context, q *SoftDeleteFixtureQuery) *SoftDeleteFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the ResultSetFixture with the data in the database and
+// Reload refreshes the SoftDeleteFixture with the data in the database and
 // makes it writable.
-func (s *ResultSetFixtureStore) Reload(record *ResultSetFixture) error {
-	return s.Store.Reload(Schema.ResultSetFixture.BaseSchema, record)
+func (s *SoftDeleteFixtureStore) Reload(record *SoftDeleteFixture) error {
+	return s.Store.Reload(Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *SoftDeleteFixtureStore) ReloadContext(ctx context.Context, record *SoftDeleteFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *ResultSetFixtureStore) Transaction(callback func(*ResultSetFixtureStore) error) error {
+func (s *SoftDeleteFixtureStore) Transaction(callback func(*SoftDeleteFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *SoftDeleteFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*SoftDeleteFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&ResultSetFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&SoftDeleteFixtureStore{store})
 	})
 }
 
-// ResultSetFixtureQuery is the object used to create queries for the ResultSetFixture
+// SoftDeleteFixtureQuery is the object used to create queries for the SoftDeleteFixture
 // entity.
-type ResultSetFixtureQuery struct {
+type SoftDeleteFixtureQuery struct {
 	*kallax.BaseQuery
 }
-
-// NewResultSetFixtureQuery returns a new instance of ResultSetFixtureQuery.
-func NewResultSetFixtureQuery() *ResultSetFixtureQuery {
-	return &ResultSetFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.ResultSetFixture.BaseSchema),
+
+// NewSoftDeleteFixtureQuery returns a new instance of SoftDeleteFixtureQuery.
+func NewSoftDeleteFixtureQuery() *SoftDeleteFixtureQuery {
+	return &SoftDeleteFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.SoftDeleteFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *ResultSetFixtureQuery) Select(columns ...kallax.SchemaField) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) Select(columns ...kallax.SchemaField) *SoftDeleteFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -10041,55 +20266,121 @@ func (q *ResultSetFixtureQuery) Select(columns ...kallax.SchemaField) *ResultSet
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *ResultSetFixtureQuery) SelectNot(columns ...kallax.SchemaField) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SoftDeleteFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *ResultSetFixtureQuery) Copy() *ResultSetFixtureQuery {
-	return &ResultSetFixtureQuery{
+func (q *SoftDeleteFixtureQuery) Copy() *SoftDeleteFixtureQuery {
+	return &SoftDeleteFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *ResultSetFixtureQuery) Order(cols ...kallax.ColumnOrder) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) Order(cols ...kallax.ColumnOrder) *SoftDeleteFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *SoftDeleteFixtureQuery) After(cursor *kallax.Cursor) *SoftDeleteFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *SoftDeleteFixtureQuery) Before(cursor *kallax.Cursor) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *ResultSetFixtureQuery) BatchSize(size uint64) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) BatchSize(size uint64) *SoftDeleteFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *ResultSetFixtureQuery) Limit(n uint64) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) Limit(n uint64) *SoftDeleteFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *ResultSetFixtureQuery) Offset(n uint64) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) Offset(n uint64) *SoftDeleteFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *ResultSetFixtureQuery) Where(cond kallax.Condition) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) Where(cond kallax.Condition) *SoftDeleteFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *SoftDeleteFixtureQuery) GroupBy(cols ...kallax.SchemaField) *SoftDeleteFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *SoftDeleteFixtureQuery) Having(cond kallax.Condition) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *SoftDeleteFixtureQuery) ForUpdate() *SoftDeleteFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *SoftDeleteFixtureQuery) ForShare() *SoftDeleteFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *SoftDeleteFixtureQuery) NoWait() *SoftDeleteFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *SoftDeleteFixtureQuery) SkipLocked() *SoftDeleteFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// WithDeleted makes the query also return the deleted records.
+func (q *SoftDeleteFixtureQuery) WithDeleted() *SoftDeleteFixtureQuery {
+	q.BaseQuery.WithDeleted()
+	return q
+}
+
+// OnlyDeleted makes the query return only the deleted records.
+func (q *SoftDeleteFixtureQuery) OnlyDeleted() *SoftDeleteFixtureQuery {
+	q.BaseQuery.OnlyDeleted()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *ResultSetFixtureQuery) FindByID(v ...kallax.ULID) *ResultSetFixtureQuery {
+func (q *SoftDeleteFixtureQuery) FindByID(v ...int64) *SoftDeleteFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -10097,33 +20388,39 @@ func (q *ResultSetFixtureQuery) FindByID(v ...kallax.ULID) *ResultSetFixtureQuer
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.ResultSetFixture.ID, values...))
+	return q.Where(kallax.In(Schema.SoftDeleteFixture.ID, values...))
+}
+
+// FindByDeletedAt adds a new filter to the query that will require that
+// the DeletedAt property is equal to the passed value.
+func (q *SoftDeleteFixtureQuery) FindByDeletedAt(cond kallax.ScalarCond, v time.Time) *SoftDeleteFixtureQuery {
+	return q.Where(cond(Schema.SoftDeleteFixture.DeletedAt, v))
 }
 
 // FindByFoo adds a new filter to the query that will require that
 // the Foo property is equal to the passed value.
-func (q *ResultSetFixtureQuery) FindByFoo(v string) *ResultSetFixtureQuery {
-	return q.Where(kallax.Eq(Schema.ResultSetFixture.Foo, v))
+func (q *SoftDeleteFixtureQuery) FindByFoo(v string) *SoftDeleteFixtureQuery {
+	return q.Where(kallax.Eq(Schema.SoftDeleteFixture.Foo, v))
 }
 
-// ResultSetFixtureResultSet is the set of results returned by a query to the
+// SoftDeleteFixtureResultSet is the set of results returned by a query to the
 // database.
-type ResultSetFixtureResultSet struct {
+type SoftDeleteFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *ResultSetFixture
+	last      *SoftDeleteFixture
 	lastErr   error
 }
 
-// NewResultSetFixtureResultSet creates a new result set for rows of the type
-// ResultSetFixture.
-func NewResultSetFixtureResultSet(rs kallax.ResultSet) *ResultSetFixtureResultSet {
-	return &ResultSetFixtureResultSet{ResultSet: rs}
+// NewSoftDeleteFixtureResultSet creates a new result set for rows of the type
+// SoftDeleteFixture.
+func NewSoftDeleteFixtureResultSet(rs kallax.ResultSet) *SoftDeleteFixtureResultSet {
+	return &SoftDeleteFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *ResultSetFixtureResultSet) Next() bool {
+func (rs *SoftDeleteFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -10131,14 +20428,14 @@ func (rs *ResultSetFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.ResultSetFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.SoftDeleteFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*ResultSetFixture)
+		rs.last, ok = record.(*SoftDeleteFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *ResultSetFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SoftDeleteFixture")
 			rs.last = nil
 		}
 	}
@@ -10147,7 +20444,7 @@ func (rs *ResultSetFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *ResultSetFixtureResultSet) Get() (*ResultSetFixture, error) {
+func (rs *SoftDeleteFixtureResultSet) Get() (*SoftDeleteFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -10155,7 +20452,7 @@ func (rs *ResultSetFixtureResultSet) Get() (*ResultSetFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *ResultSetFixtureResultSet) ForEach(fn func(*ResultSetFixture) error) error {
+func (rs *SoftDeleteFixtureResultSet) ForEach(fn func(*SoftDeleteFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -10174,8 +20471,8 @@ func (rs *ResultSetFixtureResultSet) ForEach(fn func(*ResultSetFixture) error) e
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *ResultSetFixtureResultSet) All() ([]*ResultSetFixture, error) {
-	var result []*ResultSetFixture
+func (rs *SoftDeleteFixtureResultSet) All() ([]*SoftDeleteFixture, error) {
+	var result []*SoftDeleteFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -10187,7 +20484,7 @@ func (rs *ResultSetFixtureResultSet) All() ([]*ResultSetFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *ResultSetFixtureResultSet) One() (*ResultSetFixture, error) {
+func (rs *SoftDeleteFixtureResultSet) One() (*SoftDeleteFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -10204,323 +20501,355 @@ func (rs *ResultSetFixtureResultSet) One() (*ResultSetFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *SoftDeleteFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *ResultSetFixtureResultSet) Err() error {
+func (rs *SoftDeleteFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *ResultSetFixtureResultSet) Close() error {
+func (rs *SoftDeleteFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewSchemaFixture returns a new instance of SchemaFixture.
-func NewSchemaFixture() (record *SchemaFixture) {
-	return newSchemaFixture()
+// NewStoreFixture returns a new instance of StoreFixture.
+func NewStoreFixture() (record *StoreFixture) {
+	return newStoreFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *SchemaFixture) GetID() kallax.Identifier {
+func (r *StoreFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *SchemaFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *StoreFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
-	case "string":
-		return &r.String, nil
-	case "int":
-		return &r.Int, nil
-	case "inline":
-		return &r.Inline.Inline, nil
-	case "map_of_string":
-		return types.JSON(&r.MapOfString), nil
-	case "map_of_interface":
-		return types.JSON(&r.MapOfInterface), nil
-	case "map_of_some_type":
-		return types.JSON(&r.MapOfSomeType), nil
-	case "rel_id":
-		return types.Nullable(kallax.VirtualColumn("rel_id", r, new(kallax.ULID))), nil
+	case "foo":
+		return &r.Foo, nil
+	case "slice_prop":
+		return types.Slice(&r.SliceProp), nil
+	case "alias_slice_prop":
+		return types.Slice((*[]string)(&r.AliasSliceProp)), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in SchemaFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *SchemaFixture) Value(col string) (interface{}, error) {
+func (r *StoreFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "string":
-		return r.String, nil
-	case "int":
-		return r.Int, nil
-	case "inline":
-		return r.Inline.Inline, nil
-	case "map_of_string":
-		return types.JSON(r.MapOfString), nil
-	case "map_of_interface":
-		return types.JSON(r.MapOfInterface), nil
-	case "map_of_some_type":
-		return types.JSON(r.MapOfSomeType), nil
-	case "rel_id":
-		v := r.Model.VirtualColumn(col)
-		if v == nil {
-			return nil, kallax.ErrEmptyVirtualColumn
-		}
-		return v, nil
+	case "foo":
+		return r.Foo, nil
+	case "slice_prop":
+		return types.Slice(r.SliceProp), nil
+	case "alias_slice_prop":
+		return types.Slice(r.AliasSliceProp), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in SchemaFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *SchemaFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	switch field {
-	case "Nested":
-		return new(SchemaFixture), nil
-	case "Inverse":
-		return new(SchemaRelationshipFixture), nil
-
-	}
-	return nil, fmt.Errorf("kallax: model SchemaFixture has no relationship %s", field)
+func (r *StoreFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model StoreFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *SchemaFixture) SetRelationship(field string, rel interface{}) error {
-	switch field {
-	case "Nested":
-		val, ok := rel.(*SchemaFixture)
-		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Nested", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Nested = val
-		}
-
-		return nil
-	case "Inverse":
-		val, ok := rel.(*SchemaRelationshipFixture)
-		if !ok {
-			return fmt.Errorf("kallax: record of type %t can't be assigned to relationship Inverse", rel)
-		}
-		if !val.GetID().IsEmpty() {
-			r.Inverse = val
-		}
-
-		return nil
-
-	}
-	return fmt.Errorf("kallax: model SchemaFixture has no relationship %s", field)
+func (r *StoreFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model StoreFixture has no relationships")
 }
 
-// SchemaFixtureStore is the entity to access the records of the type SchemaFixture
+// StoreFixtureStore is the entity to access the records of the type StoreFixture
 // in the database.
-type SchemaFixtureStore struct {
+type StoreFixtureStore struct {
 	*kallax.Store
 }
 
-// NewSchemaFixtureStore creates a new instance of SchemaFixtureStore
+// NewStoreFixtureStore creates a new instance of StoreFixtureStore
 // using a SQL database.
-func NewSchemaFixtureStore(db *sql.DB) *SchemaFixtureStore {
-	return &SchemaFixtureStore{kallax.NewStore(db)}
+func NewStoreFixtureStore(db *sql.DB) *StoreFixtureStore {
+	return &StoreFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *SchemaFixtureStore) GenericStore() *kallax.Store {
+func (s *StoreFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *SchemaFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *StoreFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *SchemaFixtureStore) Debug() *SchemaFixtureStore {
-	return &SchemaFixtureStore{s.Store.Debug()}
+func (s *StoreFixtureStore) Debug() *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *SchemaFixtureStore) DebugWith(logger kallax.LoggerFunc) *SchemaFixtureStore {
-	return &SchemaFixtureStore{s.Store.DebugWith(logger)}
+func (s *StoreFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *SchemaFixtureStore) DisableCacher() *SchemaFixtureStore {
-	return &SchemaFixtureStore{s.Store.DisableCacher()}
+func (s *StoreFixtureStore) DisableCacher() *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.DisableCacher()}
 }
 
-func (s *SchemaFixtureStore) relationshipRecords(record *SchemaFixture) []modelSaveFunc {
-	var result []modelSaveFunc
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *StoreFixtureStore) WithDialect(dialect kallax.Dialect) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.WithDialect(dialect)}
+}
 
-	if record.Nested != nil && !record.Nested.IsSaving() {
-		r := record.Nested
-		r.AddVirtualColumn("schema_fixture_id", record.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&SchemaFixtureStore{store}).Save(r)
-			return err
-		})
-	}
+// Insert inserts a StoreFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *StoreFixtureStore) Insert(record *StoreFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
 
-	return result
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *StoreFixtureStore) InsertContext(ctx context.Context, record *StoreFixture) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.InsertContext(ctx, Schema.StoreFixture.BaseSchema, record)
 }
 
-func (s *SchemaFixtureStore) inverseRecords(record *SchemaFixture) []modelSaveFunc {
-	var result []modelSaveFunc
+// InsertMany inserts all the given StoreFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *StoreFixtureStore) InsertMany(records []*StoreFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-	if record.Inverse != nil && !record.Inverse.IsSaving() {
-		record.AddVirtualColumn("rel_id", record.Inverse.GetID())
-		result = append(result, func(store *kallax.Store) error {
-			_, err := (&SchemaRelationshipFixtureStore{store}).Save(record.Inverse)
-			return err
-		})
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *StoreFixtureStore) InsertManyContext(ctx context.Context, records []*StoreFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
 	}
 
-	return result
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.StoreFixture.BaseSchema, recs...)
 }
 
-// Insert inserts a SchemaFixture in the database. A non-persisted object is
-// required for this operation.
-func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *StoreFixtureStore) Update(record *StoreFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *StoreFixtureStore) UpdateContext(ctx context.Context, record *StoreFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	records := s.relationshipRecords(record)
-
-	inverseRecords := s.inverseRecords(record)
-
-	if len(records) > 0 || len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+	return s.Store.UpdateContext(ctx, Schema.StoreFixture.BaseSchema, record, cols...)
+}
 
-			if err := s.Insert(Schema.SchemaFixture.BaseSchema, record); err != nil {
-				return err
-			}
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *StoreFixtureStore) Save(record *StoreFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *StoreFixtureStore) SaveContext(ctx context.Context, record *StoreFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
 
-			return nil
-		})
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
 	}
 
-	return s.Store.Insert(Schema.SchemaFixture.BaseSchema, record)
+	return rowsUpdated > 0, nil
 }
 
-// Update updates the given record on the database. If the columns are given,
-// only these columns will be updated. Otherwise all of them will be.
-// Be very careful with this, as you will have a potentially different object
-// in memory but not on the database.
-// Only writable records can be updated. Writable objects are those that have
-// been just inserted or retrieved using a query with no custom select fields.
-func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *StoreFixtureStore) Upsert(record *StoreFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *StoreFixtureStore) UpsertContext(ctx context.Context, record *StoreFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	records := s.relationshipRecords(record)
+	return s.Store.UpsertContext(ctx, Schema.StoreFixture.BaseSchema, record, conflictCols, updateCols...)
+}
 
-	inverseRecords := s.inverseRecords(record)
+// Delete removes the given record from the database.
+func (s *StoreFixtureStore) Delete(record *StoreFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
 
-	if len(records) > 0 || len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			for _, r := range inverseRecords {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *StoreFixtureStore) DeleteContext(ctx context.Context, record *StoreFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.StoreFixture.BaseSchema, record)
+}
 
-			updated, err = s.Update(Schema.SchemaFixture.BaseSchema, record, cols...)
-			if err != nil {
-				return err
-			}
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *StoreFixtureStore) UpdateWhere(q *StoreFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
 
-			for _, r := range records {
-				if err := r(s); err != nil {
-					return err
-				}
-			}
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *StoreFixtureStore) UpdateWhereContext(ctx context.Context, q *StoreFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
 
-			return nil
-		})
-		if err != nil {
-			return 0, err
-		}
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *StoreFixtureStore) UpdateWhereReturning(q *StoreFixtureQuery, values map[kallax.SchemaField]interface{}) (*StoreFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
 
-		return updated, nil
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *StoreFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *StoreFixtureQuery, values map[kallax.SchemaField]interface{}) (*StoreFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.Store.Update(Schema.SchemaFixture.BaseSchema, record, cols...)
+	return NewStoreFixtureResultSet(rs), nil
 }
 
-// Save inserts the object if the record is not persisted, otherwise it updates
-// it. Same rules of Update and Insert apply depending on the case.
-func (s *SchemaFixtureStore) Save(record *SchemaFixture) (updated bool, err error) {
-	if !record.IsPersisted() {
-		return false, s.Insert(record)
-	}
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *StoreFixtureStore) DeleteWhere(q *StoreFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *StoreFixtureStore) DeleteWhereContext(ctx context.Context, q *StoreFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *StoreFixtureStore) DeleteWhereReturning(q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
 
-	rowsUpdated, err := s.Update(record)
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *StoreFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return rowsUpdated > 0, nil
+	return NewStoreFixtureResultSet(rs), nil
 }
 
-// Delete removes the given record from the database.
-func (s *SchemaFixtureStore) Delete(record *SchemaFixture) error {
-	return s.Store.Delete(Schema.SchemaFixture.BaseSchema, record)
+// Find returns the set of results for the given query.
+func (s *StoreFixtureStore) Find(q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
 }
 
-// Find returns the set of results for the given query.
-func (s *SchemaFixtureStore) Find(q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *StoreFixtureStore) FindContext(ctx context.Context, q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewSchemaFixtureResultSet(rs), nil
+	return NewStoreFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *SchemaFixtureStore) MustFind(q *SchemaFixtureQuery) *SchemaFixtureResultSet {
-	return NewSchemaFixtureResultSet(s.Store.MustFind(q))
+func (s *StoreFixtureStore) MustFind(q *StoreFixtureQuery) *StoreFixtureResultSet {
+	return NewStoreFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *StoreFixtureStore) MustFindContext(ctx context.Context, q *StoreFixtureQuery) *StoreFixtureResultSet {
+	return NewStoreFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *SchemaFixtureStore) Count(q *SchemaFixtureQuery) (int64, error) {
+func (s *StoreFixtureStore) Count(q *StoreFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *StoreFixtureStore) CountContext(ctx context.Context, q *StoreFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *SchemaFixtureStore) MustCount(q *SchemaFixtureQuery) int64 {
+func (s *StoreFixtureStore) MustCount(q *StoreFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *StoreFixtureStore) MustCountContext(ctx context.Context, q *StoreFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *SchemaFixtureStore) FindOne(q *SchemaFixtureQuery) (*SchemaFixture, error) {
+func (s *StoreFixtureStore) FindOne(q *StoreFixtureQuery) (*StoreFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *StoreFixtureStore) FindOneContext(ctx context.Context, q *StoreFixtureQuery) (*StoreFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -10542,8 +20871,14 @@ func (s *SchemaFixtureStore) FindOne(q *SchemaFixtureQuery) (*SchemaFixture, err
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *SchemaFixtureStore) FindAll(q *SchemaFixtureQuery) ([]*SchemaFixture, error) {
-	rs, err := s.Find(q)
+func (s *StoreFixtureStore) FindAll(q *StoreFixtureQuery) ([]*StoreFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *StoreFixtureStore) FindAllContext(ctx context.Context, q *StoreFixtureQuery) ([]*StoreFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -10553,80 +20888,67 @@ func (s *SchemaFixtureStore) FindAll(q *SchemaFixtureQuery) ([]*SchemaFixture, e
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *SchemaFixtureStore) MustFindOne(q *SchemaFixtureQuery) *SchemaFixture {
-	record, err := s.FindOne(q)
+func (s *StoreFixtureStore) MustFindOne(q *StoreFixtureQuery) *StoreFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *StoreFixtureStore) MustFindOneContext(ctx context.Context, q *StoreFixtureQuery) *StoreFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the SchemaFixture with the data in the database and
+// Reload refreshes the StoreFixture with the data in the database and
 // makes it writable.
-func (s *SchemaFixtureStore) Reload(record *SchemaFixture) error {
-	return s.Store.Reload(Schema.SchemaFixture.BaseSchema, record)
+func (s *StoreFixtureStore) Reload(record *StoreFixture) error {
+	return s.Store.Reload(Schema.StoreFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *StoreFixtureStore) ReloadContext(ctx context.Context, record *StoreFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.StoreFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *SchemaFixtureStore) Transaction(callback func(*SchemaFixtureStore) error) error {
+func (s *StoreFixtureStore) Transaction(callback func(*StoreFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *StoreFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*StoreFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&SchemaFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&StoreFixtureStore{store})
 	})
 }
 
-// RemoveNested removes from the database the given relationship of the
-// model. It also resets the field Nested of the model.
-func (s *SchemaFixtureStore) RemoveNested(record *SchemaFixture) error {
-	var r kallax.Record = record.Nested
-	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
-		if err := beforeDeleter.BeforeDelete(); err != nil {
-			return err
-		}
-	}
-
-	var err error
-	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.SchemaFixture.BaseSchema, r)
-			if err != nil {
-				return err
-			}
-
-			return afterDeleter.AfterDelete()
-		})
-	} else {
-		err = s.Store.Delete(Schema.SchemaFixture.BaseSchema, r)
-	}
-	if err != nil {
-		return err
-	}
-
-	record.Nested = nil
-	return nil
-}
-
-// SchemaFixtureQuery is the object used to create queries for the SchemaFixture
+// StoreFixtureQuery is the object used to create queries for the StoreFixture
 // entity.
-type SchemaFixtureQuery struct {
+type StoreFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewSchemaFixtureQuery returns a new instance of SchemaFixtureQuery.
-func NewSchemaFixtureQuery() *SchemaFixtureQuery {
-	return &SchemaFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.SchemaFixture.BaseSchema),
+// NewStoreFixtureQuery returns a new instance of StoreFixtureQuery.
+func NewStoreFixtureQuery() *StoreFixtureQuery {
+	return &StoreFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.StoreFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *SchemaFixtureQuery) Select(columns ...kallax.SchemaField) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) Select(columns ...kallax.SchemaField) *StoreFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -10635,65 +20957,109 @@ func (q *SchemaFixtureQuery) Select(columns ...kallax.SchemaField) *SchemaFixtur
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *SchemaFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *SchemaFixtureQuery) Copy() *SchemaFixtureQuery {
-	return &SchemaFixtureQuery{
+func (q *StoreFixtureQuery) Copy() *StoreFixtureQuery {
+	return &StoreFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *SchemaFixtureQuery) Order(cols ...kallax.ColumnOrder) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *StoreFixtureQuery) After(cursor *kallax.Cursor) *StoreFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *StoreFixtureQuery) Before(cursor *kallax.Cursor) *StoreFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *SchemaFixtureQuery) BatchSize(size uint64) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) BatchSize(size uint64) *StoreFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *SchemaFixtureQuery) Limit(n uint64) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) Limit(n uint64) *StoreFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *SchemaFixtureQuery) Offset(n uint64) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) Offset(n uint64) *StoreFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *SchemaFixtureQuery) Where(cond kallax.Condition) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) Where(cond kallax.Condition) *StoreFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-func (q *SchemaFixtureQuery) WithNested() *SchemaFixtureQuery {
-	q.AddRelation(Schema.SchemaFixture.BaseSchema, "Nested", kallax.OneToOne, nil)
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *StoreFixtureQuery) GroupBy(cols ...kallax.SchemaField) *StoreFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
 	return q
 }
 
-func (q *SchemaFixtureQuery) WithInverse() *SchemaFixtureQuery {
-	q.AddRelation(Schema.SchemaRelationshipFixture.BaseSchema, "Inverse", kallax.OneToOne, nil)
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *StoreFixtureQuery) Having(cond kallax.Condition) *StoreFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *StoreFixtureQuery) ForUpdate() *StoreFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *StoreFixtureQuery) ForShare() *StoreFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *StoreFixtureQuery) NoWait() *StoreFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *StoreFixtureQuery) SkipLocked() *StoreFixtureQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *SchemaFixtureQuery) FindByID(v ...kallax.ULID) *SchemaFixtureQuery {
+func (q *StoreFixtureQuery) FindByID(v ...kallax.ULID) *StoreFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -10701,51 +21067,61 @@ func (q *SchemaFixtureQuery) FindByID(v ...kallax.ULID) *SchemaFixtureQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.SchemaFixture.ID, values...))
-}
-
-// FindByString adds a new filter to the query that will require that
-// the String property is equal to the passed value.
-func (q *SchemaFixtureQuery) FindByString(v string) *SchemaFixtureQuery {
-	return q.Where(kallax.Eq(Schema.SchemaFixture.String, v))
+	return q.Where(kallax.In(Schema.StoreFixture.ID, values...))
 }
 
-// FindByInt adds a new filter to the query that will require that
-// the Int property is equal to the passed value.
-func (q *SchemaFixtureQuery) FindByInt(cond kallax.ScalarCond, v int) *SchemaFixtureQuery {
-	return q.Where(cond(Schema.SchemaFixture.Int, v))
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *StoreFixtureQuery) FindByFoo(v string) *StoreFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreFixture.Foo, v))
 }
 
-// FindByInline adds a new filter to the query that will require that
-// the Inline property is equal to the passed value.
-func (q *SchemaFixtureQuery) FindByInline(v string) *SchemaFixtureQuery {
-	return q.Where(kallax.Eq(Schema.SchemaFixture.Inline, v))
+// FindBySliceProp adds a new filter to the query that will require that
+// the SliceProp property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *StoreFixtureQuery) FindBySliceProp(v ...string) *StoreFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.StoreFixture.SliceProp, values...))
 }
 
-// FindByInverse adds a new filter to the query that will require that
-// the foreign key of Inverse is equal to the passed value.
-func (q *SchemaFixtureQuery) FindByInverse(v kallax.ULID) *SchemaFixtureQuery {
-	return q.Where(kallax.Eq(Schema.SchemaFixture.InverseFK, v))
+// FindByAliasSliceProp adds a new filter to the query that will require that
+// the AliasSliceProp property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *StoreFixtureQuery) FindByAliasSliceProp(v ...string) *StoreFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.StoreFixture.AliasSliceProp, values...))
 }
 
-// SchemaFixtureResultSet is the set of results returned by a query to the
+// StoreFixtureResultSet is the set of results returned by a query to the
 // database.
-type SchemaFixtureResultSet struct {
+type StoreFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *SchemaFixture
+	last      *StoreFixture
 	lastErr   error
 }
 
-// NewSchemaFixtureResultSet creates a new result set for rows of the type
-// SchemaFixture.
-func NewSchemaFixtureResultSet(rs kallax.ResultSet) *SchemaFixtureResultSet {
-	return &SchemaFixtureResultSet{ResultSet: rs}
+// NewStoreFixtureResultSet creates a new result set for rows of the type
+// StoreFixture.
+func NewStoreFixtureResultSet(rs kallax.ResultSet) *StoreFixtureResultSet {
+	return &StoreFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *SchemaFixtureResultSet) Next() bool {
+func (rs *StoreFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -10753,14 +21129,14 @@ func (rs *SchemaFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.SchemaFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*SchemaFixture)
+		rs.last, ok = record.(*StoreFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SchemaFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreFixture")
 			rs.last = nil
 		}
 	}
@@ -10769,7 +21145,7 @@ func (rs *SchemaFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *SchemaFixtureResultSet) Get() (*SchemaFixture, error) {
+func (rs *StoreFixtureResultSet) Get() (*StoreFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -10777,7 +21153,7 @@ func (rs *SchemaFixtureResultSet) Get() (*SchemaFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *SchemaFixtureResultSet) ForEach(fn func(*SchemaFixture) error) error {
+func (rs *StoreFixtureResultSet) ForEach(fn func(*StoreFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -10796,8 +21172,8 @@ func (rs *SchemaFixtureResultSet) ForEach(fn func(*SchemaFixture) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *SchemaFixtureResultSet) All() ([]*SchemaFixture, error) {
-	var result []*SchemaFixture
+func (rs *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
+	var result []*StoreFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -10809,7 +21185,7 @@ func (rs *SchemaFixtureResultSet) All() ([]*SchemaFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *SchemaFixtureResultSet) One() (*SchemaFixture, error) {
+func (rs *StoreFixtureResultSet) One() (*StoreFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -10826,105 +21202,152 @@ func (rs *SchemaFixtureResultSet) One() (*SchemaFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *StoreFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *SchemaFixtureResultSet) Err() error {
+func (rs *StoreFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *SchemaFixtureResultSet) Close() error {
+func (rs *StoreFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewSchemaRelationshipFixture returns a new instance of SchemaRelationshipFixture.
-func NewSchemaRelationshipFixture() (record *SchemaRelationshipFixture) {
-	return new(SchemaRelationshipFixture)
+// NewStoreWithConstructFixture returns a new instance of StoreWithConstructFixture.
+func NewStoreWithConstructFixture(f string) (record *StoreWithConstructFixture) {
+	return newStoreWithConstructFixture(f)
 }
 
 // GetID returns the primary key of the model.
-func (r *SchemaRelationshipFixture) GetID() kallax.Identifier {
+func (r *StoreWithConstructFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *SchemaRelationshipFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *StoreWithConstructFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
+	case "foo":
+		return &r.Foo, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in SchemaRelationshipFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *SchemaRelationshipFixture) Value(col string) (interface{}, error) {
+func (r *StoreWithConstructFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
+	case "foo":
+		return r.Foo, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in SchemaRelationshipFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *SchemaRelationshipFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model SchemaRelationshipFixture has no relationships")
+func (r *StoreWithConstructFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *SchemaRelationshipFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model SchemaRelationshipFixture has no relationships")
+func (r *StoreWithConstructFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
 }
 
-// SchemaRelationshipFixtureStore is the entity to access the records of the type SchemaRelationshipFixture
+// StoreWithConstructFixtureStore is the entity to access the records of the type StoreWithConstructFixture
 // in the database.
-type SchemaRelationshipFixtureStore struct {
+type StoreWithConstructFixtureStore struct {
 	*kallax.Store
 }
 
-// NewSchemaRelationshipFixtureStore creates a new instance of SchemaRelationshipFixtureStore
+// NewStoreWithConstructFixtureStore creates a new instance of StoreWithConstructFixtureStore
 // using a SQL database.
-func NewSchemaRelationshipFixtureStore(db *sql.DB) *SchemaRelationshipFixtureStore {
-	return &SchemaRelationshipFixtureStore{kallax.NewStore(db)}
+func NewStoreWithConstructFixtureStore(db *sql.DB) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *SchemaRelationshipFixtureStore) GenericStore() *kallax.Store {
+func (s *StoreWithConstructFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *SchemaRelationshipFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *StoreWithConstructFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *SchemaRelationshipFixtureStore) Debug() *SchemaRelationshipFixtureStore {
-	return &SchemaRelationshipFixtureStore{s.Store.Debug()}
+func (s *StoreWithConstructFixtureStore) Debug() *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *SchemaRelationshipFixtureStore) DebugWith(logger kallax.LoggerFunc) *SchemaRelationshipFixtureStore {
-	return &SchemaRelationshipFixtureStore{s.Store.DebugWith(logger)}
+func (s *StoreWithConstructFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *SchemaRelationshipFixtureStore) DisableCacher() *SchemaRelationshipFixtureStore {
-	return &SchemaRelationshipFixtureStore{s.Store.DisableCacher()}
+func (s *StoreWithConstructFixtureStore) DisableCacher() *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a SchemaRelationshipFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *StoreWithConstructFixtureStore) WithDialect(dialect kallax.Dialect) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a StoreWithConstructFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *SchemaRelationshipFixtureStore) Insert(record *SchemaRelationshipFixture) error {
+func (s *StoreWithConstructFixtureStore) Insert(record *StoreWithConstructFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *StoreWithConstructFixtureStore) InsertContext(ctx context.Context, record *StoreWithConstructFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.SchemaRelationshipFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given StoreWithConstructFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *StoreWithConstructFixtureStore) InsertMany(records []*StoreWithConstructFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *StoreWithConstructFixtureStore) InsertManyContext(ctx context.Context, records []*StoreWithConstructFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -10933,21 +21356,33 @@ func (s *SchemaRelationshipFixtureStore) Insert(record *SchemaRelationshipFixtur
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *SchemaRelationshipFixtureStore) Update(record *SchemaRelationshipFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *StoreWithConstructFixtureStore) Update(record *StoreWithConstructFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *StoreWithConstructFixtureStore) UpdateContext(ctx context.Context, record *StoreWithConstructFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.SchemaRelationshipFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *SchemaRelationshipFixtureStore) Save(record *SchemaRelationshipFixture) (updated bool, err error) {
+func (s *StoreWithConstructFixtureStore) Save(record *StoreWithConstructFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *StoreWithConstructFixtureStore) SaveContext(ctx context.Context, record *StoreWithConstructFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -10955,45 +21390,159 @@ func (s *SchemaRelationshipFixtureStore) Save(record *SchemaRelationshipFixture)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *StoreWithConstructFixtureStore) Upsert(record *StoreWithConstructFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *StoreWithConstructFixtureStore) UpsertContext(ctx context.Context, record *StoreWithConstructFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *SchemaRelationshipFixtureStore) Delete(record *SchemaRelationshipFixture) error {
-	return s.Store.Delete(Schema.SchemaRelationshipFixture.BaseSchema, record)
+func (s *StoreWithConstructFixtureStore) Delete(record *StoreWithConstructFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *StoreWithConstructFixtureStore) DeleteContext(ctx context.Context, record *StoreWithConstructFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *StoreWithConstructFixtureStore) UpdateWhere(q *StoreWithConstructFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *StoreWithConstructFixtureStore) UpdateWhereContext(ctx context.Context, q *StoreWithConstructFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *StoreWithConstructFixtureStore) UpdateWhereReturning(q *StoreWithConstructFixtureQuery, values map[kallax.SchemaField]interface{}) (*StoreWithConstructFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *StoreWithConstructFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *StoreWithConstructFixtureQuery, values map[kallax.SchemaField]interface{}) (*StoreWithConstructFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStoreWithConstructFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *StoreWithConstructFixtureStore) DeleteWhere(q *StoreWithConstructFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *StoreWithConstructFixtureStore) DeleteWhereContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *StoreWithConstructFixtureStore) DeleteWhereReturning(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *StoreWithConstructFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStoreWithConstructFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *SchemaRelationshipFixtureStore) Find(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *StoreWithConstructFixtureStore) Find(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *StoreWithConstructFixtureStore) FindContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewSchemaRelationshipFixtureResultSet(rs), nil
+	return NewStoreWithConstructFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *SchemaRelationshipFixtureStore) MustFind(q *SchemaRelationshipFixtureQuery) *SchemaRelationshipFixtureResultSet {
-	return NewSchemaRelationshipFixtureResultSet(s.Store.MustFind(q))
+func (s *StoreWithConstructFixtureStore) MustFind(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
+	return NewStoreWithConstructFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *StoreWithConstructFixtureStore) MustFindContext(ctx context.Context, q *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
+	return NewStoreWithConstructFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *SchemaRelationshipFixtureStore) Count(q *SchemaRelationshipFixtureQuery) (int64, error) {
+func (s *StoreWithConstructFixtureStore) Count(q *StoreWithConstructFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *StoreWithConstructFixtureStore) CountContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *SchemaRelationshipFixtureStore) MustCount(q *SchemaRelationshipFixtureQuery) int64 {
+func (s *StoreWithConstructFixtureStore) MustCount(q *StoreWithConstructFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *StoreWithConstructFixtureStore) MustCountContext(ctx context.Context, q *StoreWithConstructFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *SchemaRelationshipFixtureStore) FindOne(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixture, error) {
+func (s *StoreWithConstructFixtureStore) FindOne(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *StoreWithConstructFixtureStore) FindOneContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -11015,8 +21564,14 @@ func (s *SchemaRelationshipFixtureStore) FindOne(q *SchemaRelationshipFixtureQue
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *SchemaRelationshipFixtureStore) FindAll(q *SchemaRelationshipFixtureQuery) ([]*SchemaRelationshipFixture, error) {
-	rs, err := s.Find(q)
+func (s *StoreWithConstructFixtureStore) FindAll(q *StoreWithConstructFixtureQuery) ([]*StoreWithConstructFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *StoreWithConstructFixtureStore) FindAllContext(ctx context.Context, q *StoreWithConstructFixtureQuery) ([]*StoreWithConstructFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -11026,49 +21581,67 @@ func (s *SchemaRelationshipFixtureStore) FindAll(q *SchemaRelationshipFixtureQue
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *SchemaRelationshipFixtureStore) MustFindOne(q *SchemaRelationshipFixtureQuery) *SchemaRelationshipFixture {
-	record, err := s.FindOne(q)
+func (s *StoreWithConstructFixtureStore) MustFindOne(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *StoreWithConstructFixtureStore) MustFindOneContext(ctx context.Context, q *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the SchemaRelationshipFixture with the data in the database and
+// Reload refreshes the StoreWithConstructFixture with the data in the database and
 // makes it writable.
-func (s *SchemaRelationshipFixtureStore) Reload(record *SchemaRelationshipFixture) error {
-	return s.Store.Reload(Schema.SchemaRelationshipFixture.BaseSchema, record)
+func (s *StoreWithConstructFixtureStore) Reload(record *StoreWithConstructFixture) error {
+	return s.Store.Reload(Schema.StoreWithConstructFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *StoreWithConstructFixtureStore) ReloadContext(ctx context.Context, record *StoreWithConstructFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *SchemaRelationshipFixtureStore) Transaction(callback func(*SchemaRelationshipFixtureStore) error) error {
+func (s *StoreWithConstructFixtureStore) Transaction(callback func(*StoreWithConstructFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *StoreWithConstructFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*StoreWithConstructFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&SchemaRelationshipFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&StoreWithConstructFixtureStore{store})
 	})
 }
 
-// SchemaRelationshipFixtureQuery is the object used to create queries for the SchemaRelationshipFixture
+// StoreWithConstructFixtureQuery is the object used to create queries for the StoreWithConstructFixture
 // entity.
-type SchemaRelationshipFixtureQuery struct {
+type StoreWithConstructFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewSchemaRelationshipFixtureQuery returns a new instance of SchemaRelationshipFixtureQuery.
-func NewSchemaRelationshipFixtureQuery() *SchemaRelationshipFixtureQuery {
-	return &SchemaRelationshipFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.SchemaRelationshipFixture.BaseSchema),
+// NewStoreWithConstructFixtureQuery returns a new instance of StoreWithConstructFixtureQuery.
+func NewStoreWithConstructFixtureQuery() *StoreWithConstructFixtureQuery {
+	return &StoreWithConstructFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithConstructFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *SchemaRelationshipFixtureQuery) Select(columns ...kallax.SchemaField) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -11077,55 +21650,109 @@ func (q *SchemaRelationshipFixtureQuery) Select(columns ...kallax.SchemaField) *
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *SchemaRelationshipFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *SchemaRelationshipFixtureQuery) Copy() *SchemaRelationshipFixtureQuery {
-	return &SchemaRelationshipFixtureQuery{
+func (q *StoreWithConstructFixtureQuery) Copy() *StoreWithConstructFixtureQuery {
+	return &StoreWithConstructFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *SchemaRelationshipFixtureQuery) Order(cols ...kallax.ColumnOrder) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *StoreWithConstructFixtureQuery) After(cursor *kallax.Cursor) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *StoreWithConstructFixtureQuery) Before(cursor *kallax.Cursor) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *SchemaRelationshipFixtureQuery) BatchSize(size uint64) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) BatchSize(size uint64) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *SchemaRelationshipFixtureQuery) Limit(n uint64) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Limit(n uint64) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *SchemaRelationshipFixtureQuery) Offset(n uint64) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Offset(n uint64) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *SchemaRelationshipFixtureQuery) Where(cond kallax.Condition) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Where(cond kallax.Condition) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *StoreWithConstructFixtureQuery) GroupBy(cols ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *StoreWithConstructFixtureQuery) Having(cond kallax.Condition) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *StoreWithConstructFixtureQuery) ForUpdate() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *StoreWithConstructFixtureQuery) ForShare() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *StoreWithConstructFixtureQuery) NoWait() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *StoreWithConstructFixtureQuery) SkipLocked() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *SchemaRelationshipFixtureQuery) FindByID(v ...kallax.ULID) *SchemaRelationshipFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithConstructFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -11133,27 +21760,33 @@ func (q *SchemaRelationshipFixtureQuery) FindByID(v ...kallax.ULID) *SchemaRelat
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.SchemaRelationshipFixture.ID, values...))
+	return q.Where(kallax.In(Schema.StoreWithConstructFixture.ID, values...))
 }
 
-// SchemaRelationshipFixtureResultSet is the set of results returned by a query to the
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *StoreWithConstructFixtureQuery) FindByFoo(v string) *StoreWithConstructFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, v))
+}
+
+// StoreWithConstructFixtureResultSet is the set of results returned by a query to the
 // database.
-type SchemaRelationshipFixtureResultSet struct {
+type StoreWithConstructFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *SchemaRelationshipFixture
+	last      *StoreWithConstructFixture
 	lastErr   error
 }
-
-// NewSchemaRelationshipFixtureResultSet creates a new result set for rows of the type
-// SchemaRelationshipFixture.
-func NewSchemaRelationshipFixtureResultSet(rs kallax.ResultSet) *SchemaRelationshipFixtureResultSet {
-	return &SchemaRelationshipFixtureResultSet{ResultSet: rs}
+
+// NewStoreWithConstructFixtureResultSet creates a new result set for rows of the type
+// StoreWithConstructFixture.
+func NewStoreWithConstructFixtureResultSet(rs kallax.ResultSet) *StoreWithConstructFixtureResultSet {
+	return &StoreWithConstructFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *SchemaRelationshipFixtureResultSet) Next() bool {
+func (rs *StoreWithConstructFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -11161,14 +21794,14 @@ func (rs *SchemaRelationshipFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.SchemaRelationshipFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithConstructFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*SchemaRelationshipFixture)
+		rs.last, ok = record.(*StoreWithConstructFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SchemaRelationshipFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithConstructFixture")
 			rs.last = nil
 		}
 	}
@@ -11177,7 +21810,7 @@ func (rs *SchemaRelationshipFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *SchemaRelationshipFixtureResultSet) Get() (*SchemaRelationshipFixture, error) {
+func (rs *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -11185,7 +21818,7 @@ func (rs *SchemaRelationshipFixtureResultSet) Get() (*SchemaRelationshipFixture,
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *SchemaRelationshipFixtureResultSet) ForEach(fn func(*SchemaRelationshipFixture) error) error {
+func (rs *StoreWithConstructFixtureResultSet) ForEach(fn func(*StoreWithConstructFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -11204,8 +21837,8 @@ func (rs *SchemaRelationshipFixtureResultSet) ForEach(fn func(*SchemaRelationshi
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *SchemaRelationshipFixtureResultSet) All() ([]*SchemaRelationshipFixture, error) {
-	var result []*SchemaRelationshipFixture
+func (rs *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixture, error) {
+	var result []*StoreWithConstructFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -11217,7 +21850,7 @@ func (rs *SchemaRelationshipFixtureResultSet) All() ([]*SchemaRelationshipFixtur
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *SchemaRelationshipFixtureResultSet) One() (*SchemaRelationshipFixture, error) {
+func (rs *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -11234,117 +21867,156 @@ func (rs *SchemaRelationshipFixtureResultSet) One() (*SchemaRelationshipFixture,
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *StoreWithConstructFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *SchemaRelationshipFixtureResultSet) Err() error {
+func (rs *StoreWithConstructFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *SchemaRelationshipFixtureResultSet) Close() error {
+func (rs *StoreWithConstructFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewStoreFixture returns a new instance of StoreFixture.
-func NewStoreFixture() (record *StoreFixture) {
-	return newStoreFixture()
+// NewStoreWithNewFixture returns a new instance of StoreWithNewFixture.
+func NewStoreWithNewFixture() (record *StoreWithNewFixture) {
+	return newStoreWithNewFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *StoreFixture) GetID() kallax.Identifier {
+func (r *StoreWithNewFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *StoreFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *StoreWithNewFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
 	case "foo":
 		return &r.Foo, nil
-	case "slice_prop":
-		return types.Slice(&r.SliceProp), nil
-	case "alias_slice_prop":
-		return types.Slice((*[]string)(&r.AliasSliceProp)), nil
+	case "bar":
+		return &r.Bar, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *StoreFixture) Value(col string) (interface{}, error) {
+func (r *StoreWithNewFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
 	case "foo":
 		return r.Foo, nil
-	case "slice_prop":
-		return types.Slice(r.SliceProp), nil
-	case "alias_slice_prop":
-		return types.Slice(r.AliasSliceProp), nil
+	case "bar":
+		return r.Bar, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *StoreFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model StoreFixture has no relationships")
+func (r *StoreWithNewFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *StoreFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model StoreFixture has no relationships")
+func (r *StoreWithNewFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
 }
 
-// StoreFixtureStore is the entity to access the records of the type StoreFixture
+// StoreWithNewFixtureStore is the entity to access the records of the type StoreWithNewFixture
 // in the database.
-type StoreFixtureStore struct {
+type StoreWithNewFixtureStore struct {
 	*kallax.Store
 }
 
-// NewStoreFixtureStore creates a new instance of StoreFixtureStore
+// NewStoreWithNewFixtureStore creates a new instance of StoreWithNewFixtureStore
 // using a SQL database.
-func NewStoreFixtureStore(db *sql.DB) *StoreFixtureStore {
-	return &StoreFixtureStore{kallax.NewStore(db)}
+func NewStoreWithNewFixtureStore(db *sql.DB) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *StoreFixtureStore) GenericStore() *kallax.Store {
+func (s *StoreWithNewFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *StoreFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *StoreWithNewFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *StoreFixtureStore) Debug() *StoreFixtureStore {
-	return &StoreFixtureStore{s.Store.Debug()}
+func (s *StoreWithNewFixtureStore) Debug() *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *StoreFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreFixtureStore {
-	return &StoreFixtureStore{s.Store.DebugWith(logger)}
+func (s *StoreWithNewFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *StoreFixtureStore) DisableCacher() *StoreFixtureStore {
-	return &StoreFixtureStore{s.Store.DisableCacher()}
+func (s *StoreWithNewFixtureStore) DisableCacher() *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a StoreFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *StoreWithNewFixtureStore) WithDialect(dialect kallax.Dialect) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a StoreWithNewFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *StoreFixtureStore) Insert(record *StoreFixture) error {
+func (s *StoreWithNewFixtureStore) Insert(record *StoreWithNewFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *StoreWithNewFixtureStore) InsertContext(ctx context.Context, record *StoreWithNewFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.StoreFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given StoreWithNewFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *StoreWithNewFixtureStore) InsertMany(records []*StoreWithNewFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *StoreWithNewFixtureStore) InsertManyContext(ctx context.Context, records []*StoreWithNewFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.StoreWithNewFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -11353,21 +22025,33 @@ func (s *StoreFixtureStore) Insert(record *StoreFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *StoreFixtureStore) Update(record *StoreFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *StoreWithNewFixtureStore) Update(record *StoreWithNewFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *StoreWithNewFixtureStore) UpdateContext(ctx context.Context, record *StoreWithNewFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.StoreFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *StoreFixtureStore) Save(record *StoreFixture) (updated bool, err error) {
+func (s *StoreWithNewFixtureStore) Save(record *StoreWithNewFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *StoreWithNewFixtureStore) SaveContext(ctx context.Context, record *StoreWithNewFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -11375,45 +22059,159 @@ func (s *StoreFixtureStore) Save(record *StoreFixture) (updated bool, err error)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *StoreWithNewFixtureStore) Upsert(record *StoreWithNewFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *StoreWithNewFixtureStore) UpsertContext(ctx context.Context, record *StoreWithNewFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *StoreFixtureStore) Delete(record *StoreFixture) error {
-	return s.Store.Delete(Schema.StoreFixture.BaseSchema, record)
+func (s *StoreWithNewFixtureStore) Delete(record *StoreWithNewFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *StoreWithNewFixtureStore) DeleteContext(ctx context.Context, record *StoreWithNewFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *StoreWithNewFixtureStore) UpdateWhere(q *StoreWithNewFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *StoreWithNewFixtureStore) UpdateWhereContext(ctx context.Context, q *StoreWithNewFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *StoreWithNewFixtureStore) UpdateWhereReturning(q *StoreWithNewFixtureQuery, values map[kallax.SchemaField]interface{}) (*StoreWithNewFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *StoreWithNewFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *StoreWithNewFixtureQuery, values map[kallax.SchemaField]interface{}) (*StoreWithNewFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStoreWithNewFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *StoreWithNewFixtureStore) DeleteWhere(q *StoreWithNewFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *StoreWithNewFixtureStore) DeleteWhereContext(ctx context.Context, q *StoreWithNewFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *StoreWithNewFixtureStore) DeleteWhereReturning(q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *StoreWithNewFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStoreWithNewFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *StoreFixtureStore) Find(q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *StoreWithNewFixtureStore) Find(q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *StoreWithNewFixtureStore) FindContext(ctx context.Context, q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStoreFixtureResultSet(rs), nil
+	return NewStoreWithNewFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *StoreFixtureStore) MustFind(q *StoreFixtureQuery) *StoreFixtureResultSet {
-	return NewStoreFixtureResultSet(s.Store.MustFind(q))
+func (s *StoreWithNewFixtureStore) MustFind(q *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
+	return NewStoreWithNewFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *StoreWithNewFixtureStore) MustFindContext(ctx context.Context, q *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
+	return NewStoreWithNewFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *StoreFixtureStore) Count(q *StoreFixtureQuery) (int64, error) {
+func (s *StoreWithNewFixtureStore) Count(q *StoreWithNewFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *StoreWithNewFixtureStore) CountContext(ctx context.Context, q *StoreWithNewFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *StoreFixtureStore) MustCount(q *StoreFixtureQuery) int64 {
+func (s *StoreWithNewFixtureStore) MustCount(q *StoreWithNewFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *StoreWithNewFixtureStore) MustCountContext(ctx context.Context, q *StoreWithNewFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *StoreFixtureStore) FindOne(q *StoreFixtureQuery) (*StoreFixture, error) {
+func (s *StoreWithNewFixtureStore) FindOne(q *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *StoreWithNewFixtureStore) FindOneContext(ctx context.Context, q *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -11435,8 +22233,14 @@ func (s *StoreFixtureStore) FindOne(q *StoreFixtureQuery) (*StoreFixture, error)
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *StoreFixtureStore) FindAll(q *StoreFixtureQuery) ([]*StoreFixture, error) {
-	rs, err := s.Find(q)
+func (s *StoreWithNewFixtureStore) FindAll(q *StoreWithNewFixtureQuery) ([]*StoreWithNewFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *StoreWithNewFixtureStore) FindAllContext(ctx context.Context, q *StoreWithNewFixtureQuery) ([]*StoreWithNewFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -11446,49 +22250,67 @@ func (s *StoreFixtureStore) FindAll(q *StoreFixtureQuery) ([]*StoreFixture, erro
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *StoreFixtureStore) MustFindOne(q *StoreFixtureQuery) *StoreFixture {
-	record, err := s.FindOne(q)
+func (s *StoreWithNewFixtureStore) MustFindOne(q *StoreWithNewFixtureQuery) *StoreWithNewFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *StoreWithNewFixtureStore) MustFindOneContext(ctx context.Context, q *StoreWithNewFixtureQuery) *StoreWithNewFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the StoreFixture with the data in the database and
+// Reload refreshes the StoreWithNewFixture with the data in the database and
 // makes it writable.
-func (s *StoreFixtureStore) Reload(record *StoreFixture) error {
-	return s.Store.Reload(Schema.StoreFixture.BaseSchema, record)
+func (s *StoreWithNewFixtureStore) Reload(record *StoreWithNewFixture) error {
+	return s.Store.Reload(Schema.StoreWithNewFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *StoreWithNewFixtureStore) ReloadContext(ctx context.Context, record *StoreWithNewFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *StoreFixtureStore) Transaction(callback func(*StoreFixtureStore) error) error {
+func (s *StoreWithNewFixtureStore) Transaction(callback func(*StoreWithNewFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *StoreWithNewFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*StoreWithNewFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&StoreFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&StoreWithNewFixtureStore{store})
 	})
 }
 
-// StoreFixtureQuery is the object used to create queries for the StoreFixture
+// StoreWithNewFixtureQuery is the object used to create queries for the StoreWithNewFixture
 // entity.
-type StoreFixtureQuery struct {
+type StoreWithNewFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewStoreFixtureQuery returns a new instance of StoreFixtureQuery.
-func NewStoreFixtureQuery() *StoreFixtureQuery {
-	return &StoreFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.StoreFixture.BaseSchema),
+// NewStoreWithNewFixtureQuery returns a new instance of StoreWithNewFixtureQuery.
+func NewStoreWithNewFixtureQuery() *StoreWithNewFixtureQuery {
+	return &StoreWithNewFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithNewFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *StoreFixtureQuery) Select(columns ...kallax.SchemaField) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -11497,55 +22319,109 @@ func (q *StoreFixtureQuery) Select(columns ...kallax.SchemaField) *StoreFixtureQ
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *StoreFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *StoreFixtureQuery) Copy() *StoreFixtureQuery {
-	return &StoreFixtureQuery{
+func (q *StoreWithNewFixtureQuery) Copy() *StoreWithNewFixtureQuery {
+	return &StoreWithNewFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *StoreFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *StoreWithNewFixtureQuery) After(cursor *kallax.Cursor) *StoreWithNewFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *StoreWithNewFixtureQuery) Before(cursor *kallax.Cursor) *StoreWithNewFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *StoreFixtureQuery) BatchSize(size uint64) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) BatchSize(size uint64) *StoreWithNewFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *StoreFixtureQuery) Limit(n uint64) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Limit(n uint64) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *StoreFixtureQuery) Offset(n uint64) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Offset(n uint64) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
-// Where adds a condition to the query. All conditions added are concatenated
-// using a logical AND.
-func (q *StoreFixtureQuery) Where(cond kallax.Condition) *StoreFixtureQuery {
-	q.BaseQuery.Where(cond)
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *StoreWithNewFixtureQuery) Where(cond kallax.Condition) *StoreWithNewFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *StoreWithNewFixtureQuery) GroupBy(cols ...kallax.SchemaField) *StoreWithNewFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *StoreWithNewFixtureQuery) Having(cond kallax.Condition) *StoreWithNewFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *StoreWithNewFixtureQuery) ForUpdate() *StoreWithNewFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *StoreWithNewFixtureQuery) ForShare() *StoreWithNewFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *StoreWithNewFixtureQuery) NoWait() *StoreWithNewFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *StoreWithNewFixtureQuery) SkipLocked() *StoreWithNewFixtureQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *StoreFixtureQuery) FindByID(v ...kallax.ULID) *StoreFixtureQuery {
+func (q *StoreWithNewFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithNewFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -11553,61 +22429,39 @@ func (q *StoreFixtureQuery) FindByID(v ...kallax.ULID) *StoreFixtureQuery {
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.StoreFixture.ID, values...))
+	return q.Where(kallax.In(Schema.StoreWithNewFixture.ID, values...))
 }
 
 // FindByFoo adds a new filter to the query that will require that
 // the Foo property is equal to the passed value.
-func (q *StoreFixtureQuery) FindByFoo(v string) *StoreFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreFixture.Foo, v))
-}
-
-// FindBySliceProp adds a new filter to the query that will require that
-// the SliceProp property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *StoreFixtureQuery) FindBySliceProp(v ...string) *StoreFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.ArrayContains(Schema.StoreFixture.SliceProp, values...))
+func (q *StoreWithNewFixtureQuery) FindByFoo(v string) *StoreWithNewFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Foo, v))
 }
 
-// FindByAliasSliceProp adds a new filter to the query that will require that
-// the AliasSliceProp property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *StoreFixtureQuery) FindByAliasSliceProp(v ...string) *StoreFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.ArrayContains(Schema.StoreFixture.AliasSliceProp, values...))
+// FindByBar adds a new filter to the query that will require that
+// the Bar property is equal to the passed value.
+func (q *StoreWithNewFixtureQuery) FindByBar(v string) *StoreWithNewFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Bar, v))
 }
 
-// StoreFixtureResultSet is the set of results returned by a query to the
+// StoreWithNewFixtureResultSet is the set of results returned by a query to the
 // database.
-type StoreFixtureResultSet struct {
+type StoreWithNewFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *StoreFixture
+	last      *StoreWithNewFixture
 	lastErr   error
 }
 
-// NewStoreFixtureResultSet creates a new result set for rows of the type
-// StoreFixture.
-func NewStoreFixtureResultSet(rs kallax.ResultSet) *StoreFixtureResultSet {
-	return &StoreFixtureResultSet{ResultSet: rs}
+// NewStoreWithNewFixtureResultSet creates a new result set for rows of the type
+// StoreWithNewFixture.
+func NewStoreWithNewFixtureResultSet(rs kallax.ResultSet) *StoreWithNewFixtureResultSet {
+	return &StoreWithNewFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *StoreFixtureResultSet) Next() bool {
+func (rs *StoreWithNewFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -11615,14 +22469,14 @@ func (rs *StoreFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithNewFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*StoreFixture)
+		rs.last, ok = record.(*StoreWithNewFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithNewFixture")
 			rs.last = nil
 		}
 	}
@@ -11631,7 +22485,7 @@ func (rs *StoreFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *StoreFixtureResultSet) Get() (*StoreFixture, error) {
+func (rs *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -11639,7 +22493,7 @@ func (rs *StoreFixtureResultSet) Get() (*StoreFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *StoreFixtureResultSet) ForEach(fn func(*StoreFixture) error) error {
+func (rs *StoreWithNewFixtureResultSet) ForEach(fn func(*StoreWithNewFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -11658,8 +22512,8 @@ func (rs *StoreFixtureResultSet) ForEach(fn func(*StoreFixture) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
-	var result []*StoreFixture
+func (rs *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
+	var result []*StoreWithNewFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -11671,7 +22525,7 @@ func (rs *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *StoreFixtureResultSet) One() (*StoreFixture, error) {
+func (rs *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -11688,109 +22542,175 @@ func (rs *StoreFixtureResultSet) One() (*StoreFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *StoreWithNewFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *StoreFixtureResultSet) Err() error {
+func (rs *StoreWithNewFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *StoreFixtureResultSet) Close() error {
+func (rs *StoreWithNewFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewStoreWithConstructFixture returns a new instance of StoreWithConstructFixture.
-func NewStoreWithConstructFixture(f string) (record *StoreWithConstructFixture) {
-	return newStoreWithConstructFixture(f)
+// NewTag returns a new instance of Tag.
+func NewTag(name string) (record *Tag) {
+	return newTag(name)
 }
 
 // GetID returns the primary key of the model.
-func (r *StoreWithConstructFixture) GetID() kallax.Identifier {
+func (r *Tag) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *StoreWithConstructFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *Tag) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
-	case "foo":
-		return &r.Foo, nil
+	case "name":
+		return &r.Name, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in Tag: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *StoreWithConstructFixture) Value(col string) (interface{}, error) {
+func (r *Tag) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
-	case "foo":
-		return r.Foo, nil
+	case "name":
+		return r.Name, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in Tag: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *StoreWithConstructFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
+func (r *Tag) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Posts":
+		return new(Post), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model Tag has no relationship %s", field)
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *StoreWithConstructFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
+func (r *Tag) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Posts":
+		records, ok := rel.([]kallax.Record)
+		if !ok {
+			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
+		}
+
+		r.Posts = make([]Post, len(records))
+		for i, record := range records {
+			rel, ok := record.(*Post)
+			if !ok {
+				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
+			}
+			r.Posts[i] = *rel
+		}
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model Tag has no relationship %s", field)
 }
 
-// StoreWithConstructFixtureStore is the entity to access the records of the type StoreWithConstructFixture
+// TagStore is the entity to access the records of the type Tag
 // in the database.
-type StoreWithConstructFixtureStore struct {
+type TagStore struct {
 	*kallax.Store
 }
 
-// NewStoreWithConstructFixtureStore creates a new instance of StoreWithConstructFixtureStore
+// NewTagStore creates a new instance of TagStore
 // using a SQL database.
-func NewStoreWithConstructFixtureStore(db *sql.DB) *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{kallax.NewStore(db)}
+func NewTagStore(db *sql.DB) *TagStore {
+	return &TagStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *StoreWithConstructFixtureStore) GenericStore() *kallax.Store {
+func (s *TagStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *StoreWithConstructFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *TagStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *StoreWithConstructFixtureStore) Debug() *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{s.Store.Debug()}
+func (s *TagStore) Debug() *TagStore {
+	return &TagStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *StoreWithConstructFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{s.Store.DebugWith(logger)}
+func (s *TagStore) DebugWith(logger kallax.LoggerFunc) *TagStore {
+	return &TagStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *StoreWithConstructFixtureStore) DisableCacher() *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{s.Store.DisableCacher()}
+func (s *TagStore) DisableCacher() *TagStore {
+	return &TagStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a StoreWithConstructFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *TagStore) WithDialect(dialect kallax.Dialect) *TagStore {
+	return &TagStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a Tag in the database. A non-persisted object is
 // required for this operation.
-func (s *StoreWithConstructFixtureStore) Insert(record *StoreWithConstructFixture) error {
+func (s *TagStore) Insert(record *Tag) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *TagStore) InsertContext(ctx context.Context, record *Tag) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.StoreWithConstructFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.Tag.BaseSchema, record)
+}
+
+// InsertMany inserts all the given Tag in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *TagStore) InsertMany(records []*Tag) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *TagStore) InsertManyContext(ctx context.Context, records []*Tag) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.Tag.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -11799,21 +22719,33 @@ func (s *StoreWithConstructFixtureStore) Insert(record *StoreWithConstructFixtur
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *StoreWithConstructFixtureStore) Update(record *StoreWithConstructFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *TagStore) Update(record *Tag, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *TagStore) UpdateContext(ctx context.Context, record *Tag, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.StoreWithConstructFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.Tag.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *StoreWithConstructFixtureStore) Save(record *StoreWithConstructFixture) (updated bool, err error) {
+func (s *TagStore) Save(record *Tag) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *TagStore) SaveContext(ctx context.Context, record *Tag) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -11821,45 +22753,159 @@ func (s *StoreWithConstructFixtureStore) Save(record *StoreWithConstructFixture)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *TagStore) Upsert(record *Tag, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *TagStore) UpsertContext(ctx context.Context, record *Tag, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.Tag.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *StoreWithConstructFixtureStore) Delete(record *StoreWithConstructFixture) error {
-	return s.Store.Delete(Schema.StoreWithConstructFixture.BaseSchema, record)
+func (s *TagStore) Delete(record *Tag) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *TagStore) DeleteContext(ctx context.Context, record *Tag) error {
+	return s.Store.DeleteContext(ctx, Schema.Tag.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *TagStore) UpdateWhere(q *TagQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *TagStore) UpdateWhereContext(ctx context.Context, q *TagQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *TagStore) UpdateWhereReturning(q *TagQuery, values map[kallax.SchemaField]interface{}) (*TagResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *TagStore) UpdateWhereReturningContext(ctx context.Context, q *TagQuery, values map[kallax.SchemaField]interface{}) (*TagResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTagResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *TagStore) DeleteWhere(q *TagQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *TagStore) DeleteWhereContext(ctx context.Context, q *TagQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *TagStore) DeleteWhereReturning(q *TagQuery) (*TagResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *TagStore) DeleteWhereReturningContext(ctx context.Context, q *TagQuery) (*TagResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTagResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *StoreWithConstructFixtureStore) Find(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *TagStore) Find(q *TagQuery) (*TagResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *TagStore) FindContext(ctx context.Context, q *TagQuery) (*TagResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStoreWithConstructFixtureResultSet(rs), nil
+	return NewTagResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *StoreWithConstructFixtureStore) MustFind(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
-	return NewStoreWithConstructFixtureResultSet(s.Store.MustFind(q))
+func (s *TagStore) MustFind(q *TagQuery) *TagResultSet {
+	return NewTagResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *TagStore) MustFindContext(ctx context.Context, q *TagQuery) *TagResultSet {
+	return NewTagResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *StoreWithConstructFixtureStore) Count(q *StoreWithConstructFixtureQuery) (int64, error) {
+func (s *TagStore) Count(q *TagQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *TagStore) CountContext(ctx context.Context, q *TagQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *StoreWithConstructFixtureStore) MustCount(q *StoreWithConstructFixtureQuery) int64 {
+func (s *TagStore) MustCount(q *TagQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *TagStore) MustCountContext(ctx context.Context, q *TagQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *StoreWithConstructFixtureStore) FindOne(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
+func (s *TagStore) FindOne(q *TagQuery) (*Tag, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *TagStore) FindOneContext(ctx context.Context, q *TagQuery) (*Tag, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -11881,8 +22927,14 @@ func (s *StoreWithConstructFixtureStore) FindOne(q *StoreWithConstructFixtureQue
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *StoreWithConstructFixtureStore) FindAll(q *StoreWithConstructFixtureQuery) ([]*StoreWithConstructFixture, error) {
-	rs, err := s.Find(q)
+func (s *TagStore) FindAll(q *TagQuery) ([]*Tag, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *TagStore) FindAllContext(ctx context.Context, q *TagQuery) ([]*Tag, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -11892,49 +22944,159 @@ func (s *StoreWithConstructFixtureStore) FindAll(q *StoreWithConstructFixtureQue
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *StoreWithConstructFixtureStore) MustFindOne(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
-	record, err := s.FindOne(q)
+func (s *TagStore) MustFindOne(q *TagQuery) *Tag {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *TagStore) MustFindOneContext(ctx context.Context, q *TagQuery) *Tag {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the StoreWithConstructFixture with the data in the database and
+// Reload refreshes the Tag with the data in the database and
 // makes it writable.
-func (s *StoreWithConstructFixtureStore) Reload(record *StoreWithConstructFixture) error {
-	return s.Store.Reload(Schema.StoreWithConstructFixture.BaseSchema, record)
+func (s *TagStore) Reload(record *Tag) error {
+	return s.Store.Reload(Schema.Tag.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *TagStore) ReloadContext(ctx context.Context, record *Tag) error {
+	return s.Store.ReloadContext(ctx, Schema.Tag.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *StoreWithConstructFixtureStore) Transaction(callback func(*StoreWithConstructFixtureStore) error) error {
+func (s *TagStore) Transaction(callback func(*TagStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *TagStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*TagStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&StoreWithConstructFixtureStore{store})
-	})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&TagStore{store})
+	})
+}
+
+// AddPosts adds the given items to the Posts many to many
+// relationship of the model, inserting the rows of the join table
+// posts_tags. The items that are not persisted yet are inserted as well.
+// The items that are not in the Posts field of the passed record yet will
+// also be appended to it.
+func (s *TagStore) AddPosts(record *Tag, added ...Post) error {
+	return s.AddPostsContext(context.Background(), record, added...)
+}
+
+// AddPostsContext is the same as AddPosts, but the given context is
+// used to run the queries.
+func (s *TagStore) AddPostsContext(ctx context.Context, record *Tag, added ...Post) error {
+	if len(added) == 0 {
+		return nil
+	}
+
+	err := s.Store.TransactionContext(ctx, nil, func(s *kallax.Store) error {
+		var related = make([]kallax.Record, len(added))
+		for i := range added {
+			r := &added[i]
+			if !r.IsPersisted() {
+				if _, err := (&PostStore{s}).SaveContext(ctx, r); err != nil {
+					return err
+				}
+			}
+			related[i] = r
+		}
+
+		return s.LinkContext(ctx, Schema.Tag.BaseSchema, "Posts", record, related...)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range added {
+		var found bool
+		for _, r := range record.Posts {
+			if r.GetID().Equals(a.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			record.Posts = append(record.Posts, a)
+		}
+	}
+	return nil
+}
+
+// RemovePosts removes the given items from the Posts many to many
+// relationship of the model, deleting the rows of the join table
+// posts_tags. If no items are given, the model is unlinked from all of
+// them. The items themselves are not deleted from the database.
+// The items will also be removed from the passed record inside this method.
+func (s *TagStore) RemovePosts(record *Tag, removed ...Post) error {
+	return s.RemovePostsContext(context.Background(), record, removed...)
+}
+
+// RemovePostsContext is the same as RemovePosts, but the given
+// context is used to run the queries.
+func (s *TagStore) RemovePostsContext(ctx context.Context, record *Tag, removed ...Post) error {
+	var related = make([]kallax.Record, len(removed))
+	for i := range removed {
+		related[i] = &removed[i]
+	}
+
+	if err := s.Store.UnlinkContext(ctx, Schema.Tag.BaseSchema, "Posts", record, related...); err != nil {
+		return err
+	}
+
+	if len(removed) == 0 {
+		record.Posts = nil
+		return nil
+	}
+
+	var updated []Post
+	for _, r := range record.Posts {
+		var found bool
+		for _, d := range removed {
+			if d.GetID().Equals(r.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, r)
+		}
+	}
+	record.Posts = updated
+	return nil
 }
 
-// StoreWithConstructFixtureQuery is the object used to create queries for the StoreWithConstructFixture
+// TagQuery is the object used to create queries for the Tag
 // entity.
-type StoreWithConstructFixtureQuery struct {
+type TagQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewStoreWithConstructFixtureQuery returns a new instance of StoreWithConstructFixtureQuery.
-func NewStoreWithConstructFixtureQuery() *StoreWithConstructFixtureQuery {
-	return &StoreWithConstructFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithConstructFixture.BaseSchema),
+// NewTagQuery returns a new instance of TagQuery.
+func NewTagQuery() *TagQuery {
+	return &TagQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.Tag.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *StoreWithConstructFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) Select(columns ...kallax.SchemaField) *TagQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -11943,55 +23105,121 @@ func (q *StoreWithConstructFixtureQuery) Select(columns ...kallax.SchemaField) *
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *StoreWithConstructFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) SelectNot(columns ...kallax.SchemaField) *TagQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *StoreWithConstructFixtureQuery) Copy() *StoreWithConstructFixtureQuery {
-	return &StoreWithConstructFixtureQuery{
+func (q *TagQuery) Copy() *TagQuery {
+	return &TagQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *StoreWithConstructFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) Order(cols ...kallax.ColumnOrder) *TagQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *TagQuery) After(cursor *kallax.Cursor) *TagQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *TagQuery) Before(cursor *kallax.Cursor) *TagQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *StoreWithConstructFixtureQuery) BatchSize(size uint64) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) BatchSize(size uint64) *TagQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
 // Limit sets the max number of items to retrieve.
-func (q *StoreWithConstructFixtureQuery) Limit(n uint64) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) Limit(n uint64) *TagQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *StoreWithConstructFixtureQuery) Offset(n uint64) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) Offset(n uint64) *TagQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *StoreWithConstructFixtureQuery) Where(cond kallax.Condition) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) Where(cond kallax.Condition) *TagQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *TagQuery) GroupBy(cols ...kallax.SchemaField) *TagQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *TagQuery) Having(cond kallax.Condition) *TagQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *TagQuery) ForUpdate() *TagQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *TagQuery) ForShare() *TagQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *TagQuery) NoWait() *TagQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *TagQuery) SkipLocked() *TagQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+func (q *TagQuery) WithPosts(cond kallax.Condition) *TagQuery {
+	q.AddRelation(Schema.Post.BaseSchema, "Posts", kallax.ManyToMany, cond)
+	return q
+}
+
+// WhereHasPosts filters the records whose Posts relationship has any
+// record matching the given condition, which can be nil.
+func (q *TagQuery) WhereHasPosts(cond kallax.Condition) *TagQuery {
+	q.Where(kallax.Has(Schema.Post.BaseSchema, "Posts", cond))
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *StoreWithConstructFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithConstructFixtureQuery {
+func (q *TagQuery) FindByID(v ...kallax.ULID) *TagQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -11999,33 +23227,33 @@ func (q *StoreWithConstructFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithCo
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.StoreWithConstructFixture.ID, values...))
+	return q.Where(kallax.In(Schema.Tag.ID, values...))
 }
 
-// FindByFoo adds a new filter to the query that will require that
-// the Foo property is equal to the passed value.
-func (q *StoreWithConstructFixtureQuery) FindByFoo(v string) *StoreWithConstructFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, v))
+// FindByName adds a new filter to the query that will require that
+// the Name property is equal to the passed value.
+func (q *TagQuery) FindByName(v string) *TagQuery {
+	return q.Where(kallax.Eq(Schema.Tag.Name, v))
 }
 
-// StoreWithConstructFixtureResultSet is the set of results returned by a query to the
+// TagResultSet is the set of results returned by a query to the
 // database.
-type StoreWithConstructFixtureResultSet struct {
+type TagResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *StoreWithConstructFixture
+	last      *Tag
 	lastErr   error
 }
 
-// NewStoreWithConstructFixtureResultSet creates a new result set for rows of the type
-// StoreWithConstructFixture.
-func NewStoreWithConstructFixtureResultSet(rs kallax.ResultSet) *StoreWithConstructFixtureResultSet {
-	return &StoreWithConstructFixtureResultSet{ResultSet: rs}
+// NewTagResultSet creates a new result set for rows of the type
+// Tag.
+func NewTagResultSet(rs kallax.ResultSet) *TagResultSet {
+	return &TagResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *StoreWithConstructFixtureResultSet) Next() bool {
+func (rs *TagResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -12033,14 +23261,14 @@ func (rs *StoreWithConstructFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithConstructFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.Tag.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*StoreWithConstructFixture)
+		rs.last, ok = record.(*Tag)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithConstructFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *Tag")
 			rs.last = nil
 		}
 	}
@@ -12049,7 +23277,7 @@ func (rs *StoreWithConstructFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture, error) {
+func (rs *TagResultSet) Get() (*Tag, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -12057,7 +23285,7 @@ func (rs *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture,
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *StoreWithConstructFixtureResultSet) ForEach(fn func(*StoreWithConstructFixture) error) error {
+func (rs *TagResultSet) ForEach(fn func(*Tag) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -12076,8 +23304,8 @@ func (rs *StoreWithConstructFixtureResultSet) ForEach(fn func(*StoreWithConstruc
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixture, error) {
-	var result []*StoreWithConstructFixture
+func (rs *TagResultSet) All() ([]*Tag, error) {
+	var result []*Tag
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -12089,7 +23317,7 @@ func (rs *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixtur
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture, error) {
+func (rs *TagResultSet) One() (*Tag, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -12106,113 +23334,156 @@ func (rs *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture,
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *TagResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *StoreWithConstructFixtureResultSet) Err() error {
+func (rs *TagResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *StoreWithConstructFixtureResultSet) Close() error {
+func (rs *TagResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewStoreWithNewFixture returns a new instance of StoreWithNewFixture.
-func NewStoreWithNewFixture() (record *StoreWithNewFixture) {
-	return newStoreWithNewFixture()
+// NewVersionedFixture returns a new instance of VersionedFixture.
+func NewVersionedFixture() (record *VersionedFixture) {
+	return new(VersionedFixture)
 }
 
 // GetID returns the primary key of the model.
-func (r *StoreWithNewFixture) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *VersionedFixture) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *StoreWithNewFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *VersionedFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
+		return (*kallax.NumericID)(&r.ID), nil
+	case "version":
+		return &r.Versioned.Version, nil
 	case "foo":
 		return &r.Foo, nil
-	case "bar":
-		return &r.Bar, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in VersionedFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *StoreWithNewFixture) Value(col string) (interface{}, error) {
+func (r *VersionedFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
+	case "version":
+		return r.Versioned.Version, nil
 	case "foo":
 		return r.Foo, nil
-	case "bar":
-		return r.Bar, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in VersionedFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *StoreWithNewFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
+func (r *VersionedFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model VersionedFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *StoreWithNewFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
+func (r *VersionedFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model VersionedFixture has no relationships")
 }
 
-// StoreWithNewFixtureStore is the entity to access the records of the type StoreWithNewFixture
+// VersionedFixtureStore is the entity to access the records of the type VersionedFixture
 // in the database.
-type StoreWithNewFixtureStore struct {
+type VersionedFixtureStore struct {
 	*kallax.Store
 }
 
-// NewStoreWithNewFixtureStore creates a new instance of StoreWithNewFixtureStore
+// NewVersionedFixtureStore creates a new instance of VersionedFixtureStore
 // using a SQL database.
-func NewStoreWithNewFixtureStore(db *sql.DB) *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{kallax.NewStore(db)}
+func NewVersionedFixtureStore(db *sql.DB) *VersionedFixtureStore {
+	return &VersionedFixtureStore{kallax.NewStore(db)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *StoreWithNewFixtureStore) GenericStore() *kallax.Store {
+func (s *VersionedFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *StoreWithNewFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *VersionedFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *StoreWithNewFixtureStore) Debug() *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{s.Store.Debug()}
+func (s *VersionedFixtureStore) Debug() *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *StoreWithNewFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{s.Store.DebugWith(logger)}
+func (s *VersionedFixtureStore) DebugWith(logger kallax.LoggerFunc) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.DebugWith(logger)}
 }
 
 // DisableCacher turns off prepared statements, which can be useful in some scenarios.
-func (s *StoreWithNewFixtureStore) DisableCacher() *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{s.Store.DisableCacher()}
+func (s *VersionedFixtureStore) DisableCacher() *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.DisableCacher()}
 }
 
-// Insert inserts a StoreWithNewFixture in the database. A non-persisted object is
+// WithDialect returns a new store that builds its queries in the given SQL
+// dialect, which must be the one of the database.
+func (s *VersionedFixtureStore) WithDialect(dialect kallax.Dialect) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.WithDialect(dialect)}
+}
+
+// Insert inserts a VersionedFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *StoreWithNewFixtureStore) Insert(record *StoreWithNewFixture) error {
+func (s *VersionedFixtureStore) Insert(record *VersionedFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext is the same as Insert, but the given context is used to run
+// the queries.
+func (s *VersionedFixtureStore) InsertContext(ctx context.Context, record *VersionedFixture) error {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Insert(Schema.StoreWithNewFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.VersionedFixture.BaseSchema, record)
+}
+
+// InsertMany inserts all the given VersionedFixture in the database using as few
+// queries as possible. All of them are required to be non-persisted.
+func (s *VersionedFixtureStore) InsertMany(records []*VersionedFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext is the same as InsertMany, but the given context is used
+// to run the queries.
+func (s *VersionedFixtureStore) InsertManyContext(ctx context.Context, records []*VersionedFixture) error {
+	recs := make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.SetSaving(true)
+
+		recs[i] = record
+	}
+
+	defer func() {
+		for _, record := range records {
+			record.SetSaving(false)
+		}
+	}()
+
+	return s.Store.InsertManyContext(ctx, Schema.VersionedFixture.BaseSchema, recs...)
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -12221,21 +23492,33 @@ func (s *StoreWithNewFixtureStore) Insert(record *StoreWithNewFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *StoreWithNewFixtureStore) Update(record *StoreWithNewFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *VersionedFixtureStore) Update(record *VersionedFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext is the same as Update, but the given context is used to run
+// the queries.
+func (s *VersionedFixtureStore) UpdateContext(ctx context.Context, record *VersionedFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.SetSaving(true)
 	defer record.SetSaving(false)
 
-	return s.Store.Update(Schema.StoreWithNewFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.VersionedFixture.BaseSchema, record, cols...)
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *StoreWithNewFixtureStore) Save(record *StoreWithNewFixture) (updated bool, err error) {
+func (s *VersionedFixtureStore) Save(record *VersionedFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext is the same as Save, but the given context is used to run the
+// queries.
+func (s *VersionedFixtureStore) SaveContext(ctx context.Context, record *VersionedFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -12243,45 +23526,159 @@ func (s *StoreWithNewFixtureStore) Save(record *StoreWithNewFixture) (updated bo
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing one on
+// the given conflict columns, updates the given update columns of the
+// existing one. If no update columns are given, the existing record is left
+// as is. The record is filled with the values stored in the database.
+// Relationships of the record are not saved.
+func (s *VersionedFixtureStore) Upsert(record *VersionedFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext is the same as Upsert, but the given context is used to run
+// the queries.
+func (s *VersionedFixtureStore) UpsertContext(ctx context.Context, record *VersionedFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.SetSaving(true)
+	defer record.SetSaving(false)
+
+	return s.Store.UpsertContext(ctx, Schema.VersionedFixture.BaseSchema, record, conflictCols, updateCols...)
+}
+
 // Delete removes the given record from the database.
-func (s *StoreWithNewFixtureStore) Delete(record *StoreWithNewFixture) error {
-	return s.Store.Delete(Schema.StoreWithNewFixture.BaseSchema, record)
+func (s *VersionedFixtureStore) Delete(record *VersionedFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is the same as Delete, but the given context is used to run
+// the queries.
+func (s *VersionedFixtureStore) DeleteContext(ctx context.Context, record *VersionedFixture) error {
+	return s.Store.DeleteContext(ctx, Schema.VersionedFixture.BaseSchema, record)
+}
+
+// UpdateWhere sets the given values to all the records matching the given
+// query and returns the number of updated records. Only the conditions of the
+// query are used. No events are run for the updated records.
+func (s *VersionedFixtureStore) UpdateWhere(q *VersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhere(q, values)
+}
+
+// UpdateWhereContext is the same as UpdateWhere, but the given context is
+// used to run the query.
+func (s *VersionedFixtureStore) UpdateWhereContext(ctx context.Context, q *VersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateWhereContext(ctx, q, values)
+}
+
+// UpdateWhereReturning is the same as UpdateWhere, but it returns the updated
+// records instead of the number of records.
+func (s *VersionedFixtureStore) UpdateWhereReturning(q *VersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (*VersionedFixtureResultSet, error) {
+	return s.UpdateWhereReturningContext(context.Background(), q, values)
+}
+
+// UpdateWhereReturningContext is the same as UpdateWhereReturning, but the
+// given context is used to run the query.
+func (s *VersionedFixtureStore) UpdateWhereReturningContext(ctx context.Context, q *VersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (*VersionedFixtureResultSet, error) {
+	rs, err := s.Store.UpdateWhereReturningContext(ctx, q, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVersionedFixtureResultSet(rs), nil
+}
+
+// DeleteWhere removes all the records matching the given query and returns
+// the number of removed records. Only the conditions of the query are used.
+// No events are run for the removed records.
+func (s *VersionedFixtureStore) DeleteWhere(q *VersionedFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhere(q)
+}
+
+// DeleteWhereContext is the same as DeleteWhere, but the given context is
+// used to run the query.
+func (s *VersionedFixtureStore) DeleteWhereContext(ctx context.Context, q *VersionedFixtureQuery) (int64, error) {
+	return s.Store.DeleteWhereContext(ctx, q)
+}
+
+// DeleteWhereReturning is the same as DeleteWhere, but it returns the removed
+// records instead of the number of records.
+func (s *VersionedFixtureStore) DeleteWhereReturning(q *VersionedFixtureQuery) (*VersionedFixtureResultSet, error) {
+	return s.DeleteWhereReturningContext(context.Background(), q)
+}
+
+// DeleteWhereReturningContext is the same as DeleteWhereReturning, but the
+// given context is used to run the query.
+func (s *VersionedFixtureStore) DeleteWhereReturningContext(ctx context.Context, q *VersionedFixtureQuery) (*VersionedFixtureResultSet, error) {
+	rs, err := s.Store.DeleteWhereReturningContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVersionedFixtureResultSet(rs), nil
 }
 
 // Find returns the set of results for the given query.
-func (s *StoreWithNewFixtureStore) Find(q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *VersionedFixtureStore) Find(q *VersionedFixtureQuery) (*VersionedFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext is the same as Find, but the given context is used to run the
+// query.
+func (s *VersionedFixtureStore) FindContext(ctx context.Context, q *VersionedFixtureQuery) (*VersionedFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStoreWithNewFixtureResultSet(rs), nil
+	return NewVersionedFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *StoreWithNewFixtureStore) MustFind(q *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
-	return NewStoreWithNewFixtureResultSet(s.Store.MustFind(q))
+func (s *VersionedFixtureStore) MustFind(q *VersionedFixtureQuery) *VersionedFixtureResultSet {
+	return NewVersionedFixtureResultSet(s.Store.MustFind(q))
+}
+
+// MustFindContext is the same as MustFind, but the given context is used to
+// run the query.
+func (s *VersionedFixtureStore) MustFindContext(ctx context.Context, q *VersionedFixtureQuery) *VersionedFixtureResultSet {
+	return NewVersionedFixtureResultSet(s.Store.MustFindContext(ctx, q))
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *StoreWithNewFixtureStore) Count(q *StoreWithNewFixtureQuery) (int64, error) {
+func (s *VersionedFixtureStore) Count(q *VersionedFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext is the same as Count, but the given context is used to run the
+// query.
+func (s *VersionedFixtureStore) CountContext(ctx context.Context, q *VersionedFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *StoreWithNewFixtureStore) MustCount(q *StoreWithNewFixtureQuery) int64 {
+func (s *VersionedFixtureStore) MustCount(q *VersionedFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// MustCountContext is the same as MustCount, but the given context is used to
+// run the query.
+func (s *VersionedFixtureStore) MustCountContext(ctx context.Context, q *VersionedFixtureQuery) int64 {
+	return s.Store.MustCountContext(ctx, q)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *StoreWithNewFixtureStore) FindOne(q *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
+func (s *VersionedFixtureStore) FindOne(q *VersionedFixtureQuery) (*VersionedFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext is the same as FindOne, but the given context is used to run
+// the query.
+func (s *VersionedFixtureStore) FindOneContext(ctx context.Context, q *VersionedFixtureQuery) (*VersionedFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -12303,8 +23700,14 @@ func (s *StoreWithNewFixtureStore) FindOne(q *StoreWithNewFixtureQuery) (*StoreW
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *StoreWithNewFixtureStore) FindAll(q *StoreWithNewFixtureQuery) ([]*StoreWithNewFixture, error) {
-	rs, err := s.Find(q)
+func (s *VersionedFixtureStore) FindAll(q *VersionedFixtureQuery) ([]*VersionedFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext is the same as FindAll, but the given context is used to run
+// the query.
+func (s *VersionedFixtureStore) FindAllContext(ctx context.Context, q *VersionedFixtureQuery) ([]*VersionedFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -12314,49 +23717,67 @@ func (s *StoreWithNewFixtureStore) FindAll(q *StoreWithNewFixtureQuery) ([]*Stor
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *StoreWithNewFixtureStore) MustFindOne(q *StoreWithNewFixtureQuery) *StoreWithNewFixture {
-	record, err := s.FindOne(q)
+func (s *VersionedFixtureStore) MustFindOne(q *VersionedFixtureQuery) *VersionedFixture {
+	return s.MustFindOneContext(context.Background(), q)
+}
+
+// MustFindOneContext is the same as MustFindOne, but the given context is used
+// to run the query.
+func (s *VersionedFixtureStore) MustFindOneContext(ctx context.Context, q *VersionedFixtureQuery) *VersionedFixture {
+	record, err := s.FindOneContext(ctx, q)
 	if err != nil {
 		panic(err)
 	}
 	return record
 }
 
-// Reload refreshes the StoreWithNewFixture with the data in the database and
+// Reload refreshes the VersionedFixture with the data in the database and
 // makes it writable.
-func (s *StoreWithNewFixtureStore) Reload(record *StoreWithNewFixture) error {
-	return s.Store.Reload(Schema.StoreWithNewFixture.BaseSchema, record)
+func (s *VersionedFixtureStore) Reload(record *VersionedFixture) error {
+	return s.Store.Reload(Schema.VersionedFixture.BaseSchema, record)
+}
+
+// ReloadContext is the same as Reload, but the given context is used to run
+// the query.
+func (s *VersionedFixtureStore) ReloadContext(ctx context.Context, record *VersionedFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.VersionedFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *StoreWithNewFixtureStore) Transaction(callback func(*StoreWithNewFixtureStore) error) error {
+func (s *VersionedFixtureStore) Transaction(callback func(*VersionedFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), nil, callback)
+}
+
+// TransactionContext is the same as Transaction, but the transaction is
+// started with the given context and options.
+func (s *VersionedFixtureStore) TransactionContext(ctx context.Context, opts *sql.TxOptions, callback func(*VersionedFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&StoreWithNewFixtureStore{store})
+	return s.Store.TransactionContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&VersionedFixtureStore{store})
 	})
 }
 
-// StoreWithNewFixtureQuery is the object used to create queries for the StoreWithNewFixture
+// VersionedFixtureQuery is the object used to create queries for the VersionedFixture
 // entity.
-type StoreWithNewFixtureQuery struct {
+type VersionedFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewStoreWithNewFixtureQuery returns a new instance of StoreWithNewFixtureQuery.
-func NewStoreWithNewFixtureQuery() *StoreWithNewFixtureQuery {
-	return &StoreWithNewFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithNewFixture.BaseSchema),
+// NewVersionedFixtureQuery returns a new instance of VersionedFixtureQuery.
+func NewVersionedFixtureQuery() *VersionedFixtureQuery {
+	return &VersionedFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.VersionedFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *StoreWithNewFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) Select(columns ...kallax.SchemaField) *VersionedFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -12365,55 +23786,109 @@ func (q *StoreWithNewFixtureQuery) Select(columns ...kallax.SchemaField) *StoreW
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *StoreWithNewFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) SelectNot(columns ...kallax.SchemaField) *VersionedFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *StoreWithNewFixtureQuery) Copy() *StoreWithNewFixtureQuery {
-	return &StoreWithNewFixtureQuery{
+func (q *VersionedFixtureQuery) Copy() *VersionedFixtureQuery {
+	return &VersionedFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
-// Order adds order clauses to the query for the given columns.
-func (q *StoreWithNewFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithNewFixtureQuery {
-	q.BaseQuery.Order(cols...)
+// Order adds order clauses to the query for the given columns.
+func (q *VersionedFixtureQuery) Order(cols ...kallax.ColumnOrder) *VersionedFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// After makes the query return only the records after the given cursor in
+// the order of the query.
+func (q *VersionedFixtureQuery) After(cursor *kallax.Cursor) *VersionedFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query return only the records before the given cursor in
+// the order of the query.
+func (q *VersionedFixtureQuery) Before(cursor *kallax.Cursor) *VersionedFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *VersionedFixtureQuery) BatchSize(size uint64) *VersionedFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *VersionedFixtureQuery) Limit(n uint64) *VersionedFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *VersionedFixtureQuery) Offset(n uint64) *VersionedFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *VersionedFixtureQuery) Where(cond kallax.Condition) *VersionedFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given columns to the list of columns to group the results
+// by. Use FindAggregate in the store to retrieve the grouped results.
+func (q *VersionedFixtureQuery) GroupBy(cols ...kallax.SchemaField) *VersionedFixtureQuery {
+	q.BaseQuery.GroupBy(cols...)
+	return q
+}
+
+// Having adds a condition to filter the groups of the query. All conditions
+// added are concatenated using a logical AND.
+func (q *VersionedFixtureQuery) Having(cond kallax.Condition) *VersionedFixtureQuery {
+	q.BaseQuery.Having(cond)
 	return q
 }
 
-// BatchSize sets the number of items to fetch per batch when there are 1:N
-// relationships selected in the query.
-func (q *StoreWithNewFixtureQuery) BatchSize(size uint64) *StoreWithNewFixtureQuery {
-	q.BaseQuery.BatchSize(size)
+// ForUpdate locks the retrieved rows until the end of the current
+// transaction, as if they were going to be updated.
+func (q *VersionedFixtureQuery) ForUpdate() *VersionedFixtureQuery {
+	q.BaseQuery.ForUpdate()
 	return q
 }
 
-// Limit sets the max number of items to retrieve.
-func (q *StoreWithNewFixtureQuery) Limit(n uint64) *StoreWithNewFixtureQuery {
-	q.BaseQuery.Limit(n)
+// ForShare locks the retrieved rows with a shared lock until the end of the
+// current transaction.
+func (q *VersionedFixtureQuery) ForShare() *VersionedFixtureQuery {
+	q.BaseQuery.ForShare()
 	return q
 }
 
-// Offset sets the number of items to skip from the result set of items.
-func (q *StoreWithNewFixtureQuery) Offset(n uint64) *StoreWithNewFixtureQuery {
-	q.BaseQuery.Offset(n)
+// NoWait makes the query fail instead of waiting for the rows to lock.
+func (q *VersionedFixtureQuery) NoWait() *VersionedFixtureQuery {
+	q.BaseQuery.NoWait()
 	return q
 }
 
-// Where adds a condition to the query. All conditions added are concatenated
-// using a logical AND.
-func (q *StoreWithNewFixtureQuery) Where(cond kallax.Condition) *StoreWithNewFixtureQuery {
-	q.BaseQuery.Where(cond)
+// SkipLocked makes the query skip the rows that are already locked.
+func (q *VersionedFixtureQuery) SkipLocked() *VersionedFixtureQuery {
+	q.BaseQuery.SkipLocked()
 	return q
 }
 
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *StoreWithNewFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) FindByID(v ...int64) *VersionedFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -12421,39 +23896,39 @@ func (q *StoreWithNewFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithNewFixtu
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.StoreWithNewFixture.ID, values...))
+	return q.Where(kallax.In(Schema.VersionedFixture.ID, values...))
 }
 
-// FindByFoo adds a new filter to the query that will require that
-// the Foo property is equal to the passed value.
-func (q *StoreWithNewFixtureQuery) FindByFoo(v string) *StoreWithNewFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Foo, v))
+// FindByVersion adds a new filter to the query that will require that
+// the Version property is equal to the passed value.
+func (q *VersionedFixtureQuery) FindByVersion(cond kallax.ScalarCond, v int64) *VersionedFixtureQuery {
+	return q.Where(cond(Schema.VersionedFixture.Version, v))
 }
 
-// FindByBar adds a new filter to the query that will require that
-// the Bar property is equal to the passed value.
-func (q *StoreWithNewFixtureQuery) FindByBar(v string) *StoreWithNewFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Bar, v))
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *VersionedFixtureQuery) FindByFoo(v string) *VersionedFixtureQuery {
+	return q.Where(kallax.Eq(Schema.VersionedFixture.Foo, v))
 }
 
-// StoreWithNewFixtureResultSet is the set of results returned by a query to the
+// VersionedFixtureResultSet is the set of results returned by a query to the
 // database.
-type StoreWithNewFixtureResultSet struct {
+type VersionedFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *StoreWithNewFixture
+	last      *VersionedFixture
 	lastErr   error
 }
 
-// NewStoreWithNewFixtureResultSet creates a new result set for rows of the type
-// StoreWithNewFixture.
-func NewStoreWithNewFixtureResultSet(rs kallax.ResultSet) *StoreWithNewFixtureResultSet {
-	return &StoreWithNewFixtureResultSet{ResultSet: rs}
+// NewVersionedFixtureResultSet creates a new result set for rows of the type
+// VersionedFixture.
+func NewVersionedFixtureResultSet(rs kallax.ResultSet) *VersionedFixtureResultSet {
+	return &VersionedFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *StoreWithNewFixtureResultSet) Next() bool {
+func (rs *VersionedFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -12461,14 +23936,14 @@ func (rs *StoreWithNewFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithNewFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.VersionedFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*StoreWithNewFixture)
+		rs.last, ok = record.(*VersionedFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithNewFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *VersionedFixture")
 			rs.last = nil
 		}
 	}
@@ -12477,7 +23952,7 @@ func (rs *StoreWithNewFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
+func (rs *VersionedFixtureResultSet) Get() (*VersionedFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -12485,7 +23960,7 @@ func (rs *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *StoreWithNewFixtureResultSet) ForEach(fn func(*StoreWithNewFixture) error) error {
+func (rs *VersionedFixtureResultSet) ForEach(fn func(*VersionedFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -12504,8 +23979,8 @@ func (rs *StoreWithNewFixtureResultSet) ForEach(fn func(*StoreWithNewFixture) er
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
-	var result []*StoreWithNewFixture
+func (rs *VersionedFixtureResultSet) All() ([]*VersionedFixture, error) {
+	var result []*VersionedFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -12517,7 +23992,7 @@ func (rs *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
+func (rs *VersionedFixtureResultSet) One() (*VersionedFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -12534,13 +24009,19 @@ func (rs *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
 	return record, nil
 }
 
+// Cursor returns the cursor of the last record retrieved, which can be used
+// to retrieve the records after or before it.
+func (rs *VersionedFixtureResultSet) Cursor() (*kallax.Cursor, error) {
+	return rs.ResultSet.Cursor()
+}
+
 // Err returns the last error occurred.
-func (rs *StoreWithNewFixtureResultSet) Err() error {
+func (rs *VersionedFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
 // Close closes the result set.
-func (rs *StoreWithNewFixtureResultSet) Close() error {
+func (rs *VersionedFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
@@ -12551,9 +24032,12 @@ type schema struct {
 	C                         *schemaC
 	Car                       *schemaCar
 	Child                     *schemaChild
+	CustomVersionedFixture    *schemaCustomVersionedFixture
+	EnumFixture               *schemaEnumFixture
 	EventsAllFixture          *schemaEventsAllFixture
 	EventsFixture             *schemaEventsFixture
 	EventsSaveFixture         *schemaEventsSaveFixture
+	FullTextFixture           *schemaFullTextFixture
 	JSONModel                 *schemaJSONModel
 	MultiKeySortFixture       *schemaMultiKeySortFixture
 	Nullable                  *schemaNullable
@@ -12561,14 +24045,18 @@ type schema struct {
 	ParentNoPtr               *schemaParentNoPtr
 	Person                    *schemaPerson
 	Pet                       *schemaPet
+	Post                      *schemaPost
 	QueryFixture              *schemaQueryFixture
 	QueryRelationFixture      *schemaQueryRelationFixture
 	ResultSetFixture          *schemaResultSetFixture
 	SchemaFixture             *schemaSchemaFixture
 	SchemaRelationshipFixture *schemaSchemaRelationshipFixture
+	SoftDeleteFixture         *schemaSoftDeleteFixture
 	StoreFixture              *schemaStoreFixture
 	StoreWithConstructFixture *schemaStoreWithConstructFixture
 	StoreWithNewFixture       *schemaStoreWithNewFixture
+	Tag                       *schemaTag
+	VersionedFixture          *schemaVersionedFixture
 }
 
 type schemaA struct {
@@ -12611,6 +24099,21 @@ type schemaChild struct {
 	Name kallax.SchemaField
 }
 
+type schemaCustomVersionedFixture struct {
+	*kallax.BaseSchema
+	ID  kallax.SchemaField
+	Rev kallax.SchemaField
+	Foo kallax.SchemaField
+}
+
+type schemaEnumFixture struct {
+	*kallax.BaseSchema
+	ID       kallax.SchemaField
+	Status   kallax.SchemaField
+	Previous kallax.SchemaField
+	Size     kallax.SchemaField
+}
+
 type schemaEventsAllFixture struct {
 	*kallax.BaseSchema
 	ID             kallax.SchemaField
@@ -12635,6 +24138,13 @@ type schemaEventsSaveFixture struct {
 	MustFailAfter  kallax.SchemaField
 }
 
+type schemaFullTextFixture struct {
+	*kallax.BaseSchema
+	ID       kallax.SchemaField
+	Title    kallax.SchemaField
+	Document kallax.SchemaField
+}
+
 type schemaJSONModel struct {
 	*kallax.BaseSchema
 	ID       kallax.SchemaField
@@ -12686,6 +24196,12 @@ type schemaPet struct {
 	OwnerFK kallax.SchemaField
 }
 
+type schemaPost struct {
+	*kallax.BaseSchema
+	ID    kallax.SchemaField
+	Title kallax.SchemaField
+}
+
 type schemaQueryFixture struct {
 	*kallax.BaseSchema
 	ID                        kallax.SchemaField
@@ -12750,6 +24266,13 @@ type schemaSchemaRelationshipFixture struct {
 	ID kallax.SchemaField
 }
 
+type schemaSoftDeleteFixture struct {
+	*kallax.BaseSchema
+	ID        kallax.SchemaField
+	DeletedAt kallax.SchemaField
+	Foo       kallax.SchemaField
+}
+
 type schemaStoreFixture struct {
 	*kallax.BaseSchema
 	ID             kallax.SchemaField
@@ -12771,6 +24294,19 @@ type schemaStoreWithNewFixture struct {
 	Bar kallax.SchemaField
 }
 
+type schemaTag struct {
+	*kallax.BaseSchema
+	ID   kallax.SchemaField
+	Name kallax.SchemaField
+}
+
+type schemaVersionedFixture struct {
+	*kallax.BaseSchema
+	ID      kallax.SchemaField
+	Version kallax.SchemaField
+	Foo     kallax.SchemaField
+}
+
 type schemaJSONModelBar struct {
 	*kallax.BaseSchemaField
 	Qux *schemaJSONModelBarQux
@@ -12825,6 +24361,9 @@ var Schema = &schema{
 			true,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -12845,6 +24384,9 @@ var Schema = &schema{
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
 			kallax.NewSchemaField("a_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -12862,6 +24404,9 @@ var Schema = &schema{
 			false,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -12881,6 +24426,9 @@ var Schema = &schema{
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
 			kallax.NewSchemaField("b_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -12903,6 +24451,9 @@ var Schema = &schema{
 			kallax.NewSchemaField("owner_id"),
 			kallax.NewSchemaField("model_name"),
 			kallax.NewSchemaField("brand_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("model_name"),
 		),
 		ID:        kallax.NewSchemaField("id"),
 		OwnerFK:   kallax.NewSchemaField("owner_id"),
@@ -12922,10 +24473,59 @@ var Schema = &schema{
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
 			kallax.NewSchemaField("parent_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
 	},
+	CustomVersionedFixture: &schemaCustomVersionedFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"custom_versioned",
+			"__customversionedfixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(CustomVersionedFixture)
+			},
+			false,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("rev"),
+			kallax.NewSchemaField("foo"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("rev"),
+			kallax.NewSchemaField("foo"),
+		).WithVersion(kallax.NewSchemaField("rev")),
+		ID:  kallax.NewSchemaField("id"),
+		Rev: kallax.NewSchemaField("rev"),
+		Foo: kallax.NewSchemaField("foo"),
+	},
+	EnumFixture: &schemaEnumFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"enums",
+			"__enumfixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(EnumFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("status"),
+			kallax.NewSchemaField("previous"),
+			kallax.NewSchemaField("size"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("status"),
+			kallax.NewSchemaField("size"),
+		),
+		ID:       kallax.NewSchemaField("id"),
+		Status:   kallax.NewSchemaField("status"),
+		Previous: kallax.NewSchemaField("previous"),
+		Size:     kallax.NewSchemaField("size"),
+	},
 	EventsAllFixture: &schemaEventsAllFixture{
 		BaseSchema: kallax.NewBaseSchema(
 			"event",
@@ -12940,6 +24540,11 @@ var Schema = &schema{
 			kallax.NewSchemaField("checks"),
 			kallax.NewSchemaField("must_fail_before"),
 			kallax.NewSchemaField("must_fail_after"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("checks"),
+			kallax.NewSchemaField("must_fail_before"),
+			kallax.NewSchemaField("must_fail_after"),
 		),
 		ID:             kallax.NewSchemaField("id"),
 		Checks:         kallax.NewSchemaField("checks"),
@@ -12960,6 +24565,11 @@ var Schema = &schema{
 			kallax.NewSchemaField("checks"),
 			kallax.NewSchemaField("must_fail_before"),
 			kallax.NewSchemaField("must_fail_after"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("checks"),
+			kallax.NewSchemaField("must_fail_before"),
+			kallax.NewSchemaField("must_fail_after"),
 		),
 		ID:             kallax.NewSchemaField("id"),
 		Checks:         kallax.NewSchemaField("checks"),
@@ -12980,12 +24590,39 @@ var Schema = &schema{
 			kallax.NewSchemaField("checks"),
 			kallax.NewSchemaField("must_fail_before"),
 			kallax.NewSchemaField("must_fail_after"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("checks"),
+			kallax.NewSchemaField("must_fail_before"),
+			kallax.NewSchemaField("must_fail_after"),
 		),
 		ID:             kallax.NewSchemaField("id"),
 		Checks:         kallax.NewSchemaField("checks"),
 		MustFailBefore: kallax.NewSchemaField("must_fail_before"),
 		MustFailAfter:  kallax.NewSchemaField("must_fail_after"),
 	},
+	FullTextFixture: &schemaFullTextFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"full_text",
+			"__fulltextfixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(FullTextFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("title"),
+			kallax.NewSchemaField("document"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("title"),
+			kallax.NewSchemaField("document"),
+		),
+		ID:       kallax.NewSchemaField("id"),
+		Title:    kallax.NewSchemaField("title"),
+		Document: kallax.NewTSVectorSchemaField("document"),
+	},
 	JSONModel: &schemaJSONModel{
 		BaseSchema: kallax.NewBaseSchema(
 			"jsons",
@@ -13001,6 +24638,11 @@ var Schema = &schema{
 			kallax.NewSchemaField("bar"),
 			kallax.NewSchemaField("baz_slice"),
 			kallax.NewSchemaField("baz"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
+			kallax.NewSchemaField("baz_slice"),
+			kallax.NewSchemaField("baz"),
 		),
 		ID:  kallax.NewSchemaField("id"),
 		Foo: kallax.NewSchemaField("foo"),
@@ -13034,6 +24676,11 @@ var Schema = &schema{
 			kallax.NewSchemaField("name"),
 			kallax.NewSchemaField("start"),
 			kallax.NewSchemaField("_end"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
+			kallax.NewSchemaField("start"),
+			kallax.NewSchemaField("_end"),
 		),
 		ID:    kallax.NewSchemaField("id"),
 		Name:  kallax.NewSchemaField("name"),
@@ -13054,6 +24701,8 @@ var Schema = &schema{
 			kallax.NewSchemaField("t"),
 			kallax.NewSchemaField("some_json"),
 			kallax.NewSchemaField("scanner"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
 		),
 		ID: kallax.NewSchemaField("id"),
 		T:  kallax.NewSchemaField("t"),
@@ -13077,6 +24726,9 @@ var Schema = &schema{
 			true,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -13095,6 +24747,9 @@ var Schema = &schema{
 			true,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -13114,6 +24769,9 @@ var Schema = &schema{
 			true,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:   kallax.NewSchemaField("id"),
 		Name: kallax.NewSchemaField("name"),
@@ -13134,12 +24792,37 @@ var Schema = &schema{
 			kallax.NewSchemaField("name"),
 			kallax.NewSchemaField("kind"),
 			kallax.NewSchemaField("owner_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
+			kallax.NewSchemaField("kind"),
 		),
 		ID:      kallax.NewSchemaField("id"),
 		Name:    kallax.NewSchemaField("name"),
 		Kind:    kallax.NewSchemaField("kind"),
 		OwnerFK: kallax.NewSchemaField("owner_id"),
 	},
+	Post: &schemaPost{
+		BaseSchema: kallax.NewBaseSchema(
+			"posts",
+			"__post",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{
+				"Tags": kallax.NewThroughForeignKey("post_id", "posts_tags", "tag_id"),
+			},
+			func() kallax.Record {
+				return new(Post)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("title"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("title"),
+		),
+		ID:    kallax.NewSchemaField("id"),
+		Title: kallax.NewSchemaField("title"),
+	},
 	QueryFixture: &schemaQueryFixture{
 		BaseSchema: kallax.NewBaseSchema(
 			"query",
@@ -13184,6 +24867,36 @@ var Schema = &schema{
 			kallax.NewSchemaField("alias_here_array_param"),
 			kallax.NewSchemaField("array_alias_here_string_param"),
 			kallax.NewSchemaField("scanner_valuer_param"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("embedded"),
+			kallax.NewSchemaField("inline"),
+			kallax.NewSchemaField("map_of_string"),
+			kallax.NewSchemaField("map_of_interface"),
+			kallax.NewSchemaField("map_of_some_type"),
+			kallax.NewSchemaField("foo"),
+			kallax.NewSchemaField("string_property"),
+			kallax.NewSchemaField("integer"),
+			kallax.NewSchemaField("integer64"),
+			kallax.NewSchemaField("float32"),
+			kallax.NewSchemaField("boolean"),
+			kallax.NewSchemaField("array_param"),
+			kallax.NewSchemaField("slice_param"),
+			kallax.NewSchemaField("alias_array_param"),
+			kallax.NewSchemaField("alias_slice_param"),
+			kallax.NewSchemaField("alias_string_param"),
+			kallax.NewSchemaField("alias_int_param"),
+			kallax.NewSchemaField("dummy_param"),
+			kallax.NewSchemaField("alias_dummy_param"),
+			kallax.NewSchemaField("slice_dummy_param"),
+			kallax.NewSchemaField("idproperty_param"),
+			kallax.NewSchemaField("interface_prop_param"),
+			kallax.NewSchemaField("urlparam"),
+			kallax.NewSchemaField("time_param"),
+			kallax.NewSchemaField("alias_arr_alias_string_param"),
+			kallax.NewSchemaField("alias_here_array_param"),
+			kallax.NewSchemaField("array_alias_here_string_param"),
+			kallax.NewSchemaField("scanner_valuer_param"),
 		),
 		ID:                        kallax.NewSchemaField("id"),
 		InverseFK:                 kallax.NewSchemaField("inverse_id"),
@@ -13231,6 +24944,9 @@ var Schema = &schema{
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("name"),
 			kallax.NewSchemaField("owner_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
 		),
 		ID:      kallax.NewSchemaField("id"),
 		Name:    kallax.NewSchemaField("name"),
@@ -13248,6 +24964,9 @@ var Schema = &schema{
 			false,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("foo"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
 		),
 		ID:  kallax.NewSchemaField("id"),
 		Foo: kallax.NewSchemaField("foo"),
@@ -13273,6 +24992,14 @@ var Schema = &schema{
 			kallax.NewSchemaField("map_of_interface"),
 			kallax.NewSchemaField("map_of_some_type"),
 			kallax.NewSchemaField("rel_id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("string"),
+			kallax.NewSchemaField("int"),
+			kallax.NewSchemaField("inline"),
+			kallax.NewSchemaField("map_of_string"),
+			kallax.NewSchemaField("map_of_interface"),
+			kallax.NewSchemaField("map_of_some_type"),
 		),
 		ID:             kallax.NewSchemaField("id"),
 		String:         kallax.NewSchemaField("string"),
@@ -13294,9 +25021,32 @@ var Schema = &schema{
 			},
 			false,
 			kallax.NewSchemaField("id"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
 		),
 		ID: kallax.NewSchemaField("id"),
 	},
+	SoftDeleteFixture: &schemaSoftDeleteFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"soft_deletes",
+			"__softdeletefixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(SoftDeleteFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("deleted_at"),
+			kallax.NewSchemaField("foo"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
+		).WithSoftDelete(kallax.NewSchemaField("deleted_at")),
+		ID:        kallax.NewSchemaField("id"),
+		DeletedAt: kallax.NewSchemaField("deleted_at"),
+		Foo:       kallax.NewSchemaField("foo"),
+	},
 	StoreFixture: &schemaStoreFixture{
 		BaseSchema: kallax.NewBaseSchema(
 			"store",
@@ -13311,6 +25061,11 @@ var Schema = &schema{
 			kallax.NewSchemaField("foo"),
 			kallax.NewSchemaField("slice_prop"),
 			kallax.NewSchemaField("alias_slice_prop"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
+			kallax.NewSchemaField("slice_prop"),
+			kallax.NewSchemaField("alias_slice_prop"),
 		),
 		ID:             kallax.NewSchemaField("id"),
 		Foo:            kallax.NewSchemaField("foo"),
@@ -13329,6 +25084,9 @@ var Schema = &schema{
 			false,
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("foo"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
 		),
 		ID:  kallax.NewSchemaField("id"),
 		Foo: kallax.NewSchemaField("foo"),
@@ -13346,9 +25104,56 @@ var Schema = &schema{
 			kallax.NewSchemaField("id"),
 			kallax.NewSchemaField("foo"),
 			kallax.NewSchemaField("bar"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
+			kallax.NewSchemaField("bar"),
 		),
 		ID:  kallax.NewSchemaField("id"),
 		Foo: kallax.NewSchemaField("foo"),
 		Bar: kallax.NewSchemaField("bar"),
 	},
+	Tag: &schemaTag{
+		BaseSchema: kallax.NewBaseSchema(
+			"tags",
+			"__tag",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{
+				"Posts": kallax.NewThroughForeignKey("tag_id", "posts_tags", "post_id"),
+			},
+			func() kallax.Record {
+				return new(Tag)
+			},
+			false,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("name"),
+		),
+		ID:   kallax.NewSchemaField("id"),
+		Name: kallax.NewSchemaField("name"),
+	},
+	VersionedFixture: &schemaVersionedFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"versioned",
+			"__versionedfixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(VersionedFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("version"),
+			kallax.NewSchemaField("foo"),
+		).WithNotNull(
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("version"),
+			kallax.NewSchemaField("foo"),
+		).WithVersion(kallax.NewSchemaField("version")),
+		ID:      kallax.NewSchemaField("id"),
+		Version: kallax.NewSchemaField("version"),
+		Foo:     kallax.NewSchemaField("foo"),
+	},
 }
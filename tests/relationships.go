@@ -155,3 +155,25 @@ func newBrand(name string) *Brand {
 func makeBrand(name string) Brand {
 	return Brand{Name: name, ID: kallax.NewULID()}
 }
+
+type Post struct {
+	kallax.Model `table:"posts"`
+	ID           int64 `pk:"autoincr"`
+	Title        string
+	Tags         []*Tag `through:"posts_tags"`
+}
+
+type Tag struct {
+	kallax.Model `table:"tags"`
+	ID           kallax.ULID `pk:""`
+	Name         string
+	Posts        []Post `through:"posts_tags"`
+}
+
+func newPost(title string) *Post {
+	return &Post{Title: title}
+}
+
+func newTag(name string) *Tag {
+	return &Tag{ID: kallax.NewULID(), Name: name}
+}
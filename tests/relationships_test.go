@@ -32,8 +32,21 @@ func TestRelationships(t *testing.T) {
 			kind text,
 			owner_id integer references persons(id)
 		)`,
+		`CREATE TABLE IF NOT EXISTS posts (
+			id serial primary key,
+			title text
+		)`,
+		`CREATE TABLE IF NOT EXISTS tags (
+			id uuid primary key,
+			name text
+		)`,
+		`CREATE TABLE IF NOT EXISTS posts_tags (
+			post_id integer not null references posts(id),
+			tag_id uuid not null references tags(id),
+			primary key (post_id, tag_id)
+		)`,
 	}
-	suite.Run(t, &RelationshipsSuite{NewBaseSuite(schemas, "cars", "pets", "persons")})
+	suite.Run(t, &RelationshipsSuite{NewBaseSuite(schemas, "cars", "pets", "persons", "posts_tags", "posts", "tags")})
 }
 
 func (s *RelationshipsSuite) TestInsertFind() {
@@ -160,6 +173,61 @@ func (s *RelationshipsSuite) TestSaveRelations() {
 	s.NotNil(car.Brand)
 }
 
+func (s *RelationshipsSuite) TestManyToMany() {
+	require := s.Require()
+	post := newPost("kallax")
+	golang, orm := newTag("go"), newTag("orm")
+
+	store := NewPostStore(s.db)
+	require.NoError(store.Insert(post))
+	require.NoError(store.AddTags(post, golang, orm))
+	require.Len(post.Tags, 2)
+
+	post, err := store.FindOne(NewPostQuery().FindByID(post.ID).WithTags(nil))
+	require.NoError(err)
+	require.Len(post.Tags, 2)
+
+	tag, err := NewTagStore(s.db).FindOne(NewTagQuery().FindByID(golang.ID).WithPosts(nil))
+	require.NoError(err)
+	require.Len(tag.Posts, 1)
+	s.Equal(post.ID, tag.Posts[0].ID)
+
+	require.NoError(store.RemoveTags(post, golang))
+	require.Len(post.Tags, 1)
+
+	post, err = store.FindOne(NewPostQuery().FindByID(post.ID).WithTags(nil))
+	require.NoError(err)
+	require.Len(post.Tags, 1)
+	s.Equal(orm.ID, post.Tags[0].ID)
+
+	require.NoError(store.RemoveTags(post))
+	s.Len(post.Tags, 0)
+
+	post, err = store.FindOne(NewPostQuery().FindByID(post.ID).WithTags(nil))
+	require.NoError(err)
+	s.Len(post.Tags, 0)
+}
+
+func (s *RelationshipsSuite) TestManyToMany_AddDuplicated() {
+	require := s.Require()
+	post := newPost("kallax")
+	golang, orm := newTag("go"), newTag("orm")
+
+	store := NewPostStore(s.db)
+	require.NoError(store.Insert(post))
+	require.NoError(store.AddTags(post, golang, golang))
+	require.Len(post.Tags, 1)
+
+	require.NoError(store.AddTags(post, golang, orm))
+	require.Len(post.Tags, 2)
+	s.Equal(golang.ID, post.Tags[0].ID)
+	s.Equal(orm.ID, post.Tags[1].ID)
+
+	post, err := store.FindOne(NewPostQuery().FindByID(post.ID).WithTags(nil))
+	require.NoError(err)
+	require.Len(post.Tags, 2)
+}
+
 func (s *RelationshipsSuite) assertEvents(evs map[string]int, events ...string) {
 	for _, e := range events {
 		s.Equal(1, evs[e])
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"gopkg.in/src-d/go-kallax.v1"
+	"gopkg.in/src-d/go-kallax.v1/types"
 )
 
 type A struct {
@@ -106,6 +107,65 @@ type Nullable struct {
 	Scanner      *kallax.ULID
 }
 
+type VersionedFixture struct {
+	kallax.Model `table:"versioned"`
+	kallax.Versioned
+	ID  int64 `pk:"autoincr"`
+	Foo string
+}
+
+type CustomVersionedFixture struct {
+	kallax.Model `table:"custom_versioned"`
+	ID           kallax.ULID `pk:""`
+	Rev          int32       `version:"true"`
+	Foo          string
+}
+
+func newCustomVersionedFixture(foo string) *CustomVersionedFixture {
+	return &CustomVersionedFixture{ID: kallax.NewULID(), Foo: foo}
+}
+
+type SoftDeleteFixture struct {
+	kallax.Model `table:"soft_deletes"`
+	kallax.SoftDeletes
+	ID  int64 `pk:"autoincr"`
+	Foo string
+}
+
+func newSoftDeleteFixture(foo string) *SoftDeleteFixture {
+	return &SoftDeleteFixture{Foo: foo}
+}
+
+type FullTextFixture struct {
+	kallax.Model `table:"full_text"`
+	ID           int64 `pk:"autoincr"`
+	Title        string
+	Document     types.TSVector `index:"gin"`
+}
+
+func newFullTextFixture(title string) *FullTextFixture {
+	return &FullTextFixture{Title: title}
+}
+
+type EnumStatus string
+
+const (
+	EnumActive   EnumStatus = "active"
+	EnumInactive EnumStatus = "inactive"
+)
+
+type EnumFixture struct {
+	kallax.Model `table:"enums"`
+	ID           int64 `pk:"autoincr"`
+	Status       EnumStatus
+	Previous     *EnumStatus
+	Size         int `enum:"1, 2, 3"`
+}
+
+func newEnumFixture(status EnumStatus, size int) *EnumFixture {
+	return &EnumFixture{Status: status, Size: size}
+}
+
 type Parent struct {
 	kallax.Model `table:"parents" pk:"id,autoincr"`
 	ID           int64
@@ -1,12 +1,14 @@
 package tests
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	kallax "gopkg.in/src-d/go-kallax.v1"
+	"gopkg.in/src-d/go-kallax.v1/types"
 )
 
 func TestStoreSuite(t *testing.T) {
@@ -61,8 +63,34 @@ func TestStoreSuite(t *testing.T) {
 			name text,
 			b_id bigint references b(id)
 		)`,
+		`CREATE TABLE IF NOT EXISTS versioned (
+			id serial primary key,
+			version bigint not null,
+			foo text
+		)`,
+		`CREATE TABLE IF NOT EXISTS custom_versioned (
+			id uuid primary key,
+			rev integer not null,
+			foo text
+		)`,
+		`CREATE TABLE IF NOT EXISTS soft_deletes (
+			id serial primary key,
+			deleted_at timestamptz,
+			foo text
+		)`,
+		`CREATE TABLE IF NOT EXISTS full_text (
+			id serial primary key,
+			title text,
+			document tsvector
+		)`,
+		`CREATE TABLE IF NOT EXISTS enums (
+			id serial primary key,
+			status text,
+			previous text,
+			size bigint
+		)`,
 	}
-	suite.Run(t, &StoreSuite{NewBaseSuite(schema, "store_construct", "store", "store_new", "query", "nullable", "children", "parents", "c", "b", "a")})
+	suite.Run(t, &StoreSuite{NewBaseSuite(schema, "store_construct", "store", "store_new", "query", "nullable", "children", "parents", "c", "b", "a", "versioned", "custom_versioned", "soft_deletes", "full_text", "enums")})
 }
 
 type StoreSuite struct {
@@ -210,6 +238,373 @@ func (s *StoreSuite) TestStoreSave() {
 	})
 }
 
+func (s *StoreSuite) TestStoreContext() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+	ctx := context.Background()
+
+	doc := NewStoreWithConstructFixture("foo")
+	updated, err := store.SaveContext(ctx, doc)
+	s.Nil(err)
+	s.False(updated)
+
+	doc.Foo = "bar"
+	updatedRows, err := store.UpdateContext(ctx, doc)
+	s.Nil(err)
+	s.True(updatedRows > 0)
+
+	count, err := store.CountContext(ctx, NewStoreWithConstructFixtureQuery())
+	s.Nil(err)
+	s.Equal(int64(1), count)
+
+	found, err := store.FindOneContext(ctx, NewStoreWithConstructFixtureQuery())
+	s.Nil(err)
+	s.Equal("bar", found.Foo)
+
+	s.Nil(store.DeleteContext(ctx, doc))
+	s.Equal(int64(0), store.MustCountContext(ctx, NewStoreWithConstructFixtureQuery()))
+}
+
+func (s *StoreSuite) TestStoreContext_Cancelled() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Equal(context.Canceled, store.InsertContext(ctx, NewStoreWithConstructFixture("foo")))
+
+	_, err := store.FindAllContext(ctx, NewStoreWithConstructFixtureQuery())
+	s.Equal(context.Canceled, err)
+	s.Equal(int64(0), store.MustCount(NewStoreWithConstructFixtureQuery()))
+}
+
+func (s *StoreSuite) TestStoreInsertMany() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+
+	var docs []*StoreWithConstructFixture
+	for i := 0; i < 10; i++ {
+		docs = append(docs, NewStoreWithConstructFixture(fmt.Sprint(i)))
+	}
+
+	s.Nil(store.InsertMany(docs))
+	for _, doc := range docs {
+		s.True(doc.IsPersisted())
+	}
+	s.Equal(int64(len(docs)), store.MustCount(NewStoreWithConstructFixtureQuery()))
+	s.Equal(kallax.ErrNonNewDocument, store.InsertMany(docs))
+}
+
+func (s *StoreSuite) TestStoreInsertMany_Relationships() {
+	store := NewParentStore(s.db)
+
+	var parents []*Parent
+	for i := 0; i < 3; i++ {
+		p := NewParent()
+		p.Name = fmt.Sprint(i)
+		for j := 0; j < 2; j++ {
+			c := NewChild()
+			c.Name = fmt.Sprint(j)
+			p.Children = append(p.Children, c)
+		}
+		parents = append(parents, p)
+	}
+
+	s.NoError(store.InsertMany(parents))
+	for _, p := range parents {
+		s.NotEqual(int64(0), p.ID)
+
+		found, err := store.FindOne(NewParentQuery().FindByID(p.ID).WithChildren(nil))
+		s.NoError(err)
+		s.Equal(p.Name, found.Name)
+		s.Len(found.Children, 2)
+	}
+}
+
+func (s *StoreSuite) TestStoreUpsert() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+
+	doc := NewStoreWithConstructFixture("foo")
+	s.NoError(store.Upsert(doc, []kallax.SchemaField{Schema.StoreWithConstructFixture.ID}, Schema.StoreWithConstructFixture.Foo))
+	s.True(doc.IsPersisted())
+
+	other := NewStoreWithConstructFixture("bar")
+	other.ID = doc.ID
+	s.NoError(store.Upsert(other, []kallax.SchemaField{Schema.StoreWithConstructFixture.ID}))
+	s.Equal("foo", other.Foo)
+
+	s.NoError(store.Upsert(other, []kallax.SchemaField{Schema.StoreWithConstructFixture.ID}, Schema.StoreWithConstructFixture.Foo))
+	s.Equal(int64(1), store.MustCount(NewStoreWithConstructFixtureQuery()))
+	s.Equal("foo", store.MustFindOne(NewStoreWithConstructFixtureQuery()).Foo)
+
+	other.Foo = "bar"
+	s.NoError(store.Upsert(other, []kallax.SchemaField{Schema.StoreWithConstructFixture.ID}, Schema.StoreWithConstructFixture.Foo))
+	s.Equal("bar", store.MustFindOne(NewStoreWithConstructFixtureQuery()).Foo)
+}
+
+func (s *StoreSuite) TestStoreVersioned() {
+	store := NewVersionedFixtureStore(s.db)
+
+	doc := NewVersionedFixture()
+	doc.Foo = "foo"
+	s.NoError(store.Insert(doc))
+	s.Equal(int64(0), doc.Version)
+
+	stale := store.MustFindOne(NewVersionedFixtureQuery().FindByID(doc.ID))
+
+	doc.Foo = "bar"
+	_, err := store.Save(doc)
+	s.NoError(err)
+	s.Equal(int64(1), doc.Version)
+
+	stale.Foo = "baz"
+	_, err = store.Update(stale)
+	s.Equal(kallax.ErrStaleRecord, err)
+	s.Equal(int64(0), stale.Version)
+
+	s.NoError(store.Reload(stale))
+	s.Equal("bar", stale.Foo)
+	s.Equal(int64(1), stale.Version)
+
+	stale.Foo = "baz"
+	_, err = store.Update(stale)
+	s.NoError(err)
+	s.Equal(int64(2), stale.Version)
+	s.Equal("baz", store.MustFindOne(NewVersionedFixtureQuery()).Foo)
+}
+
+func (s *StoreSuite) TestStoreVersioned_CustomColumn() {
+	store := NewCustomVersionedFixtureStore(s.db)
+
+	doc := NewCustomVersionedFixture("foo")
+	s.NoError(store.Insert(doc))
+
+	stale := store.MustFindOne(NewCustomVersionedFixtureQuery().FindByID(doc.ID))
+
+	doc.Foo = "bar"
+	_, err := store.Update(doc, Schema.CustomVersionedFixture.Foo)
+	s.NoError(err)
+	s.Equal(int32(1), doc.Rev)
+
+	_, err = store.Update(stale)
+	s.Equal(kallax.ErrStaleRecord, err)
+	s.Equal("bar", store.MustFindOne(NewCustomVersionedFixtureQuery()).Foo)
+}
+
+func (s *StoreSuite) TestStoreSoftDeletes() {
+	store := NewSoftDeleteFixtureStore(s.db)
+
+	foo := NewSoftDeleteFixture("foo")
+	bar := NewSoftDeleteFixture("bar")
+	s.NoError(store.Insert(foo))
+	s.NoError(store.Insert(bar))
+
+	s.NoError(store.Delete(foo))
+	s.True(foo.IsDeleted())
+	deletedAt := *foo.DeletedAt
+
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery()))
+	s.Equal("bar", store.MustFindOne(NewSoftDeleteFixtureQuery()).Foo)
+	s.Equal(int64(2), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+
+	deleted := store.MustFindOne(NewSoftDeleteFixtureQuery().OnlyDeleted())
+	s.Equal("foo", deleted.Foo)
+	s.True(deleted.IsDeleted())
+
+	_, err := store.FindOne(NewSoftDeleteFixtureQuery().FindByID(foo.ID))
+	s.Equal(kallax.ErrNotFound, err)
+
+	s.NoError(store.Delete(deleted))
+	s.NoError(store.Reload(deleted))
+	s.True(deletedAt.Equal(*deleted.DeletedAt), "deletion time is not changed")
+
+	s.NoError(store.Restore(foo))
+	s.False(foo.IsDeleted())
+	s.Equal(int64(2), store.MustCount(NewSoftDeleteFixtureQuery()))
+
+	s.NoError(store.ForceDelete(foo))
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+}
+
+func (s *StoreSuite) TestStoreSoftDeletes_DeleteWhere() {
+	store := NewSoftDeleteFixtureStore(s.db)
+
+	foo := NewSoftDeleteFixture("foo")
+	bar := NewSoftDeleteFixture("bar")
+	s.NoError(store.Insert(foo))
+	s.NoError(store.Insert(bar))
+
+	count, err := store.DeleteWhere(NewSoftDeleteFixtureQuery().
+		Where(kallax.Eq(Schema.SoftDeleteFixture.Foo, "foo")))
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery()))
+	s.Equal(int64(2), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+
+	s.NoError(store.Reload(foo))
+	s.True(foo.IsDeleted())
+	deletedAt := *foo.DeletedAt
+
+	count, err = store.DeleteWhere(NewSoftDeleteFixtureQuery().WithDeleted())
+	s.NoError(err)
+	s.Equal(int64(1), count, "only bar is deleted")
+	s.NoError(store.Reload(foo))
+	s.True(deletedAt.Equal(*foo.DeletedAt), "deletion time is not changed")
+
+	count, err = store.ForceDeleteWhere(NewSoftDeleteFixtureQuery().OnlyDeleted().
+		Where(kallax.Eq(Schema.SoftDeleteFixture.Foo, "foo")))
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+}
+
+func (s *StoreSuite) TestStoreLocking() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+	s.NoError(store.Insert(NewStoreWithConstructFixture("foo")))
+	s.NoError(store.Insert(NewStoreWithConstructFixture("bar")))
+
+	err := store.Transaction(func(store *StoreWithConstructFixtureStore) error {
+		locked, err := store.FindOne(NewStoreWithConstructFixtureQuery().
+			Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, "foo")).
+			ForUpdate())
+		s.NoError(err)
+		s.Equal("foo", locked.Foo)
+
+		other := NewStoreWithConstructFixtureStore(s.db)
+		unlocked, err := other.FindAll(NewStoreWithConstructFixtureQuery().ForUpdate().SkipLocked())
+		s.NoError(err)
+		s.Len(unlocked, 1)
+		s.Equal("bar", unlocked[0].Foo)
+
+		_, err = other.FindAll(NewStoreWithConstructFixtureQuery().ForUpdate().NoWait())
+		s.Error(err)
+		return nil
+	})
+	s.NoError(err)
+
+	s.Equal(int64(2), store.MustCount(NewStoreWithConstructFixtureQuery().ForShare()))
+
+	_, err = NewParentStore(s.db).Find(NewParentQuery().WithChildren(nil).ForUpdate())
+	s.Equal(kallax.ErrLockNotSupported, err)
+}
+
+func (s *StoreSuite) TestStoreKeysetPagination() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+	for _, foo := range []string{"c", "a", "e", "b", "d"} {
+		s.NoError(store.Insert(NewStoreWithConstructFixture(foo)))
+	}
+
+	page := func(cursor string) ([]string, string) {
+		q := NewStoreWithConstructFixtureQuery().
+			Order(kallax.Asc(Schema.StoreWithConstructFixture.Foo), kallax.Asc(Schema.StoreWithConstructFixture.ID)).
+			Limit(2)
+		if cursor != "" {
+			c, err := kallax.ParseCursor(cursor)
+			s.NoError(err)
+			q.After(c)
+		}
+
+		rs, err := store.Find(q)
+		s.NoError(err)
+		records, err := rs.All()
+		s.NoError(err)
+
+		var foos []string
+		for _, r := range records {
+			foos = append(foos, r.Foo)
+		}
+
+		c, err := rs.Cursor()
+		if err == kallax.ErrNoCursor {
+			return foos, ""
+		}
+		s.NoError(err)
+
+		next, err := c.Encode()
+		s.NoError(err)
+		return foos, next
+	}
+
+	foos, cursor := page("")
+	s.Equal([]string{"a", "b"}, foos)
+
+	s.NoError(store.Insert(NewStoreWithConstructFixture("0")))
+
+	foos, cursor = page(cursor)
+	s.Equal([]string{"c", "d"}, foos)
+	foos, cursor = page(cursor)
+	s.Equal([]string{"e"}, foos)
+	foos, _ = page(cursor)
+	s.Len(foos, 0)
+}
+
+func (s *StoreSuite) TestStoreUpdateDeleteWhere() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+	for _, foo := range []string{"a", "b", "c"} {
+		s.NoError(store.Insert(NewStoreWithConstructFixture(foo)))
+	}
+
+	count, err := store.UpdateWhere(
+		NewStoreWithConstructFixtureQuery().Where(kallax.In(Schema.StoreWithConstructFixture.Foo, "a", "b")),
+		map[kallax.SchemaField]interface{}{Schema.StoreWithConstructFixture.Foo: "d"},
+	)
+	s.NoError(err)
+	s.Equal(int64(2), count)
+
+	rs, err := store.UpdateWhereReturning(
+		NewStoreWithConstructFixtureQuery().Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, "c")),
+		map[kallax.SchemaField]interface{}{Schema.StoreWithConstructFixture.Foo: "e"},
+	)
+	s.NoError(err)
+	docs, err := rs.All()
+	s.NoError(err)
+	s.Len(docs, 1)
+	s.Equal("e", docs[0].Foo)
+
+	count, err = store.DeleteWhere(NewStoreWithConstructFixtureQuery().Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, "d")))
+	s.NoError(err)
+	s.Equal(int64(2), count)
+
+	rs, err = store.DeleteWhereReturning(NewStoreWithConstructFixtureQuery())
+	s.NoError(err)
+	docs, err = rs.All()
+	s.NoError(err)
+	s.Len(docs, 1)
+	s.Equal("e", docs[0].Foo)
+	s.Equal(int64(0), store.MustCount(NewStoreWithConstructFixtureQuery()))
+}
+
+func (s *StoreSuite) TestStoreFindAggregate() {
+	store := NewStoreWithConstructFixtureStore(s.db)
+	for _, foo := range []string{"a", "b", "b", "c", "c", "c"} {
+		s.NoError(store.Insert(NewStoreWithConstructFixture(foo)))
+	}
+
+	count, err := store.CountDistinct(NewStoreWithConstructFixtureQuery(), Schema.StoreWithConstructFixture.Foo)
+	s.NoError(err)
+	s.Equal(int64(3), count)
+
+	q := NewStoreWithConstructFixtureQuery().
+		GroupBy(Schema.StoreWithConstructFixture.Foo).
+		Having(kallax.Gt(kallax.CountAll(), 1)).
+		Order(kallax.Desc(kallax.CountAll()))
+	rs, err := store.FindAggregate(q, kallax.CountAll())
+	s.NoError(err)
+
+	rows, err := rs.All()
+	s.NoError(err)
+	s.Len(rows, 2)
+	for i, expected := range []struct {
+		foo   string
+		count int64
+	}{{"c", 3}, {"b", 2}} {
+		var foo string
+		s.NoError(rows[i].Scan(Schema.StoreWithConstructFixture.Foo, &foo))
+		s.Equal(expected.foo, foo)
+
+		n, err := rows[i].Int64(kallax.CountAll())
+		s.NoError(err)
+		s.Equal(expected.count, n)
+	}
+}
+
 func (s *StoreSuite) TestMultiKeySort() {
 	store := NewMultiKeySortFixtureStore(s.db)
 
@@ -458,6 +853,120 @@ func (s *StoreSuite) TestRecursiveInsert() {
 	s.NotNil(retrievedB.C)
 }
 
+func (s *StoreSuite) TestWhereHas() {
+	store := NewParentStore(s.db)
+	for _, children := range [][]string{{"x", "y"}, {"y"}, nil} {
+		p := NewParent()
+		p.Name = "parent"
+		for _, name := range children {
+			c := NewChild()
+			c.Name = name
+			p.Children = append(p.Children, c)
+		}
+		s.NoError(store.Insert(p))
+	}
+
+	s.Equal(int64(2), store.MustCount(NewParentQuery().WhereHasChildren(nil)))
+	s.Equal(int64(1), store.MustCount(NewParentQuery().WhereHasChildren(kallax.Eq(Schema.Child.Name, "x"))))
+	s.Equal(int64(1), store.MustCount(NewParentQuery().Where(kallax.Not(kallax.Has(Schema.Child.BaseSchema, "Children", nil)))))
+
+	astore := NewAStore(s.db)
+	a := NewA("foo")
+	b := NewB("bar", a)
+	NewC("baz", b)
+	s.NoError(astore.Insert(a))
+	s.NoError(astore.Insert(NewA("qux")))
+
+	s.Equal(int64(1), astore.MustCount(NewAQuery().WhereHasB(kallax.Has(Schema.C.BaseSchema, "C", nil))))
+	s.Equal(int64(1), NewBStore(s.db).MustCount(NewBQuery().WhereHasA(kallax.Eq(Schema.A.Name, "foo"))))
+	s.Equal(int64(0), NewBStore(s.db).MustCount(NewBQuery().WhereHasA(kallax.Eq(Schema.A.Name, "qux"))))
+}
+
+func (s *StoreSuite) TestFullTextSearch() {
+	store := NewFullTextFixtureStore(s.db)
+	for _, title := range []string{"The fat rats", "A fat cat", "The rat ate the rat"} {
+		s.NoError(store.Insert(newFullTextFixture(title)))
+	}
+
+	_, err := s.db.Exec("UPDATE full_text SET document = to_tsvector('english', title)")
+	s.NoError(err)
+
+	query := kallax.PlainQuery("rat")
+	titles := func(q *FullTextFixtureQuery) []string {
+		records, err := store.FindAll(q)
+		s.NoError(err)
+		var result []string
+		for _, r := range records {
+			result = append(result, r.Title)
+		}
+		return result
+	}
+
+	s.Equal(
+		[]string{"The rat ate the rat", "The fat rats"},
+		titles(NewFullTextFixtureQuery().
+			Where(kallax.Matches(Schema.FullTextFixture.Title, query, "english")).
+			Order(kallax.ByRank(Schema.FullTextFixture.Title, query, "english"))),
+	)
+
+	s.Equal(
+		[]string{"The rat ate the rat", "The fat rats"},
+		titles(NewFullTextFixtureQuery().
+			Where(kallax.Matches(Schema.FullTextFixture.Document, query, "english")).
+			Order(kallax.ByRank(Schema.FullTextFixture.Document, query, "english"))),
+	)
+
+	record, err := store.FindOne(NewFullTextFixtureQuery().
+		Where(kallax.Matches(Schema.FullTextFixture.Document, kallax.PlainQuery("cat"), "english")))
+	s.NoError(err)
+	s.Equal("A fat cat", record.Title)
+	s.Equal(types.TSVector("'cat':3 'fat':2"), record.Document)
+}
+
+func (s *StoreSuite) TestEnum() {
+	store := NewEnumFixtureStore(s.db)
+	record := newEnumFixture(EnumActive, 2)
+	s.NoError(store.Insert(record))
+
+	record.Previous = &record.Status
+	record.Status = EnumInactive
+	_, err := store.Update(record)
+	s.NoError(err)
+
+	record, err = store.FindOne(NewEnumFixtureQuery().FindByID(record.ID))
+	s.NoError(err)
+	s.Equal(EnumInactive, record.Status)
+	s.Equal(EnumActive, *record.Previous)
+	s.Equal(2, record.Size)
+
+	s.Error(store.Insert(newEnumFixture(EnumStatus("deleted"), 1)))
+	s.Error(store.Insert(newEnumFixture(EnumActive, 4)))
+
+	_, err = s.db.Exec("UPDATE enums SET status = 'deleted'")
+	s.NoError(err)
+
+	_, err = store.FindOne(NewEnumFixtureQuery().FindByID(record.ID))
+	s.Error(err)
+}
+
+func (s *StoreSuite) TestColumnComparison() {
+	store := NewBStore(s.db)
+	for _, names := range [][2]string{{"foo", "foo"}, {"bar", "baz"}} {
+		s.NoError(store.Insert(NewB(names[1], NewA(names[0]))))
+	}
+
+	bs, err := store.FindAll(NewBQuery().WithA().Where(kallax.Eq(
+		Schema.B.Name,
+		kallax.RelCol(Schema.A.BaseSchema, "A", Schema.A.Name),
+	)))
+	s.NoError(err)
+	s.Len(bs, 1)
+	s.Equal("foo", bs[0].Name)
+
+	s.Equal(int64(2), store.MustCount(NewBQuery().Where(kallax.Eq(Schema.B.Name, kallax.Col(Schema.B.Name)))))
+	s.Equal(int64(0), store.MustCount(NewBQuery().Where(kallax.Neq(Schema.B.ID, kallax.Col(Schema.B.ID)))))
+}
+
 func (s *StoreSuite) TestRecursiveInsert_Reverse() {
 	store := NewCStore(s.db).Debug()
 	a := NewA("foo")
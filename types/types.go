@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
@@ -731,6 +733,149 @@ func (u URL) Value() (driver.Value, error) {
 	return (&url).String(), nil
 }
 
+// TSVector is a PostgreSQL tsvector, that is, a document preprocessed for
+// full-text search, in its textual representation. It implements SQLType
+// interface.
+type TSVector string
+
+func (v *TSVector) Scan(src interface{}) error {
+	switch t := src.(type) {
+	case []byte:
+		*v = TSVector(t)
+		return nil
+	case string:
+		*v = TSVector(t)
+		return nil
+	}
+	return fmt.Errorf("kallax: cannot scan type %s into TSVector type", reflect.TypeOf(src))
+}
+
+func (v TSVector) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+type enum struct {
+	val    reflect.Value
+	values []string
+}
+
+// Enum returns an SQLType for the given pointer to a value of a string or
+// integer type, that fails to scan or be used as a value if the value is not
+// one of the given ones. For nullable values, a pointer to a pointer can be
+// given instead. Note that the actual implementation of this relies on
+// reflection, so be cautious with its usage.
+func Enum(v interface{}, values ...interface{}) SQLType {
+	var e = &enum{val: reflect.ValueOf(v)}
+	for _, v := range values {
+		e.values = append(e.values, enumKey(reflect.ValueOf(v)))
+	}
+	return e
+}
+
+func (e *enum) Scan(v interface{}) error {
+	elem := e.val.Elem()
+	if v == nil {
+		if elem.Kind() != reflect.Ptr {
+			return fmt.Errorf("kallax: cannot scan NULL into non nullable enum %s", elem.Type())
+		}
+		elem.Set(reflect.Zero(elem.Type()))
+		return nil
+	}
+
+	if elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			elem.Set(reflect.New(elem.Type().Elem()))
+		}
+		elem = elem.Elem()
+	}
+
+	var key string
+	switch v := v.(type) {
+	case []byte:
+		key = string(v)
+	case string:
+		key = v
+	case int64:
+		key = strconv.FormatInt(v, 10)
+	default:
+		return fmt.Errorf("kallax: cannot scan type %s into enum %s", reflect.TypeOf(v), elem.Type())
+	}
+
+	if !e.isValid(key) {
+		return e.invalid(key, elem.Type())
+	}
+
+	switch elem.Kind() {
+	case reflect.String:
+		elem.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return e.invalid(key, elem.Type())
+		}
+		elem.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return e.invalid(key, elem.Type())
+		}
+		elem.SetUint(n)
+	default:
+		return fmt.Errorf("kallax: cannot scan into enum of type %s", elem.Type())
+	}
+	return nil
+}
+
+func (e *enum) Value() (driver.Value, error) {
+	elem := e.val.Elem()
+	if elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			return nil, nil
+		}
+		elem = elem.Elem()
+	}
+
+	key := enumKey(elem)
+	if !e.isValid(key) {
+		return nil, e.invalid(key, elem.Type())
+	}
+
+	switch elem.Kind() {
+	case reflect.String:
+		return elem.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return elem.Int(), nil
+	}
+	return int64(elem.Uint()), nil
+}
+
+func (e *enum) isValid(key string) bool {
+	for _, v := range e.values {
+		if v == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *enum) invalid(key string, typ reflect.Type) error {
+	return fmt.Errorf("kallax: invalid value %q for enum %s, it must be one of: %s", key, typ, strings.Join(e.values, ", "))
+}
+
+// enumKey returns the string representation of the given string or integer
+// value used to compare it with the values of an enum.
+func enumKey(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
 type array struct {
 	val  reflect.Value
 	size int
@@ -28,6 +28,69 @@ func TestURL(t *testing.T) {
 	require.Equal(expectedURL, val)
 }
 
+func TestTSVector(t *testing.T) {
+	require := require.New(t)
+	expected := "'fat':2 'rat':3"
+
+	var v TSVector
+	require.Nil(v.Scan(expected))
+	require.Equal(TSVector(expected), v)
+
+	v = TSVector("")
+	require.Nil(v.Scan([]byte(expected)))
+	require.Equal(TSVector(expected), v)
+
+	val, err := v.Value()
+	require.Nil(err)
+	require.Equal(expected, val)
+
+	require.Error(v.Scan(1))
+}
+
+type status string
+
+type weekday uint8
+
+func TestEnum(t *testing.T) {
+	require := require.New(t)
+
+	var s status
+	e := Enum(&s, "active", "inactive")
+	require.NoError(e.Scan([]byte("inactive")))
+	require.Equal(status("inactive"), s)
+	require.Error(e.Scan("deleted"))
+	require.Error(e.Scan(nil))
+
+	val, err := e.Value()
+	require.NoError(err)
+	require.Equal("inactive", val)
+
+	s = "deleted"
+	_, err = e.Value()
+	require.Error(err)
+
+	var d weekday
+	e = Enum(&d, 0, 1, 2)
+	require.NoError(e.Scan(int64(2)))
+	require.Equal(weekday(2), d)
+	require.Error(e.Scan(int64(7)))
+
+	val, err = e.Value()
+	require.NoError(err)
+	require.Equal(int64(2), val)
+
+	var ptr *status
+	e = Enum(&ptr, "active", "inactive")
+	val, err = e.Value()
+	require.NoError(err)
+	require.Nil(val)
+
+	require.NoError(e.Scan("active"))
+	require.Equal(status("active"), *ptr)
+	require.NoError(e.Scan(nil))
+	require.Nil(ptr)
+}
+
 func urlStr(u url.URL) string {
 	url := &u
 	return url.String()
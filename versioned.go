@@ -0,0 +1,20 @@
+package kallax
+
+// Versioned contains the version of the model, which is used to prevent
+// concurrent updates from overwriting each other. Every time the model is
+// updated, the version is checked against the one stored in the database and
+// incremented. If they do not match, the update fails with ErrStaleRecord.
+// It is intended to be embedded in the model.
+//
+//	type MyModel struct {
+//		kallax.Model
+//		kallax.Versioned
+//		Foo string
+//	}
+//
+// Any other integer field of the model can be used as the version using the
+// `version:"true"` struct tag instead.
+type Versioned struct {
+	// Version is the version of the model, incremented on every update.
+	Version int64 `version:"true"`
+}